@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 
@@ -38,6 +39,13 @@ func main() {
 		return
 	}
 
+	// 存量电价日期条件无法解析时，GetDlgdPrice返回错误，启动时提前报告
+	if size, err := ctx.CheckDlgdDates(context.Background()); err != nil {
+		logx.Errorf("校验代理购电日期条件失败: %v", err)
+	} else if size > 0 {
+		logx.Errorf("%d条代理购电日期条件无法解析，请修正", size)
+	}
+
 	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
 		cron.RegisterCronServer(grpcServer, server.NewCronServer(ctx))
 
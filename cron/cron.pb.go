@@ -410,8 +410,9 @@ type CarbonReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // 用户地址
-	Year    int64  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`      //
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`   // 用户地址
+	Year     int64  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`        //
+	Category string `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"` // 因子类型：emission(二氧化碳排放因子，默认)、footprint(碳足迹因子)、fossil(化石能源排放因子)、market(市场化调整排放因子)
 }
 
 func (x *CarbonReq) Reset() {
@@ -460,12 +461,24 @@ func (x *CarbonReq) GetYear() int64 {
 	return 0
 }
 
+func (x *CarbonReq) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
 type CarbonRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"` //
+	Value    float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`     //
+	Level    string  `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`       // 匹配层级：province(省级)、region(区域电网)、national(全国)
+	Area     string  `protobuf:"bytes,3,opt,name=area,proto3" json:"area,omitempty"`         // 匹配区域，如：福建、华东、全国
+	Year     int64   `protobuf:"varint,4,opt,name=year,proto3" json:"year,omitempty"`        // 因子年份，指定年份未发布时为最新年份
+	Source   string  `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`     // 来源文件，如公告链接
+	Category string  `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"` // 因子类型
 }
 
 func (x *CarbonRsp) Reset() {
@@ -507,14 +520,51 @@ func (x *CarbonRsp) GetValue() float64 {
 	return 0
 }
 
+func (x *CarbonRsp) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *CarbonRsp) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+func (x *CarbonRsp) GetYear() int64 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *CarbonRsp) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *CarbonRsp) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
 type AddCarbonReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Area  string  `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`     //
-	Year  int64   `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`    //
-	Value float64 `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"` //
+	Area     string  `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`         //
+	Year     int64   `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`        //
+	Value    float64 `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`     //
+	Source   string  `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`     // 来源文件，如公告链接
+	Category string  `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"` // 因子类型，缺省为emission
 }
 
 func (x *AddCarbonReq) Reset() {
@@ -570,6 +620,20 @@ func (x *AddCarbonReq) GetValue() float64 {
 	return 0
 }
 
+func (x *AddCarbonReq) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *AddCarbonReq) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
 type WeathersReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -759,17 +823,22 @@ func (x *WeathersRsp) GetWeathers() []*Weather {
 	return nil
 }
 
-type HolidaysReq struct {
+type DegreeDaysReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // 用户地址
-	Year    int64  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`      //
+	Address   string  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`     // 用户地址
+	StartDate string  `protobuf:"bytes,2,opt,name=startDate,proto3" json:"startDate,omitempty"` // 开始日期
+	EndDate   string  `protobuf:"bytes,3,opt,name=endDate,proto3" json:"endDate,omitempty"`     // 结束日期(含)，缺省为当日
+	Interval  string  `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"`   // 统计周期：day(默认)、week、month
+	HeatBase  float64 `protobuf:"fixed64,5,opt,name=heatBase,proto3" json:"heatBase,omitempty"` // 采暖度日基准温度，缺省18℃
+	CoolBase  float64 `protobuf:"fixed64,6,opt,name=coolBase,proto3" json:"coolBase,omitempty"` // 制冷度日基准温度，缺省26℃
+	Source    string  `protobuf:"bytes,7,opt,name=source,proto3" json:"source,omitempty"`       // 气温来源：缺省已过日期实测优先、forecast(仅预报)、observed(仅实测)
 }
 
-func (x *HolidaysReq) Reset() {
-	*x = HolidaysReq{}
+func (x *DegreeDaysReq) Reset() {
+	*x = DegreeDaysReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cron_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -777,13 +846,13 @@ func (x *HolidaysReq) Reset() {
 	}
 }
 
-func (x *HolidaysReq) String() string {
+func (x *DegreeDaysReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HolidaysReq) ProtoMessage() {}
+func (*DegreeDaysReq) ProtoMessage() {}
 
-func (x *HolidaysReq) ProtoReflect() protoreflect.Message {
+func (x *DegreeDaysReq) ProtoReflect() protoreflect.Message {
 	mi := &file_cron_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -795,38 +864,80 @@ func (x *HolidaysReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HolidaysReq.ProtoReflect.Descriptor instead.
-func (*HolidaysReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use DegreeDaysReq.ProtoReflect.Descriptor instead.
+func (*DegreeDaysReq) Descriptor() ([]byte, []int) {
 	return file_cron_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *HolidaysReq) GetAddress() string {
+func (x *DegreeDaysReq) GetAddress() string {
 	if x != nil {
 		return x.Address
 	}
 	return ""
 }
 
-func (x *HolidaysReq) GetYear() int64 {
+func (x *DegreeDaysReq) GetStartDate() string {
 	if x != nil {
-		return x.Year
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *DegreeDaysReq) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *DegreeDaysReq) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *DegreeDaysReq) GetHeatBase() float64 {
+	if x != nil {
+		return x.HeatBase
 	}
 	return 0
 }
 
-type Holiday struct {
+func (x *DegreeDaysReq) GetCoolBase() float64 {
+	if x != nil {
+		return x.CoolBase
+	}
+	return 0
+}
+
+func (x *DegreeDaysReq) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type DegreeDay struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`            //
-	Date     string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`         //
-	Category string `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"` // 假日类别：假日、调休工作日
-	Detail   string `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`     //
-}
-
-func (x *Holiday) Reset() {
-	*x = Holiday{}
+	Period       string   `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`              // 周期，如2025-08-01、2025-W31、2025-08
+	StartDate    string   `protobuf:"bytes,2,opt,name=startDate,proto3" json:"startDate,omitempty"`        // 周期开始日期
+	EndDate      string   `protobuf:"bytes,3,opt,name=endDate,proto3" json:"endDate,omitempty"`            // 周期结束日期(含)
+	Hdd          float64  `protobuf:"fixed64,4,opt,name=hdd,proto3" json:"hdd,omitempty"`                  // 采暖度日
+	Cdd          float64  `protobuf:"fixed64,5,opt,name=cdd,proto3" json:"cdd,omitempty"`                  // 制冷度日
+	MeanTemp     float64  `protobuf:"fixed64,6,opt,name=meanTemp,proto3" json:"meanTemp,omitempty"`        // 平均气温
+	Days         int64    `protobuf:"varint,7,opt,name=days,proto3" json:"days,omitempty"`                 // 有数据天数
+	ExpectedDays int64    `protobuf:"varint,8,opt,name=expectedDays,proto3" json:"expectedDays,omitempty"` // 应有天数
+	ObservedDays int64    `protobuf:"varint,9,opt,name=observedDays,proto3" json:"observedDays,omitempty"` // 实测天数
+	Coverage     float64  `protobuf:"fixed64,10,opt,name=coverage,proto3" json:"coverage,omitempty"`       // 数据覆盖率
+	Missing      []string `protobuf:"bytes,11,rep,name=missing,proto3" json:"missing,omitempty"`           // 缺数日期
+}
+
+func (x *DegreeDay) Reset() {
+	*x = DegreeDay{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cron_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -834,13 +945,13 @@ func (x *Holiday) Reset() {
 	}
 }
 
-func (x *Holiday) String() string {
+func (x *DegreeDay) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Holiday) ProtoMessage() {}
+func (*DegreeDay) ProtoMessage() {}
 
-func (x *Holiday) ProtoReflect() protoreflect.Message {
+func (x *DegreeDay) ProtoReflect() protoreflect.Message {
 	mi := &file_cron_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -852,49 +963,105 @@ func (x *Holiday) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Holiday.ProtoReflect.Descriptor instead.
-func (*Holiday) Descriptor() ([]byte, []int) {
+// Deprecated: Use DegreeDay.ProtoReflect.Descriptor instead.
+func (*DegreeDay) Descriptor() ([]byte, []int) {
 	return file_cron_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Holiday) GetId() int64 {
+func (x *DegreeDay) GetPeriod() string {
 	if x != nil {
-		return x.Id
+		return x.Period
 	}
-	return 0
+	return ""
 }
 
-func (x *Holiday) GetDate() string {
+func (x *DegreeDay) GetStartDate() string {
 	if x != nil {
-		return x.Date
+		return x.StartDate
 	}
 	return ""
 }
 
-func (x *Holiday) GetCategory() string {
+func (x *DegreeDay) GetEndDate() string {
 	if x != nil {
-		return x.Category
+		return x.EndDate
 	}
 	return ""
 }
 
-func (x *Holiday) GetDetail() string {
+func (x *DegreeDay) GetHdd() float64 {
 	if x != nil {
-		return x.Detail
+		return x.Hdd
 	}
-	return ""
+	return 0
 }
 
-type HolidaysRsp struct {
+func (x *DegreeDay) GetCdd() float64 {
+	if x != nil {
+		return x.Cdd
+	}
+	return 0
+}
+
+func (x *DegreeDay) GetMeanTemp() float64 {
+	if x != nil {
+		return x.MeanTemp
+	}
+	return 0
+}
+
+func (x *DegreeDay) GetDays() int64 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *DegreeDay) GetExpectedDays() int64 {
+	if x != nil {
+		return x.ExpectedDays
+	}
+	return 0
+}
+
+func (x *DegreeDay) GetObservedDays() int64 {
+	if x != nil {
+		return x.ObservedDays
+	}
+	return 0
+}
+
+func (x *DegreeDay) GetCoverage() float64 {
+	if x != nil {
+		return x.Coverage
+	}
+	return 0
+}
+
+func (x *DegreeDay) GetMissing() []string {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+type DegreeDaysRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Holidays []*Holiday `protobuf:"bytes,1,rep,name=holidays,proto3" json:"holidays,omitempty"` //
+	City     string       `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`           // 气温城市
+	HeatBase float64      `protobuf:"fixed64,2,opt,name=heatBase,proto3" json:"heatBase,omitempty"` // 采暖度日基准温度
+	CoolBase float64      `protobuf:"fixed64,3,opt,name=coolBase,proto3" json:"coolBase,omitempty"` // 制冷度日基准温度
+	Hdd      float64      `protobuf:"fixed64,4,opt,name=hdd,proto3" json:"hdd,omitempty"`           // 合计采暖度日
+	Cdd      float64      `protobuf:"fixed64,5,opt,name=cdd,proto3" json:"cdd,omitempty"`           // 合计制冷度日
+	Coverage float64      `protobuf:"fixed64,6,opt,name=coverage,proto3" json:"coverage,omitempty"` // 数据覆盖率
+	Missing  []string     `protobuf:"bytes,7,rep,name=missing,proto3" json:"missing,omitempty"`     // 缺数日期
+	Items    []*DegreeDay `protobuf:"bytes,8,rep,name=items,proto3" json:"items,omitempty"`         // 各周期度日
 }
 
-func (x *HolidaysRsp) Reset() {
-	*x = HolidaysRsp{}
+func (x *DegreeDaysRsp) Reset() {
+	*x = DegreeDaysRsp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cron_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -902,13 +1069,13 @@ func (x *HolidaysRsp) Reset() {
 	}
 }
 
-func (x *HolidaysRsp) String() string {
+func (x *DegreeDaysRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HolidaysRsp) ProtoMessage() {}
+func (*DegreeDaysRsp) ProtoMessage() {}
 
-func (x *HolidaysRsp) ProtoReflect() protoreflect.Message {
+func (x *DegreeDaysRsp) ProtoReflect() protoreflect.Message {
 	mi := &file_cron_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -920,29 +1087,79 @@ func (x *HolidaysRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HolidaysRsp.ProtoReflect.Descriptor instead.
-func (*HolidaysRsp) Descriptor() ([]byte, []int) {
+// Deprecated: Use DegreeDaysRsp.ProtoReflect.Descriptor instead.
+func (*DegreeDaysRsp) Descriptor() ([]byte, []int) {
 	return file_cron_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *HolidaysRsp) GetHolidays() []*Holiday {
+func (x *DegreeDaysRsp) GetCity() string {
 	if x != nil {
-		return x.Holidays
+		return x.City
+	}
+	return ""
+}
+
+func (x *DegreeDaysRsp) GetHeatBase() float64 {
+	if x != nil {
+		return x.HeatBase
+	}
+	return 0
+}
+
+func (x *DegreeDaysRsp) GetCoolBase() float64 {
+	if x != nil {
+		return x.CoolBase
+	}
+	return 0
+}
+
+func (x *DegreeDaysRsp) GetHdd() float64 {
+	if x != nil {
+		return x.Hdd
+	}
+	return 0
+}
+
+func (x *DegreeDaysRsp) GetCdd() float64 {
+	if x != nil {
+		return x.Cdd
+	}
+	return 0
+}
+
+func (x *DegreeDaysRsp) GetCoverage() float64 {
+	if x != nil {
+		return x.Coverage
+	}
+	return 0
+}
+
+func (x *DegreeDaysRsp) GetMissing() []string {
+	if x != nil {
+		return x.Missing
 	}
 	return nil
 }
 
-type AddHolidaysReq struct {
+func (x *DegreeDaysRsp) GetItems() []*DegreeDay {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type StationReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address  string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`   // 用户地址
-	Holidays []*Holiday `protobuf:"bytes,2,rep,name=holidays,proto3" json:"holidays,omitempty"` //
+	Province string `protobuf:"bytes,1,opt,name=province,proto3" json:"province,omitempty"` // 省级名称
+	City     string `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`         // 市级名称
+	Code     string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`         // 气象站代码，指定时写入人工映射(刷新不覆盖)
 }
 
-func (x *AddHolidaysReq) Reset() {
-	*x = AddHolidaysReq{}
+func (x *StationReq) Reset() {
+	*x = StationReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cron_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -950,13 +1167,13 @@ func (x *AddHolidaysReq) Reset() {
 	}
 }
 
-func (x *AddHolidaysReq) String() string {
+func (x *StationReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddHolidaysReq) ProtoMessage() {}
+func (*StationReq) ProtoMessage() {}
 
-func (x *AddHolidaysReq) ProtoReflect() protoreflect.Message {
+func (x *StationReq) ProtoReflect() protoreflect.Message {
 	mi := &file_cron_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -968,36 +1185,45 @@ func (x *AddHolidaysReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddHolidaysReq.ProtoReflect.Descriptor instead.
-func (*AddHolidaysReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use StationReq.ProtoReflect.Descriptor instead.
+func (*StationReq) Descriptor() ([]byte, []int) {
 	return file_cron_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *AddHolidaysReq) GetAddress() string {
+func (x *StationReq) GetProvince() string {
 	if x != nil {
-		return x.Address
+		return x.Province
 	}
 	return ""
 }
 
-func (x *AddHolidaysReq) GetHolidays() []*Holiday {
+func (x *StationReq) GetCity() string {
 	if x != nil {
-		return x.Holidays
+		return x.City
 	}
-	return nil
+	return ""
 }
 
-type PriceReq struct {
+func (x *StationReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type StationRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Category string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"` // 用电类别，例："福建>工商业,两部制>1-10（20）千伏"
-	Time     string `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`         // 时间节点，例："2025-08-01 00:00:00"
+	Province string `protobuf:"bytes,1,opt,name=province,proto3" json:"province,omitempty"` // 映射省级名称
+	City     string `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`         // 映射市级名称
+	Code     string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`         // 气象站代码
+	Manual   bool   `protobuf:"varint,4,opt,name=manual,proto3" json:"manual,omitempty"`    // 是否人工指定
 }
 
-func (x *PriceReq) Reset() {
-	*x = PriceReq{}
+func (x *StationRsp) Reset() {
+	*x = StationRsp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cron_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1005,13 +1231,13 @@ func (x *PriceReq) Reset() {
 	}
 }
 
-func (x *PriceReq) String() string {
+func (x *StationRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PriceReq) ProtoMessage() {}
+func (*StationRsp) ProtoMessage() {}
 
-func (x *PriceReq) ProtoReflect() protoreflect.Message {
+func (x *StationRsp) ProtoReflect() protoreflect.Message {
 	mi := &file_cron_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1023,38 +1249,50 @@ func (x *PriceReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PriceReq.ProtoReflect.Descriptor instead.
-func (*PriceReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use StationRsp.ProtoReflect.Descriptor instead.
+func (*StationRsp) Descriptor() ([]byte, []int) {
 	return file_cron_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *PriceReq) GetCategory() string {
+func (x *StationRsp) GetProvince() string {
 	if x != nil {
-		return x.Category
+		return x.Province
 	}
 	return ""
 }
 
-func (x *PriceReq) GetTime() string {
+func (x *StationRsp) GetCity() string {
 	if x != nil {
-		return x.Time
+		return x.City
 	}
 	return ""
 }
 
-type PriceRsp struct {
+func (x *StationRsp) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *StationRsp) GetManual() bool {
+	if x != nil {
+		return x.Manual
+	}
+	return false
+}
+
+type HolidaysReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`     // 所属时段，例："flat"
-	Price float64 `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"` // 时段电价，例：1.0
-	Desc  string  `protobuf:"bytes,3,opt,name=desc,proto3" json:"desc,omitempty"`     // 时段描述，例："平段"
-	Color string  `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`   // 时段色块，例："#3498DB"
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // 用户地址
+	Year    int64  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`      //
 }
 
-func (x *PriceRsp) Reset() {
-	*x = PriceRsp{}
+func (x *HolidaysReq) Reset() {
+	*x = HolidaysReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cron_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1062,13 +1300,13 @@ func (x *PriceRsp) Reset() {
 	}
 }
 
-func (x *PriceRsp) String() string {
+func (x *HolidaysReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PriceRsp) ProtoMessage() {}
+func (*HolidaysReq) ProtoMessage() {}
 
-func (x *PriceRsp) ProtoReflect() protoreflect.Message {
+func (x *HolidaysReq) ProtoReflect() protoreflect.Message {
 	mi := &file_cron_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1080,53 +1318,38 @@ func (x *PriceRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PriceRsp.ProtoReflect.Descriptor instead.
-func (*PriceRsp) Descriptor() ([]byte, []int) {
+// Deprecated: Use HolidaysReq.ProtoReflect.Descriptor instead.
+func (*HolidaysReq) Descriptor() ([]byte, []int) {
 	return file_cron_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *PriceRsp) GetName() string {
+func (x *HolidaysReq) GetAddress() string {
 	if x != nil {
-		return x.Name
+		return x.Address
 	}
 	return ""
 }
 
-func (x *PriceRsp) GetPrice() float64 {
+func (x *HolidaysReq) GetYear() int64 {
 	if x != nil {
-		return x.Price
+		return x.Year
 	}
 	return 0
 }
 
-func (x *PriceRsp) GetDesc() string {
-	if x != nil {
-		return x.Desc
-	}
-	return ""
-}
-
-func (x *PriceRsp) GetColor() string {
-	if x != nil {
-		return x.Color
-	}
-	return ""
-}
-
-type BillReq struct {
+type Holiday struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account string    `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`        // 工程ID
-	Area    string    `protobuf:"bytes,2,opt,name=area,proto3" json:"area,omitempty"`              // 工程区域，可选区域名、支路名或设备ID等关键字，sql用like查询
-	Month   string    `protobuf:"bytes,3,opt,name=month,proto3" json:"month,omitempty"`            // 按月查询，例："2025-08"
-	Ep30Ms  []float64 `protobuf:"fixed64,4,rep,packed,name=ep30ms,proto3" json:"ep30ms,omitempty"` // 30min有功电能列表
-	EqTotal float64   `protobuf:"fixed64,5,opt,name=eqTotal,proto3" json:"eqTotal,omitempty"`      // 总无功电能，计算功率因素调整电费
+	Id       int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`            //
+	Date     string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`         //
+	Category string `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"` // 假日类别：假日、调休工作日
+	Detail   string `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`     //
 }
 
-func (x *BillReq) Reset() {
-	*x = BillReq{}
+func (x *Holiday) Reset() {
+	*x = Holiday{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cron_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1134,13 +1357,13 @@ func (x *BillReq) Reset() {
 	}
 }
 
-func (x *BillReq) String() string {
+func (x *Holiday) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BillReq) ProtoMessage() {}
+func (*Holiday) ProtoMessage() {}
 
-func (x *BillReq) ProtoReflect() protoreflect.Message {
+func (x *Holiday) ProtoReflect() protoreflect.Message {
 	mi := &file_cron_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1152,60 +1375,49 @@ func (x *BillReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BillReq.ProtoReflect.Descriptor instead.
-func (*BillReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use Holiday.ProtoReflect.Descriptor instead.
+func (*Holiday) Descriptor() ([]byte, []int) {
 	return file_cron_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *BillReq) GetAccount() string {
+func (x *Holiday) GetId() int64 {
 	if x != nil {
-		return x.Account
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *BillReq) GetArea() string {
+func (x *Holiday) GetDate() string {
 	if x != nil {
-		return x.Area
+		return x.Date
 	}
 	return ""
 }
 
-func (x *BillReq) GetMonth() string {
+func (x *Holiday) GetCategory() string {
 	if x != nil {
-		return x.Month
+		return x.Category
 	}
 	return ""
 }
 
-func (x *BillReq) GetEp30Ms() []float64 {
-	if x != nil {
-		return x.Ep30Ms
-	}
-	return nil
-}
-
-func (x *BillReq) GetEqTotal() float64 {
+func (x *Holiday) GetDetail() string {
 	if x != nil {
-		return x.EqTotal
+		return x.Detail
 	}
-	return 0
+	return ""
 }
 
-type BillDetail struct {
+type HolidaysRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`     // 所属时段，例："flat"
-	Price float64 `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"` // 时段电价，例：1.0
-	Desc  string  `protobuf:"bytes,3,opt,name=desc,proto3" json:"desc,omitempty"`     // 时段描述，例："平段"
-	Color string  `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`   // 时段色块，例："#3498DB"
-	Usage float64 `protobuf:"fixed64,5,opt,name=usage,proto3" json:"usage,omitempty"` // 所用电量，例：100.0
+	Holidays []*Holiday `protobuf:"bytes,1,rep,name=holidays,proto3" json:"holidays,omitempty"` //
 }
 
-func (x *BillDetail) Reset() {
-	*x = BillDetail{}
+func (x *HolidaysRsp) Reset() {
+	*x = HolidaysRsp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cron_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1213,13 +1425,13 @@ func (x *BillDetail) Reset() {
 	}
 }
 
-func (x *BillDetail) String() string {
+func (x *HolidaysRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BillDetail) ProtoMessage() {}
+func (*HolidaysRsp) ProtoMessage() {}
 
-func (x *BillDetail) ProtoReflect() protoreflect.Message {
+func (x *HolidaysRsp) ProtoReflect() protoreflect.Message {
 	mi := &file_cron_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1231,62 +1443,29 @@ func (x *BillDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BillDetail.ProtoReflect.Descriptor instead.
-func (*BillDetail) Descriptor() ([]byte, []int) {
+// Deprecated: Use HolidaysRsp.ProtoReflect.Descriptor instead.
+func (*HolidaysRsp) Descriptor() ([]byte, []int) {
 	return file_cron_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *BillDetail) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *BillDetail) GetPrice() float64 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
-
-func (x *BillDetail) GetDesc() string {
-	if x != nil {
-		return x.Desc
-	}
-	return ""
-}
-
-func (x *BillDetail) GetColor() string {
-	if x != nil {
-		return x.Color
-	}
-	return ""
-}
-
-func (x *BillDetail) GetUsage() float64 {
+func (x *HolidaysRsp) GetHolidays() []*Holiday {
 	if x != nil {
-		return x.Usage
+		return x.Holidays
 	}
-	return 0
+	return nil
 }
 
-type BillRsp struct {
+type AddHolidaysReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fee      float64       `protobuf:"fixed64,1,opt,name=fee,proto3" json:"fee,omitempty"`           // 本期电费
-	BasicFee float64       `protobuf:"fixed64,2,opt,name=basicFee,proto3" json:"basicFee,omitempty"` // 基础电费
-	UsageFee float64       `protobuf:"fixed64,3,opt,name=usageFee,proto3" json:"usageFee,omitempty"` // 电量电费
-	PfFee    float64       `protobuf:"fixed64,4,opt,name=pfFee,proto3" json:"pfFee,omitempty"`       // 功率因素调整电费
-	StageFee float64       `protobuf:"fixed64,5,opt,name=stageFee,proto3" json:"stageFee,omitempty"` // 阶梯电费
-	Usage    float64       `protobuf:"fixed64,6,opt,name=usage,proto3" json:"usage,omitempty"`       // 本期电量
-	Details  []*BillDetail `protobuf:"bytes,7,rep,name=details,proto3" json:"details,omitempty"`     // 峰谷分时电量电费列表
+	Address  string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`   // 用户地址
+	Holidays []*Holiday `protobuf:"bytes,2,rep,name=holidays,proto3" json:"holidays,omitempty"` //
 }
 
-func (x *BillRsp) Reset() {
-	*x = BillRsp{}
+func (x *AddHolidaysReq) Reset() {
+	*x = AddHolidaysReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cron_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1294,13 +1473,13 @@ func (x *BillRsp) Reset() {
 	}
 }
 
-func (x *BillRsp) String() string {
+func (x *AddHolidaysReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BillRsp) ProtoMessage() {}
+func (*AddHolidaysReq) ProtoMessage() {}
 
-func (x *BillRsp) ProtoReflect() protoreflect.Message {
+func (x *AddHolidaysReq) ProtoReflect() protoreflect.Message {
 	mi := &file_cron_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1312,70 +1491,36 @@ func (x *BillRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BillRsp.ProtoReflect.Descriptor instead.
-func (*BillRsp) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddHolidaysReq.ProtoReflect.Descriptor instead.
+func (*AddHolidaysReq) Descriptor() ([]byte, []int) {
 	return file_cron_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *BillRsp) GetFee() float64 {
-	if x != nil {
-		return x.Fee
-	}
-	return 0
-}
-
-func (x *BillRsp) GetBasicFee() float64 {
-	if x != nil {
-		return x.BasicFee
-	}
-	return 0
-}
-
-func (x *BillRsp) GetUsageFee() float64 {
-	if x != nil {
-		return x.UsageFee
-	}
-	return 0
-}
-
-func (x *BillRsp) GetPfFee() float64 {
-	if x != nil {
-		return x.PfFee
-	}
-	return 0
-}
-
-func (x *BillRsp) GetStageFee() float64 {
-	if x != nil {
-		return x.StageFee
-	}
-	return 0
-}
-
-func (x *BillRsp) GetUsage() float64 {
+func (x *AddHolidaysReq) GetAddress() string {
 	if x != nil {
-		return x.Usage
+		return x.Address
 	}
-	return 0
+	return ""
 }
 
-func (x *BillRsp) GetDetails() []*BillDetail {
+func (x *AddHolidaysReq) GetHolidays() []*Holiday {
 	if x != nil {
-		return x.Details
+		return x.Holidays
 	}
 	return nil
 }
 
-type AvailableOptionsReq struct {
+type PriceReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // 工程地址，需包含省份、城市、区县信息，示例："福建省厦门市集美区孙坂南路92号"
+	Category string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"` // 用电类别，例："福建>工商业,两部制>1-10（20）千伏"
+	Time     string `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`         // 时间节点，例："2025-08-01 00:00:00"
 }
 
-func (x *AvailableOptionsReq) Reset() {
-	*x = AvailableOptionsReq{}
+func (x *PriceReq) Reset() {
+	*x = PriceReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cron_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1383,13 +1528,13 @@ func (x *AvailableOptionsReq) Reset() {
 	}
 }
 
-func (x *AvailableOptionsReq) String() string {
+func (x *PriceReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AvailableOptionsReq) ProtoMessage() {}
+func (*PriceReq) ProtoMessage() {}
 
-func (x *AvailableOptionsReq) ProtoReflect() protoreflect.Message {
+func (x *PriceReq) ProtoReflect() protoreflect.Message {
 	mi := &file_cron_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1401,29 +1546,38 @@ func (x *AvailableOptionsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AvailableOptionsReq.ProtoReflect.Descriptor instead.
-func (*AvailableOptionsReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use PriceReq.ProtoReflect.Descriptor instead.
+func (*PriceReq) Descriptor() ([]byte, []int) {
 	return file_cron_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *AvailableOptionsReq) GetAddress() string {
+func (x *PriceReq) GetCategory() string {
 	if x != nil {
-		return x.Address
+		return x.Category
 	}
 	return ""
 }
 
-type AvailableOptionsRsp struct {
+func (x *PriceReq) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type PriceRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Categories   []string  `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`              // 用电类别列表，示例：["福建>工商业,两部制>1-10（20）千伏"]
-	PowerFactors []float64 `protobuf:"fixed64,2,rep,packed,name=powerFactors,proto3" json:"powerFactors,omitempty"` // 功率因素标准值列表，示例：[0.8, 0.85, 0.9]
+	Name  string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`     // 所属时段，例："flat"
+	Price float64 `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"` // 时段电价，例：1.0
+	Desc  string  `protobuf:"bytes,3,opt,name=desc,proto3" json:"desc,omitempty"`     // 时段描述，例："平段"
+	Color string  `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`   // 时段色块，例："#3498DB"
 }
 
-func (x *AvailableOptionsRsp) Reset() {
-	*x = AvailableOptionsRsp{}
+func (x *PriceRsp) Reset() {
+	*x = PriceRsp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cron_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1431,13 +1585,13 @@ func (x *AvailableOptionsRsp) Reset() {
 	}
 }
 
-func (x *AvailableOptionsRsp) String() string {
+func (x *PriceRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AvailableOptionsRsp) ProtoMessage() {}
+func (*PriceRsp) ProtoMessage() {}
 
-func (x *AvailableOptionsRsp) ProtoReflect() protoreflect.Message {
+func (x *PriceRsp) ProtoReflect() protoreflect.Message {
 	mi := &file_cron_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1449,36 +1603,51 @@ func (x *AvailableOptionsRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AvailableOptionsRsp.ProtoReflect.Descriptor instead.
-func (*AvailableOptionsRsp) Descriptor() ([]byte, []int) {
+// Deprecated: Use PriceRsp.ProtoReflect.Descriptor instead.
+func (*PriceRsp) Descriptor() ([]byte, []int) {
 	return file_cron_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *AvailableOptionsRsp) GetCategories() []string {
+func (x *PriceRsp) GetName() string {
 	if x != nil {
-		return x.Categories
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *AvailableOptionsRsp) GetPowerFactors() []float64 {
+func (x *PriceRsp) GetPrice() float64 {
 	if x != nil {
-		return x.PowerFactors
+		return x.Price
 	}
-	return nil
+	return 0
 }
 
-type GetUserOptionReq struct {
-	state         protoimpl.MessageState
+func (x *PriceRsp) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
+func (x *PriceRsp) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+type PriceEventsReq struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"` // 工程ID
-	Area    string `protobuf:"bytes,2,opt,name=area,proto3" json:"area,omitempty"`       // 工程区域，可选区域名、支路名或设备ID等关键字，sql用like查询
+	Area    string `protobuf:"bytes,2,opt,name=area,proto3" json:"area,omitempty"`       // 工程区域，sql用like查询，缺省为全部区域
+	Date    string `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`       // 起始日期(含)，缺省为当日
 }
 
-func (x *GetUserOptionReq) Reset() {
-	*x = GetUserOptionReq{}
+func (x *PriceEventsReq) Reset() {
+	*x = PriceEventsReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cron_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1486,13 +1655,13 @@ func (x *GetUserOptionReq) Reset() {
 	}
 }
 
-func (x *GetUserOptionReq) String() string {
+func (x *PriceEventsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserOptionReq) ProtoMessage() {}
+func (*PriceEventsReq) ProtoMessage() {}
 
-func (x *GetUserOptionReq) ProtoReflect() protoreflect.Message {
+func (x *PriceEventsReq) ProtoReflect() protoreflect.Message {
 	mi := &file_cron_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1504,47 +1673,50 @@ func (x *GetUserOptionReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserOptionReq.ProtoReflect.Descriptor instead.
-func (*GetUserOptionReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use PriceEventsReq.ProtoReflect.Descriptor instead.
+func (*PriceEventsReq) Descriptor() ([]byte, []int) {
 	return file_cron_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetUserOptionReq) GetAccount() string {
+func (x *PriceEventsReq) GetAccount() string {
 	if x != nil {
 		return x.Account
 	}
 	return ""
 }
 
-func (x *GetUserOptionReq) GetArea() string {
+func (x *PriceEventsReq) GetArea() string {
 	if x != nil {
 		return x.Area
 	}
 	return ""
 }
 
-type UserOptionBody struct {
+func (x *PriceEventsReq) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type PriceEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account        string  `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`                  // 工程ID
-	Area           string  `protobuf:"bytes,2,opt,name=area,proto3" json:"area,omitempty"`                        // 工程区域
-	Category       string  `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`                // 用电类别
-	PowerFactor    float64 `protobuf:"fixed64,4,opt,name=powerFactor,proto3" json:"powerFactor,omitempty"`        // [大陆]功率因素标准
-	Capacity       float64 `protobuf:"fixed64,5,opt,name=capacity,proto3" json:"capacity,omitempty"`              // [大陆]合同容量
-	Demand         float64 `protobuf:"fixed64,6,opt,name=demand,proto3" json:"demand,omitempty"`                  // [大陆]合同需量
-	InstalledCap   float64 `protobuf:"fixed64,7,opt,name=installedCap,proto3" json:"installedCap,omitempty"`      // [台湾]装置契约
-	RegularCap     float64 `protobuf:"fixed64,8,opt,name=regularCap,proto3" json:"regularCap,omitempty"`          // [台湾]常规契约
-	NonSummerCap   float64 `protobuf:"fixed64,9,opt,name=nonSummerCap,proto3" json:"nonSummerCap,omitempty"`      // [台湾]非夏季契约
-	SemiPeakCap    float64 `protobuf:"fixed64,10,opt,name=semiPeakCap,proto3" json:"semiPeakCap,omitempty"`       // [台湾]半尖峰契约
-	SatSemiPeakCap float64 `protobuf:"fixed64,11,opt,name=satSemiPeakCap,proto3" json:"satSemiPeakCap,omitempty"` // [台湾]周六半尖峰契约
-	OffPeakCap     float64 `protobuf:"fixed64,12,opt,name=offPeakCap,proto3" json:"offPeakCap,omitempty"`         // [台湾]离峰契约
-	Id             int64   `protobuf:"varint,13,opt,name=id,proto3" json:"id,omitempty"`                          //
+	Area        string  `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`                // 工程区域
+	Category    string  `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`        // 用电类别
+	Date        string  `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`                // 触发日期
+	Name        string  `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`                // 触发时段，例："sharp"
+	Desc        string  `protobuf:"bytes,5,opt,name=desc,proto3" json:"desc,omitempty"`                // 时段描述，例："尖段"
+	Hour        string  `protobuf:"bytes,6,opt,name=hour,proto3" json:"hour,omitempty"`                // 时段范围，例："1100-1200,1500-1700"
+	Price       float64 `protobuf:"fixed64,7,opt,name=price,proto3" json:"price,omitempty"`            // 时段电价
+	Rule        string  `protobuf:"bytes,8,opt,name=rule,proto3" json:"rule,omitempty"`                // 气温触发条件，例："temp:35,max@广州"
+	Provisional bool    `protobuf:"varint,9,opt,name=provisional,proto3" json:"provisional,omitempty"` // 依据天气预报，待实测确认
 }
 
-func (x *UserOptionBody) Reset() {
-	*x = UserOptionBody{}
+func (x *PriceEvent) Reset() {
+	*x = PriceEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cron_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1552,13 +1724,13 @@ func (x *UserOptionBody) Reset() {
 	}
 }
 
-func (x *UserOptionBody) String() string {
+func (x *PriceEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserOptionBody) ProtoMessage() {}
+func (*PriceEvent) ProtoMessage() {}
 
-func (x *UserOptionBody) ProtoReflect() protoreflect.Message {
+func (x *PriceEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_cron_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1570,113 +1742,84 @@ func (x *UserOptionBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserOptionBody.ProtoReflect.Descriptor instead.
-func (*UserOptionBody) Descriptor() ([]byte, []int) {
+// Deprecated: Use PriceEvent.ProtoReflect.Descriptor instead.
+func (*PriceEvent) Descriptor() ([]byte, []int) {
 	return file_cron_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *UserOptionBody) GetAccount() string {
-	if x != nil {
-		return x.Account
-	}
-	return ""
-}
-
-func (x *UserOptionBody) GetArea() string {
+func (x *PriceEvent) GetArea() string {
 	if x != nil {
 		return x.Area
 	}
 	return ""
 }
 
-func (x *UserOptionBody) GetCategory() string {
+func (x *PriceEvent) GetCategory() string {
 	if x != nil {
 		return x.Category
 	}
 	return ""
 }
 
-func (x *UserOptionBody) GetPowerFactor() float64 {
-	if x != nil {
-		return x.PowerFactor
-	}
-	return 0
-}
-
-func (x *UserOptionBody) GetCapacity() float64 {
-	if x != nil {
-		return x.Capacity
-	}
-	return 0
-}
-
-func (x *UserOptionBody) GetDemand() float64 {
-	if x != nil {
-		return x.Demand
-	}
-	return 0
-}
-
-func (x *UserOptionBody) GetInstalledCap() float64 {
+func (x *PriceEvent) GetDate() string {
 	if x != nil {
-		return x.InstalledCap
+		return x.Date
 	}
-	return 0
+	return ""
 }
 
-func (x *UserOptionBody) GetRegularCap() float64 {
+func (x *PriceEvent) GetName() string {
 	if x != nil {
-		return x.RegularCap
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *UserOptionBody) GetNonSummerCap() float64 {
+func (x *PriceEvent) GetDesc() string {
 	if x != nil {
-		return x.NonSummerCap
+		return x.Desc
 	}
-	return 0
+	return ""
 }
 
-func (x *UserOptionBody) GetSemiPeakCap() float64 {
+func (x *PriceEvent) GetHour() string {
 	if x != nil {
-		return x.SemiPeakCap
+		return x.Hour
 	}
-	return 0
+	return ""
 }
 
-func (x *UserOptionBody) GetSatSemiPeakCap() float64 {
+func (x *PriceEvent) GetPrice() float64 {
 	if x != nil {
-		return x.SatSemiPeakCap
+		return x.Price
 	}
 	return 0
 }
 
-func (x *UserOptionBody) GetOffPeakCap() float64 {
+func (x *PriceEvent) GetRule() string {
 	if x != nil {
-		return x.OffPeakCap
+		return x.Rule
 	}
-	return 0
+	return ""
 }
 
-func (x *UserOptionBody) GetId() int64 {
+func (x *PriceEvent) GetProvisional() bool {
 	if x != nil {
-		return x.Id
+		return x.Provisional
 	}
-	return 0
+	return false
 }
 
-type AddDlgdHourReq struct {
+type PriceEventsRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Area    string `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`       // 区域
-	Comment string `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"` // 原始文本
+	Events []*PriceEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"` //
 }
 
-func (x *AddDlgdHourReq) Reset() {
-	*x = AddDlgdHourReq{}
+func (x *PriceEventsRsp) Reset() {
+	*x = PriceEventsRsp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cron_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1684,13 +1827,13 @@ func (x *AddDlgdHourReq) Reset() {
 	}
 }
 
-func (x *AddDlgdHourReq) String() string {
+func (x *PriceEventsRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddDlgdHourReq) ProtoMessage() {}
+func (*PriceEventsRsp) ProtoMessage() {}
 
-func (x *AddDlgdHourReq) ProtoReflect() protoreflect.Message {
+func (x *PriceEventsRsp) ProtoReflect() protoreflect.Message {
 	mi := &file_cron_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1702,36 +1845,36 @@ func (x *AddDlgdHourReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddDlgdHourReq.ProtoReflect.Descriptor instead.
-func (*AddDlgdHourReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use PriceEventsRsp.ProtoReflect.Descriptor instead.
+func (*PriceEventsRsp) Descriptor() ([]byte, []int) {
 	return file_cron_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *AddDlgdHourReq) GetArea() string {
-	if x != nil {
-		return x.Area
-	}
-	return ""
-}
-
-func (x *AddDlgdHourReq) GetComment() string {
+func (x *PriceEventsRsp) GetEvents() []*PriceEvent {
 	if x != nil {
-		return x.Comment
+		return x.Events
 	}
-	return ""
+	return nil
 }
 
-type DlgdHourReq struct {
+type BillReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Area  string `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`   // 区域
-	DocNo string `protobuf:"bytes,2,opt,name=docNo,proto3" json:"docNo,omitempty"` // 政策文号
+	Account     string          `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`         // 工程ID
+	Area        string          `protobuf:"bytes,2,opt,name=area,proto3" json:"area,omitempty"`               // 工程区域，可选区域名、支路名或设备ID等关键字，sql用like查询
+	Month       string          `protobuf:"bytes,3,opt,name=month,proto3" json:"month,omitempty"`             // 按月查询，例："2025-08"
+	Ep30Ms      []float64       `protobuf:"fixed64,4,rep,packed,name=ep30ms,proto3" json:"ep30ms,omitempty"`  // 30min有功电能列表
+	EqTotal     float64         `protobuf:"fixed64,5,opt,name=eqTotal,proto3" json:"eqTotal,omitempty"`       // 总无功电能，计算功率因素调整电费
+	Readings    []*MeterReading `protobuf:"bytes,6,rep,name=readings,proto3" json:"readings,omitempty"`       // 带时间戳的有功读数，ep30ms为空时转换为30min网格
+	ReadingKind string          `protobuf:"bytes,7,opt,name=readingKind,proto3" json:"readingKind,omitempty"` // 读数类型：interval(区间电量，默认)、cumulative(累计表码)
+	GapPolicy   string          `protobuf:"bytes,8,opt,name=gapPolicy,proto3" json:"gapPolicy,omitempty"`     // 缺数补齐策略：linear(线性插值，默认)、previous(沿用前值)、zero(补零)
+	MeterMax    float64         `protobuf:"fixed64,9,opt,name=meterMax,proto3" json:"meterMax,omitempty"`     // 累计表码满量程，用于翻转处理，缺省时按表码位数推算
 }
 
-func (x *DlgdHourReq) Reset() {
-	*x = DlgdHourReq{}
+func (x *BillReq) Reset() {
+	*x = BillReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cron_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1739,13 +1882,13 @@ func (x *DlgdHourReq) Reset() {
 	}
 }
 
-func (x *DlgdHourReq) String() string {
+func (x *BillReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DlgdHourReq) ProtoMessage() {}
+func (*BillReq) ProtoMessage() {}
 
-func (x *DlgdHourReq) ProtoReflect() protoreflect.Message {
+func (x *BillReq) ProtoReflect() protoreflect.Message {
 	mi := &file_cron_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1757,44 +1900,86 @@ func (x *DlgdHourReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DlgdHourReq.ProtoReflect.Descriptor instead.
-func (*DlgdHourReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use BillReq.ProtoReflect.Descriptor instead.
+func (*BillReq) Descriptor() ([]byte, []int) {
 	return file_cron_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *DlgdHourReq) GetArea() string {
+func (x *BillReq) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *BillReq) GetArea() string {
 	if x != nil {
 		return x.Area
 	}
 	return ""
 }
 
-func (x *DlgdHourReq) GetDocNo() string {
+func (x *BillReq) GetMonth() string {
 	if x != nil {
-		return x.DocNo
+		return x.Month
 	}
 	return ""
 }
 
-type DlgdHour struct {
+func (x *BillReq) GetEp30Ms() []float64 {
+	if x != nil {
+		return x.Ep30Ms
+	}
+	return nil
+}
+
+func (x *BillReq) GetEqTotal() float64 {
+	if x != nil {
+		return x.EqTotal
+	}
+	return 0
+}
+
+func (x *BillReq) GetReadings() []*MeterReading {
+	if x != nil {
+		return x.Readings
+	}
+	return nil
+}
+
+func (x *BillReq) GetReadingKind() string {
+	if x != nil {
+		return x.ReadingKind
+	}
+	return ""
+}
+
+func (x *BillReq) GetGapPolicy() string {
+	if x != nil {
+		return x.GapPolicy
+	}
+	return ""
+}
+
+func (x *BillReq) GetMeterMax() float64 {
+	if x != nil {
+		return x.MeterMax
+	}
+	return 0
+}
+
+type MeterReading struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Area          string `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`                   // 区域
-	DocNo         string `protobuf:"bytes,2,opt,name=docNo,proto3" json:"docNo,omitempty"`                 // 政策文号
-	Confirm       int64  `protobuf:"varint,3,opt,name=confirm,proto3" json:"confirm,omitempty"`            // 确认有效
-	Name          string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`                   // 时段名称
-	Value         string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`                 // 时段值
-	Temp          string `protobuf:"bytes,6,opt,name=temp,proto3" json:"temp,omitempty"`                   // 温度条件，如：其他月份中广州日最高气温达到35℃
-	Months        string `protobuf:"bytes,7,opt,name=months,proto3" json:"months,omitempty"`               // 月份条件
-	WeekendMonths string `protobuf:"bytes,8,opt,name=weekendMonths,proto3" json:"weekendMonths,omitempty"` // 月份-休息日条件
-	Holidays      string `protobuf:"bytes,9,opt,name=holidays,proto3" json:"holidays,omitempty"`           // 节假日条件，如：春节,劳动节,国庆节
-	Categories    string `protobuf:"bytes,10,opt,name=categories,proto3" json:"categories,omitempty"`      // 用电类别条件，如：容量315千伏安及以上,两部制
+	Time     string  `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`          // 区间起点或抄表时刻，例："2025-08-01 00:30:00"
+	Value    float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`      // 区间电量或累计表码
+	Replaced bool    `protobuf:"varint,3,opt,name=replaced,proto3" json:"replaced,omitempty"` // 换表后首个读数，累计表码自此重新起算
 }
 
-func (x *DlgdHour) Reset() {
-	*x = DlgdHour{}
+func (x *MeterReading) Reset() {
+	*x = MeterReading{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cron_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1802,13 +1987,13 @@ func (x *DlgdHour) Reset() {
 	}
 }
 
-func (x *DlgdHour) String() string {
+func (x *MeterReading) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DlgdHour) ProtoMessage() {}
+func (*MeterReading) ProtoMessage() {}
 
-func (x *DlgdHour) ProtoReflect() protoreflect.Message {
+func (x *MeterReading) ProtoReflect() protoreflect.Message {
 	mi := &file_cron_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1820,498 +2005,3661 @@ func (x *DlgdHour) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DlgdHour.ProtoReflect.Descriptor instead.
-func (*DlgdHour) Descriptor() ([]byte, []int) {
+// Deprecated: Use MeterReading.ProtoReflect.Descriptor instead.
+func (*MeterReading) Descriptor() ([]byte, []int) {
 	return file_cron_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *DlgdHour) GetArea() string {
-	if x != nil {
-		return x.Area
-	}
-	return ""
-}
-
-func (x *DlgdHour) GetDocNo() string {
+func (x *MeterReading) GetTime() string {
 	if x != nil {
-		return x.DocNo
+		return x.Time
 	}
 	return ""
 }
 
-func (x *DlgdHour) GetConfirm() int64 {
+func (x *MeterReading) GetValue() float64 {
 	if x != nil {
-		return x.Confirm
+		return x.Value
 	}
 	return 0
 }
 
-func (x *DlgdHour) GetName() string {
+func (x *MeterReading) GetReplaced() bool {
 	if x != nil {
-		return x.Name
+		return x.Replaced
 	}
-	return ""
+	return false
 }
 
-func (x *DlgdHour) GetValue() string {
-	if x != nil {
-		return x.Value
-	}
-	return ""
-}
+type BillDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *DlgdHour) GetTemp() string {
-	if x != nil {
-		return x.Temp
-	}
-	return ""
+	Name  string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`     // 所属时段，例："flat"
+	Price float64 `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"` // 时段电价，例：1.0
+	Desc  string  `protobuf:"bytes,3,opt,name=desc,proto3" json:"desc,omitempty"`     // 时段描述，例："平段"
+	Color string  `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`   // 时段色块，例："#3498DB"
+	Usage float64 `protobuf:"fixed64,5,opt,name=usage,proto3" json:"usage,omitempty"` // 所用电量，例：100.0
 }
 
-func (x *DlgdHour) GetMonths() string {
-	if x != nil {
-		return x.Months
+func (x *BillDetail) Reset() {
+	*x = BillDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cron_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *DlgdHour) GetWeekendMonths() string {
-	if x != nil {
-		return x.WeekendMonths
-	}
-	return ""
+func (x *BillDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DlgdHour) GetHolidays() string {
+func (*BillDetail) ProtoMessage() {}
+
+func (x *BillDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_cron_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BillDetail.ProtoReflect.Descriptor instead.
+func (*BillDetail) Descriptor() ([]byte, []int) {
+	return file_cron_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BillDetail) GetName() string {
 	if x != nil {
-		return x.Holidays
+		return x.Name
 	}
 	return ""
 }
 
-func (x *DlgdHour) GetCategories() string {
+func (x *BillDetail) GetPrice() float64 {
 	if x != nil {
-		return x.Categories
+		return x.Price
+	}
+	return 0
+}
+
+func (x *BillDetail) GetDesc() string {
+	if x != nil {
+		return x.Desc
 	}
 	return ""
 }
 
-type DlgdHoursRsp struct {
+func (x *BillDetail) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *BillDetail) GetUsage() float64 {
+	if x != nil {
+		return x.Usage
+	}
+	return 0
+}
+
+type BillRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hours []*DlgdHour `protobuf:"bytes,1,rep,name=hours,proto3" json:"hours,omitempty"` // 用电时段列表
+	Fee            float64       `protobuf:"fixed64,1,opt,name=fee,proto3" json:"fee,omitempty"`                        // 本期电费
+	BasicFee       float64       `protobuf:"fixed64,2,opt,name=basicFee,proto3" json:"basicFee,omitempty"`              // 基础电费
+	UsageFee       float64       `protobuf:"fixed64,3,opt,name=usageFee,proto3" json:"usageFee,omitempty"`              // 电量电费
+	PfFee          float64       `protobuf:"fixed64,4,opt,name=pfFee,proto3" json:"pfFee,omitempty"`                    // 功率因素调整电费
+	StageFee       float64       `protobuf:"fixed64,5,opt,name=stageFee,proto3" json:"stageFee,omitempty"`              // 阶梯电费
+	Usage          float64       `protobuf:"fixed64,6,opt,name=usage,proto3" json:"usage,omitempty"`                    // 本期电量
+	Details        []*BillDetail `protobuf:"bytes,7,rep,name=details,proto3" json:"details,omitempty"`                  // 峰谷分时电量电费列表
+	EstimatedSize  int64         `protobuf:"varint,8,opt,name=estimatedSize,proto3" json:"estimatedSize,omitempty"`     // 估算点数(30min)，仅readings有效
+	EstimatedRatio float64       `protobuf:"fixed64,9,opt,name=estimatedRatio,proto3" json:"estimatedRatio,omitempty"`  // 估算点数占比
+	EstimatedUsage float64       `protobuf:"fixed64,10,opt,name=estimatedUsage,proto3" json:"estimatedUsage,omitempty"` // 估算电量
+	RolloverSize   int64         `protobuf:"varint,11,opt,name=rolloverSize,proto3" json:"rolloverSize,omitempty"`      // 表码翻转次数
+	ReplaceSize    int64         `protobuf:"varint,12,opt,name=replaceSize,proto3" json:"replaceSize,omitempty"`        // 换表次数
+	BackwardSize   int64         `protobuf:"varint,13,opt,name=backwardSize,proto3" json:"backwardSize,omitempty"`      // 表码回退次数，区间电量按缺数补齐
+	UncoveredSize  int64         `protobuf:"varint,14,opt,name=uncoveredSize,proto3" json:"uncoveredSize,omitempty"`    // 首个读数之前、末个读数之后的无数据点数(30min)，不计费
 }
 
-func (x *DlgdHoursRsp) Reset() {
-	*x = DlgdHoursRsp{}
+func (x *BillRsp) Reset() {
+	*x = BillRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cron_proto_msgTypes[29]
+		mi := &file_cron_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DlgdHoursRsp) String() string {
+func (x *BillRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DlgdHoursRsp) ProtoMessage() {}
+func (*BillRsp) ProtoMessage() {}
 
-func (x *DlgdHoursRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_cron_proto_msgTypes[29]
+func (x *BillRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_cron_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BillRsp.ProtoReflect.Descriptor instead.
+func (*BillRsp) Descriptor() ([]byte, []int) {
+	return file_cron_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BillRsp) GetFee() float64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *BillRsp) GetBasicFee() float64 {
+	if x != nil {
+		return x.BasicFee
+	}
+	return 0
+}
+
+func (x *BillRsp) GetUsageFee() float64 {
+	if x != nil {
+		return x.UsageFee
+	}
+	return 0
+}
+
+func (x *BillRsp) GetPfFee() float64 {
+	if x != nil {
+		return x.PfFee
+	}
+	return 0
+}
+
+func (x *BillRsp) GetStageFee() float64 {
+	if x != nil {
+		return x.StageFee
+	}
+	return 0
+}
+
+func (x *BillRsp) GetUsage() float64 {
+	if x != nil {
+		return x.Usage
+	}
+	return 0
+}
+
+func (x *BillRsp) GetDetails() []*BillDetail {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *BillRsp) GetEstimatedSize() int64 {
+	if x != nil {
+		return x.EstimatedSize
+	}
+	return 0
+}
+
+func (x *BillRsp) GetEstimatedRatio() float64 {
+	if x != nil {
+		return x.EstimatedRatio
+	}
+	return 0
+}
+
+func (x *BillRsp) GetEstimatedUsage() float64 {
+	if x != nil {
+		return x.EstimatedUsage
+	}
+	return 0
+}
+
+func (x *BillRsp) GetRolloverSize() int64 {
+	if x != nil {
+		return x.RolloverSize
+	}
+	return 0
+}
+
+func (x *BillRsp) GetReplaceSize() int64 {
+	if x != nil {
+		return x.ReplaceSize
+	}
+	return 0
+}
+
+func (x *BillRsp) GetBackwardSize() int64 {
+	if x != nil {
+		return x.BackwardSize
+	}
+	return 0
+}
+
+func (x *BillRsp) GetUncoveredSize() int64 {
+	if x != nil {
+		return x.UncoveredSize
+	}
+	return 0
+}
+
+type AvailableOptionsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // 工程地址，需包含省份、城市、区县信息，示例："福建省厦门市集美区孙坂南路92号"
+}
+
+func (x *AvailableOptionsReq) Reset() {
+	*x = AvailableOptionsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cron_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AvailableOptionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailableOptionsReq) ProtoMessage() {}
+
+func (x *AvailableOptionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cron_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailableOptionsReq.ProtoReflect.Descriptor instead.
+func (*AvailableOptionsReq) Descriptor() ([]byte, []int) {
+	return file_cron_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AvailableOptionsReq) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type AvailableOptionsRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Categories   []string  `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`              // 用电类别列表，示例：["福建>工商业,两部制>1-10（20）千伏"]
+	PowerFactors []float64 `protobuf:"fixed64,2,rep,packed,name=powerFactors,proto3" json:"powerFactors,omitempty"` // 功率因素标准值列表，示例：[0.8, 0.85, 0.9]
+}
+
+func (x *AvailableOptionsRsp) Reset() {
+	*x = AvailableOptionsRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cron_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AvailableOptionsRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailableOptionsRsp) ProtoMessage() {}
+
+func (x *AvailableOptionsRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_cron_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DlgdHoursRsp.ProtoReflect.Descriptor instead.
-func (*DlgdHoursRsp) Descriptor() ([]byte, []int) {
-	return file_cron_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *DlgdHoursRsp) GetHours() []*DlgdHour {
-	if x != nil {
-		return x.Hours
-	}
-	return nil
-}
-
-var File_cron_proto protoreflect.FileDescriptor
-
-var file_cron_proto_rawDesc = []byte{
-	0x0a, 0x0a, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x63, 0x72,
-	0x6f, 0x6e, 0x22, 0x18, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x25, 0x0a, 0x09,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0x29, 0x0a, 0x0d, 0x51, 0x75, 0x69, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x72,
-	0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xd2,
-	0x01, 0x0a, 0x08, 0x43, 0x72, 0x6f, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63,
-	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
-	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64,
-	0x75, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x54, 0x69,
-	0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x0a, 0x0a, 0x08, 0x43, 0x72, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x22,
-	0x30, 0x0a, 0x08, 0x43, 0x72, 0x6f, 0x6e, 0x73, 0x52, 0x73, 0x70, 0x12, 0x24, 0x0a, 0x05, 0x63,
-	0x72, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x72, 0x6f,
-	0x6e, 0x2e, 0x43, 0x72, 0x6f, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x05, 0x63, 0x72, 0x6f, 0x6e,
-	0x73, 0x22, 0x31, 0x0a, 0x0b, 0x54, 0x6f, 0x64, 0x6f, 0x43, 0x72, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x74, 0x69, 0x6d, 0x65, 0x22, 0x39, 0x0a, 0x09, 0x43, 0x61, 0x72, 0x62, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x79,
-	0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x22,
-	0x21, 0x0a, 0x09, 0x43, 0x61, 0x72, 0x62, 0x6f, 0x6e, 0x52, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x22, 0x4c, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x62, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x22, 0x4f, 0x0a, 0x0b, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a,
-	0x65, 0x22, 0x99, 0x01, 0x0a, 0x07, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x12, 0x12, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x79, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x79, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65,
-	0x72, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x61, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x07, 0x64, 0x61, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x6e,
-	0x69, 0x67, 0x68, 0x74, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x12,
-	0x1c, 0x0a, 0x09, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x09, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x22, 0x38, 0x0a,
-	0x0b, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x73, 0x52, 0x73, 0x70, 0x12, 0x29, 0x0a, 0x08,
-	0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d,
-	0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x52, 0x08, 0x77,
-	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x73, 0x22, 0x3b, 0x0a, 0x0b, 0x48, 0x6f, 0x6c, 0x69, 0x64,
-	0x61, 0x79, 0x73, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
-	0x79, 0x65, 0x61, 0x72, 0x22, 0x61, 0x0a, 0x07, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12,
-	0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x38, 0x0a, 0x0b, 0x48, 0x6f, 0x6c, 0x69, 0x64,
-	0x61, 0x79, 0x73, 0x52, 0x73, 0x70, 0x12, 0x29, 0x0a, 0x08, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61,
-	0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e,
-	0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x52, 0x08, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79,
-	0x73, 0x22, 0x55, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73,
-	0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x29, 0x0a,
-	0x08, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0d, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x52, 0x08,
-	0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x22, 0x3a, 0x0a, 0x08, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
-	0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x74, 0x69, 0x6d, 0x65, 0x22, 0x5e, 0x0a, 0x08, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x73, 0x70,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65,
-	0x73, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x12, 0x14,
-	0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63,
-	0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x7f, 0x0a, 0x07, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65,
-	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x12, 0x14, 0x0a,
-	0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f,
-	0x6e, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x70, 0x33, 0x30, 0x6d, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x01, 0x52, 0x06, 0x65, 0x70, 0x33, 0x30, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65,
-	0x71, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x65, 0x71,
-	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x76, 0x0a, 0x0a, 0x42, 0x69, 0x6c, 0x6c, 0x44, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x65, 0x73,
-	0x63, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x22, 0xc7, 0x01,
-	0x0a, 0x07, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x62,
-	0x61, 0x73, 0x69, 0x63, 0x46, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x62,
-	0x61, 0x73, 0x69, 0x63, 0x46, 0x65, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x61, 0x67, 0x65,
-	0x46, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x75, 0x73, 0x61, 0x67, 0x65,
-	0x46, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x66, 0x46, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x05, 0x70, 0x66, 0x46, 0x65, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x61,
-	0x67, 0x65, 0x46, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x73, 0x74, 0x61,
-	0x67, 0x65, 0x46, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x64,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63,
-	0x72, 0x6f, 0x6e, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x07,
-	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x2f, 0x0a, 0x13, 0x41, 0x76, 0x61, 0x69, 0x6c,
-	0x61, 0x62, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x59, 0x0a, 0x13, 0x41, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x73, 0x70, 0x12,
-	0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12,
-	0x22, 0x0a, 0x0c, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0c, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x46, 0x61, 0x63, 0x74,
-	0x6f, 0x72, 0x73, 0x22, 0x40, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x61, 0x72, 0x65, 0x61, 0x22, 0x92, 0x03, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x46, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x46, 0x61,
-	0x63, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
-	0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x06, 0x64, 0x65, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x43, 0x61, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x43, 0x61, 0x70, 0x12, 0x1e, 0x0a, 0x0a,
-	0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x43, 0x61, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x0a, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x43, 0x61, 0x70, 0x12, 0x22, 0x0a, 0x0c,
-	0x6e, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x65, 0x72, 0x43, 0x61, 0x70, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x0c, 0x6e, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x65, 0x72, 0x43, 0x61, 0x70,
-	0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x6d, 0x69, 0x50, 0x65, 0x61, 0x6b, 0x43, 0x61, 0x70, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x65, 0x6d, 0x69, 0x50, 0x65, 0x61, 0x6b, 0x43,
-	0x61, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x61, 0x74, 0x53, 0x65, 0x6d, 0x69, 0x50, 0x65, 0x61,
-	0x6b, 0x43, 0x61, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x73, 0x61, 0x74, 0x53,
-	0x65, 0x6d, 0x69, 0x50, 0x65, 0x61, 0x6b, 0x43, 0x61, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x66,
-	0x66, 0x50, 0x65, 0x61, 0x6b, 0x43, 0x61, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
-	0x6f, 0x66, 0x66, 0x50, 0x65, 0x61, 0x6b, 0x43, 0x61, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3e, 0x0a, 0x0e, 0x41, 0x64,
-	0x64, 0x44, 0x6c, 0x67, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04,
-	0x61, 0x72, 0x65, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x65, 0x61,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x37, 0x0a, 0x0b, 0x44, 0x6c,
-	0x67, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65,
-	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x12, 0x14, 0x0a,
-	0x05, 0x64, 0x6f, 0x63, 0x4e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6f,
-	0x63, 0x4e, 0x6f, 0x22, 0x86, 0x02, 0x0a, 0x08, 0x44, 0x6c, 0x67, 0x64, 0x48, 0x6f, 0x75, 0x72,
-	0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x61, 0x72, 0x65, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x4e, 0x6f, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6f, 0x63, 0x4e, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x72, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x65, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65,
-	0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x77, 0x65,
-	0x65, 0x6b, 0x65, 0x6e, 0x64, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x77, 0x65, 0x65, 0x6b, 0x65, 0x6e, 0x64, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73,
-	0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
-	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x34, 0x0a, 0x0c,
-	0x44, 0x6c, 0x67, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x73, 0x70, 0x12, 0x24, 0x0a, 0x05,
-	0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x72,
-	0x6f, 0x6e, 0x2e, 0x44, 0x6c, 0x67, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x52, 0x05, 0x68, 0x6f, 0x75,
-	0x72, 0x73, 0x32, 0x83, 0x09, 0x0a, 0x04, 0x43, 0x72, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x0a, 0x51,
-	0x75, 0x69, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x13, 0x2e, 0x63, 0x72, 0x6f, 0x6e,
-	0x2e, 0x51, 0x75, 0x69, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0f,
-	0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x73, 0x70, 0x12,
-	0x2a, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x43, 0x72, 0x6f, 0x6e, 0x73, 0x12, 0x0e, 0x2e, 0x63, 0x72,
-	0x6f, 0x6e, 0x2e, 0x43, 0x72, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x63, 0x72,
-	0x6f, 0x6e, 0x2e, 0x43, 0x72, 0x6f, 0x6e, 0x73, 0x52, 0x73, 0x70, 0x12, 0x2a, 0x0a, 0x07, 0x41,
-	0x64, 0x64, 0x43, 0x72, 0x6f, 0x6e, 0x12, 0x0e, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x43, 0x72,
-	0x6f, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x0f, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x52, 0x73, 0x70, 0x12, 0x2d, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x43, 0x72, 0x6f, 0x6e, 0x12, 0x0e, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x43, 0x72, 0x6f,
-	0x6e, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x0f, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x52, 0x73, 0x70, 0x12, 0x2b, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x43, 0x72, 0x6f, 0x6e, 0x12, 0x0c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x52,
-	0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x52, 0x73, 0x70, 0x12, 0x2e, 0x0a, 0x08, 0x54, 0x6f, 0x64, 0x6f, 0x43, 0x72, 0x6f, 0x6e, 0x12,
-	0x11, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x54, 0x6f, 0x64, 0x6f, 0x43, 0x72, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x52, 0x73, 0x70, 0x12, 0x2d, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x62, 0x6f, 0x6e,
-	0x12, 0x0f, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x43, 0x61, 0x72, 0x62, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x43, 0x61, 0x72, 0x62, 0x6f, 0x6e, 0x52,
-	0x73, 0x70, 0x12, 0x30, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x62, 0x6f, 0x6e, 0x12,
-	0x12, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x62, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x52, 0x73, 0x70, 0x12, 0x33, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x57, 0x65, 0x61, 0x74, 0x68,
-	0x65, 0x72, 0x73, 0x12, 0x11, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x57, 0x65,
-	0x61, 0x74, 0x68, 0x65, 0x72, 0x73, 0x52, 0x73, 0x70, 0x12, 0x33, 0x0a, 0x0b, 0x47, 0x65, 0x74,
-	0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x12, 0x11, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e,
-	0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x63, 0x72,
-	0x6f, 0x6e, 0x2e, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x52, 0x73, 0x70, 0x12, 0x34,
-	0x0a, 0x0b, 0x41, 0x64, 0x64, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x12, 0x14, 0x2e,
-	0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73,
-	0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x52, 0x73, 0x70, 0x12, 0x2e, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x6f,
-	0x6c, 0x69, 0x64, 0x61, 0x79, 0x12, 0x0c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x6c,
-	0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x52, 0x73, 0x70, 0x12, 0x2a, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x12, 0x0e, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x1a, 0x0e, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x73, 0x70,
-	0x12, 0x2e, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x42, 0x69,
-	0x6c, 0x6c, 0x12, 0x0d, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65,
-	0x71, 0x1a, 0x0d, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x73, 0x70,
-	0x12, 0x4b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x41,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x1a, 0x19, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
-	0x62, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x73, 0x70, 0x12, 0x3d, 0x0a,
-	0x0d, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
-	0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x36, 0x0a, 0x0d,
-	0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e,
-	0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x6f, 0x64, 0x79, 0x1a, 0x0f, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x52, 0x73, 0x70, 0x12, 0x39, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x0f,
-	0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x73, 0x70, 0x12,
-	0x31, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x52, 0x65,
-	0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
-	0x73, 0x70, 0x12, 0x35, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x44, 0x6c, 0x67, 0x64, 0x48, 0x6f, 0x75,
-	0x72, 0x73, 0x12, 0x14, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x44, 0x6c, 0x67,
-	0x64, 0x48, 0x6f, 0x75, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x73, 0x70, 0x12, 0x36, 0x0a, 0x10, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x72, 0x6d, 0x44, 0x6c, 0x67, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x11, 0x2e,
-	0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x44, 0x6c, 0x67, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x52, 0x65, 0x71,
-	0x1a, 0x0f, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x73,
-	0x70, 0x12, 0x35, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x44, 0x6c, 0x67, 0x64, 0x48, 0x6f, 0x75, 0x72,
-	0x73, 0x12, 0x11, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x44, 0x6c, 0x67, 0x64, 0x48, 0x6f, 0x75,
-	0x72, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x44, 0x6c, 0x67, 0x64,
-	0x48, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x73, 0x70, 0x42, 0x08, 0x5a, 0x06, 0x2e, 0x2f, 0x63, 0x72,
-	0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_cron_proto_rawDescOnce sync.Once
-	file_cron_proto_rawDescData = file_cron_proto_rawDesc
-)
-
-func file_cron_proto_rawDescGZIP() []byte {
-	file_cron_proto_rawDescOnce.Do(func() {
-		file_cron_proto_rawDescData = protoimpl.X.CompressGZIP(file_cron_proto_rawDescData)
-	})
-	return file_cron_proto_rawDescData
-}
-
-var file_cron_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
-var file_cron_proto_goTypes = []interface{}{
-	(*DelReq)(nil),              // 0: cron.DelReq
-	(*ResultRsp)(nil),           // 1: cron.ResultRsp
-	(*QuickStartReq)(nil),       // 2: cron.QuickStartReq
-	(*CronBody)(nil),            // 3: cron.CronBody
-	(*CronsReq)(nil),            // 4: cron.CronsReq
-	(*CronsRsp)(nil),            // 5: cron.CronsRsp
-	(*TodoCronReq)(nil),         // 6: cron.TodoCronReq
-	(*CarbonReq)(nil),           // 7: cron.CarbonReq
-	(*CarbonRsp)(nil),           // 8: cron.CarbonRsp
-	(*AddCarbonReq)(nil),        // 9: cron.AddCarbonReq
-	(*WeathersReq)(nil),         // 10: cron.WeathersReq
-	(*Weather)(nil),             // 11: cron.Weather
-	(*WeathersRsp)(nil),         // 12: cron.WeathersRsp
-	(*HolidaysReq)(nil),         // 13: cron.HolidaysReq
-	(*Holiday)(nil),             // 14: cron.Holiday
-	(*HolidaysRsp)(nil),         // 15: cron.HolidaysRsp
-	(*AddHolidaysReq)(nil),      // 16: cron.AddHolidaysReq
-	(*PriceReq)(nil),            // 17: cron.PriceReq
-	(*PriceRsp)(nil),            // 18: cron.PriceRsp
-	(*BillReq)(nil),             // 19: cron.BillReq
-	(*BillDetail)(nil),          // 20: cron.BillDetail
-	(*BillRsp)(nil),             // 21: cron.BillRsp
-	(*AvailableOptionsReq)(nil), // 22: cron.AvailableOptionsReq
-	(*AvailableOptionsRsp)(nil), // 23: cron.AvailableOptionsRsp
-	(*GetUserOptionReq)(nil),    // 24: cron.GetUserOptionReq
-	(*UserOptionBody)(nil),      // 25: cron.UserOptionBody
-	(*AddDlgdHourReq)(nil),      // 26: cron.AddDlgdHourReq
-	(*DlgdHourReq)(nil),         // 27: cron.DlgdHourReq
-	(*DlgdHour)(nil),            // 28: cron.DlgdHour
-	(*DlgdHoursRsp)(nil),        // 29: cron.DlgdHoursRsp
-}
-var file_cron_proto_depIdxs = []int32{
-	3,  // 0: cron.CronsRsp.crons:type_name -> cron.CronBody
-	11, // 1: cron.WeathersRsp.weathers:type_name -> cron.Weather
-	14, // 2: cron.HolidaysRsp.holidays:type_name -> cron.Holiday
-	14, // 3: cron.AddHolidaysReq.holidays:type_name -> cron.Holiday
-	20, // 4: cron.BillRsp.details:type_name -> cron.BillDetail
-	28, // 5: cron.DlgdHoursRsp.hours:type_name -> cron.DlgdHour
-	2,  // 6: cron.Cron.QuickStart:input_type -> cron.QuickStartReq
-	4,  // 7: cron.Cron.GetCrons:input_type -> cron.CronsReq
-	3,  // 8: cron.Cron.AddCron:input_type -> cron.CronBody
-	3,  // 9: cron.Cron.UpdateCron:input_type -> cron.CronBody
-	0,  // 10: cron.Cron.DeleteCron:input_type -> cron.DelReq
-	6,  // 11: cron.Cron.TodoCron:input_type -> cron.TodoCronReq
-	7,  // 12: cron.Cron.GetCarbon:input_type -> cron.CarbonReq
-	9,  // 13: cron.Cron.AddCarbon:input_type -> cron.AddCarbonReq
-	10, // 14: cron.Cron.GetWeathers:input_type -> cron.WeathersReq
-	13, // 15: cron.Cron.GetHolidays:input_type -> cron.HolidaysReq
-	16, // 16: cron.Cron.AddHolidays:input_type -> cron.AddHolidaysReq
-	0,  // 17: cron.Cron.DeleteHoliday:input_type -> cron.DelReq
-	17, // 18: cron.Cron.GetPrice:input_type -> cron.PriceReq
-	19, // 19: cron.Cron.GetMonthlyBill:input_type -> cron.BillReq
-	22, // 20: cron.Cron.GetAvailableOptions:input_type -> cron.AvailableOptionsReq
-	24, // 21: cron.Cron.GetUserOption:input_type -> cron.GetUserOptionReq
-	25, // 22: cron.Cron.AddUserOption:input_type -> cron.UserOptionBody
-	25, // 23: cron.Cron.UpdateUserOption:input_type -> cron.UserOptionBody
-	0,  // 24: cron.Cron.DeleteUserOption:input_type -> cron.DelReq
-	26, // 25: cron.Cron.AddDlgdHours:input_type -> cron.AddDlgdHourReq
-	27, // 26: cron.Cron.ConfirmDlgdHours:input_type -> cron.DlgdHourReq
-	27, // 27: cron.Cron.GetDlgdHours:input_type -> cron.DlgdHourReq
-	1,  // 28: cron.Cron.QuickStart:output_type -> cron.ResultRsp
-	5,  // 29: cron.Cron.GetCrons:output_type -> cron.CronsRsp
-	1,  // 30: cron.Cron.AddCron:output_type -> cron.ResultRsp
-	1,  // 31: cron.Cron.UpdateCron:output_type -> cron.ResultRsp
-	1,  // 32: cron.Cron.DeleteCron:output_type -> cron.ResultRsp
-	1,  // 33: cron.Cron.TodoCron:output_type -> cron.ResultRsp
-	8,  // 34: cron.Cron.GetCarbon:output_type -> cron.CarbonRsp
-	1,  // 35: cron.Cron.AddCarbon:output_type -> cron.ResultRsp
-	12, // 36: cron.Cron.GetWeathers:output_type -> cron.WeathersRsp
-	15, // 37: cron.Cron.GetHolidays:output_type -> cron.HolidaysRsp
-	1,  // 38: cron.Cron.AddHolidays:output_type -> cron.ResultRsp
-	1,  // 39: cron.Cron.DeleteHoliday:output_type -> cron.ResultRsp
-	18, // 40: cron.Cron.GetPrice:output_type -> cron.PriceRsp
-	21, // 41: cron.Cron.GetMonthlyBill:output_type -> cron.BillRsp
-	23, // 42: cron.Cron.GetAvailableOptions:output_type -> cron.AvailableOptionsRsp
-	25, // 43: cron.Cron.GetUserOption:output_type -> cron.UserOptionBody
-	1,  // 44: cron.Cron.AddUserOption:output_type -> cron.ResultRsp
-	1,  // 45: cron.Cron.UpdateUserOption:output_type -> cron.ResultRsp
-	1,  // 46: cron.Cron.DeleteUserOption:output_type -> cron.ResultRsp
-	1,  // 47: cron.Cron.AddDlgdHours:output_type -> cron.ResultRsp
-	1,  // 48: cron.Cron.ConfirmDlgdHours:output_type -> cron.ResultRsp
-	29, // 49: cron.Cron.GetDlgdHours:output_type -> cron.DlgdHoursRsp
-	28, // [28:50] is the sub-list for method output_type
-	6,  // [6:28] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
-}
-
-func init() { file_cron_proto_init() }
-func file_cron_proto_init() {
-	if File_cron_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_cron_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelReq); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailableOptionsRsp.ProtoReflect.Descriptor instead.
+func (*AvailableOptionsRsp) Descriptor() ([]byte, []int) {
+	return file_cron_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AvailableOptionsRsp) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *AvailableOptionsRsp) GetPowerFactors() []float64 {
+	if x != nil {
+		return x.PowerFactors
+	}
+	return nil
+}
+
+type GetUserOptionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"` // 工程ID
+	Area    string `protobuf:"bytes,2,opt,name=area,proto3" json:"area,omitempty"`       // 工程区域，可选区域名、支路名或设备ID等关键字，sql用like查询
+}
+
+func (x *GetUserOptionReq) Reset() {
+	*x = GetUserOptionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cron_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserOptionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserOptionReq) ProtoMessage() {}
+
+func (x *GetUserOptionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cron_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserOptionReq.ProtoReflect.Descriptor instead.
+func (*GetUserOptionReq) Descriptor() ([]byte, []int) {
+	return file_cron_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetUserOptionReq) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *GetUserOptionReq) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+type UserOptionBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account        string  `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`                  // 工程ID
+	Area           string  `protobuf:"bytes,2,opt,name=area,proto3" json:"area,omitempty"`                        // 工程区域
+	Category       string  `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`                // 用电类别
+	PowerFactor    float64 `protobuf:"fixed64,4,opt,name=powerFactor,proto3" json:"powerFactor,omitempty"`        // [大陆]功率因素标准
+	Capacity       float64 `protobuf:"fixed64,5,opt,name=capacity,proto3" json:"capacity,omitempty"`              // [大陆]合同容量
+	Demand         float64 `protobuf:"fixed64,6,opt,name=demand,proto3" json:"demand,omitempty"`                  // [大陆]合同需量
+	InstalledCap   float64 `protobuf:"fixed64,7,opt,name=installedCap,proto3" json:"installedCap,omitempty"`      // [台湾]装置契约
+	RegularCap     float64 `protobuf:"fixed64,8,opt,name=regularCap,proto3" json:"regularCap,omitempty"`          // [台湾]常规契约
+	NonSummerCap   float64 `protobuf:"fixed64,9,opt,name=nonSummerCap,proto3" json:"nonSummerCap,omitempty"`      // [台湾]非夏季契约
+	SemiPeakCap    float64 `protobuf:"fixed64,10,opt,name=semiPeakCap,proto3" json:"semiPeakCap,omitempty"`       // [台湾]半尖峰契约
+	SatSemiPeakCap float64 `protobuf:"fixed64,11,opt,name=satSemiPeakCap,proto3" json:"satSemiPeakCap,omitempty"` // [台湾]周六半尖峰契约
+	OffPeakCap     float64 `protobuf:"fixed64,12,opt,name=offPeakCap,proto3" json:"offPeakCap,omitempty"`         // [台湾]离峰契约
+	Id             int64   `protobuf:"varint,13,opt,name=id,proto3" json:"id,omitempty"`                          //
+}
+
+func (x *UserOptionBody) Reset() {
+	*x = UserOptionBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cron_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserOptionBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserOptionBody) ProtoMessage() {}
+
+func (x *UserOptionBody) ProtoReflect() protoreflect.Message {
+	mi := &file_cron_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserOptionBody.ProtoReflect.Descriptor instead.
+func (*UserOptionBody) Descriptor() ([]byte, []int) {
+	return file_cron_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UserOptionBody) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *UserOptionBody) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+func (x *UserOptionBody) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *UserOptionBody) GetPowerFactor() float64 {
+	if x != nil {
+		return x.PowerFactor
+	}
+	return 0
+}
+
+func (x *UserOptionBody) GetCapacity() float64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *UserOptionBody) GetDemand() float64 {
+	if x != nil {
+		return x.Demand
+	}
+	return 0
+}
+
+func (x *UserOptionBody) GetInstalledCap() float64 {
+	if x != nil {
+		return x.InstalledCap
+	}
+	return 0
+}
+
+func (x *UserOptionBody) GetRegularCap() float64 {
+	if x != nil {
+		return x.RegularCap
+	}
+	return 0
+}
+
+func (x *UserOptionBody) GetNonSummerCap() float64 {
+	if x != nil {
+		return x.NonSummerCap
+	}
+	return 0
+}
+
+func (x *UserOptionBody) GetSemiPeakCap() float64 {
+	if x != nil {
+		return x.SemiPeakCap
+	}
+	return 0
+}
+
+func (x *UserOptionBody) GetSatSemiPeakCap() float64 {
+	if x != nil {
+		return x.SatSemiPeakCap
+	}
+	return 0
+}
+
+func (x *UserOptionBody) GetOffPeakCap() float64 {
+	if x != nil {
+		return x.OffPeakCap
+	}
+	return 0
+}
+
+func (x *UserOptionBody) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AddDlgdHourReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Area    string `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`       // 区域
+	Comment string `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"` // 原始文本
+}
+
+func (x *AddDlgdHourReq) Reset() {
+	*x = AddDlgdHourReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cron_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddDlgdHourReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDlgdHourReq) ProtoMessage() {}
+
+func (x *AddDlgdHourReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cron_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDlgdHourReq.ProtoReflect.Descriptor instead.
+func (*AddDlgdHourReq) Descriptor() ([]byte, []int) {
+	return file_cron_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AddDlgdHourReq) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+func (x *AddDlgdHourReq) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type DlgdHourReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Area     string `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`         // 区域
+	DocNo    string `protobuf:"bytes,2,opt,name=docNo,proto3" json:"docNo,omitempty"`       // 政策文号
+	Reviewer string `protobuf:"bytes,3,opt,name=reviewer,proto3" json:"reviewer,omitempty"` // 审核人，确认/驳回时记录
+	Comment  string `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`   // 审核意见，驳回时必填
+}
+
+func (x *DlgdHourReq) Reset() {
+	*x = DlgdHourReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cron_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DlgdHourReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DlgdHourReq) ProtoMessage() {}
+
+func (x *DlgdHourReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cron_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DlgdHourReq.ProtoReflect.Descriptor instead.
+func (*DlgdHourReq) Descriptor() ([]byte, []int) {
+	return file_cron_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DlgdHourReq) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+func (x *DlgdHourReq) GetDocNo() string {
+	if x != nil {
+		return x.DocNo
+	}
+	return ""
+}
+
+func (x *DlgdHourReq) GetReviewer() string {
+	if x != nil {
+		return x.Reviewer
+	}
+	return ""
+}
+
+func (x *DlgdHourReq) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type UpdateDlgdHoursReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Area     string      `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`         // 区域
+	DocNo    string      `protobuf:"bytes,2,opt,name=docNo,proto3" json:"docNo,omitempty"`       // 政策文号
+	Hours    []*DlgdHour `protobuf:"bytes,3,rep,name=hours,proto3" json:"hours,omitempty"`       // 修改后的完整时段列表
+	Reviewer string      `protobuf:"bytes,4,opt,name=reviewer,proto3" json:"reviewer,omitempty"` // 审核人
+	Comment  string      `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`   // 审核意见
+	Approve  bool        `protobuf:"varint,6,opt,name=approve,proto3" json:"approve,omitempty"`  // 修改后直接确认
+}
+
+func (x *UpdateDlgdHoursReq) Reset() {
+	*x = UpdateDlgdHoursReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cron_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateDlgdHoursReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDlgdHoursReq) ProtoMessage() {}
+
+func (x *UpdateDlgdHoursReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cron_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDlgdHoursReq.ProtoReflect.Descriptor instead.
+func (*UpdateDlgdHoursReq) Descriptor() ([]byte, []int) {
+	return file_cron_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UpdateDlgdHoursReq) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+func (x *UpdateDlgdHoursReq) GetDocNo() string {
+	if x != nil {
+		return x.DocNo
+	}
+	return ""
+}
+
+func (x *UpdateDlgdHoursReq) GetHours() []*DlgdHour {
+	if x != nil {
+		return x.Hours
+	}
+	return nil
+}
+
+func (x *UpdateDlgdHoursReq) GetReviewer() string {
+	if x != nil {
+		return x.Reviewer
+	}
+	return ""
+}
+
+func (x *UpdateDlgdHoursReq) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *UpdateDlgdHoursReq) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+type DlgdHour struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Area          string  `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`                   // 区域
+	DocNo         string  `protobuf:"bytes,2,opt,name=docNo,proto3" json:"docNo,omitempty"`                 // 政策文号
+	Confirm       int64   `protobuf:"varint,3,opt,name=confirm,proto3" json:"confirm,omitempty"`            // 确认有效
+	Name          string  `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`                   // 时段名称
+	Value         string  `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`                 // 时段值
+	Temp          string  `protobuf:"bytes,6,opt,name=temp,proto3" json:"temp,omitempty"`                   // 温度条件，如：其他月份中广州日最高气温达到35℃
+	Months        string  `protobuf:"bytes,7,opt,name=months,proto3" json:"months,omitempty"`               // 月份条件
+	WeekendMonths string  `protobuf:"bytes,8,opt,name=weekendMonths,proto3" json:"weekendMonths,omitempty"` // 月份-休息日条件
+	Holidays      string  `protobuf:"bytes,9,opt,name=holidays,proto3" json:"holidays,omitempty"`           // 节假日条件，如：春节,劳动节,国庆节
+	Categories    string  `protobuf:"bytes,10,opt,name=categories,proto3" json:"categories,omitempty"`      // 用电类别条件，如：容量315千伏安及以上,两部制
+	Stated        float64 `protobuf:"fixed64,11,opt,name=stated,proto3" json:"stated,omitempty"`            // 政策所述时长(小时)，如：峰时段6小时
+}
+
+func (x *DlgdHour) Reset() {
+	*x = DlgdHour{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cron_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DlgdHour) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DlgdHour) ProtoMessage() {}
+
+func (x *DlgdHour) ProtoReflect() protoreflect.Message {
+	mi := &file_cron_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DlgdHour.ProtoReflect.Descriptor instead.
+func (*DlgdHour) Descriptor() ([]byte, []int) {
+	return file_cron_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DlgdHour) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+func (x *DlgdHour) GetDocNo() string {
+	if x != nil {
+		return x.DocNo
+	}
+	return ""
+}
+
+func (x *DlgdHour) GetConfirm() int64 {
+	if x != nil {
+		return x.Confirm
+	}
+	return 0
+}
+
+func (x *DlgdHour) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DlgdHour) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *DlgdHour) GetTemp() string {
+	if x != nil {
+		return x.Temp
+	}
+	return ""
+}
+
+func (x *DlgdHour) GetMonths() string {
+	if x != nil {
+		return x.Months
+	}
+	return ""
+}
+
+func (x *DlgdHour) GetWeekendMonths() string {
+	if x != nil {
+		return x.WeekendMonths
+	}
+	return ""
+}
+
+func (x *DlgdHour) GetHolidays() string {
+	if x != nil {
+		return x.Holidays
+	}
+	return ""
+}
+
+func (x *DlgdHour) GetCategories() string {
+	if x != nil {
+		return x.Categories
+	}
+	return ""
+}
+
+func (x *DlgdHour) GetStated() float64 {
+	if x != nil {
+		return x.Stated
+	}
+	return 0
+}
+
+type DlgdHourReview struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action     string   `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`         // 审核动作：update、approve、reject
+	Reviewer   string   `protobuf:"bytes,2,opt,name=reviewer,proto3" json:"reviewer,omitempty"`     // 审核人
+	Comment    string   `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`       // 审核意见
+	BaseDocNo  string   `protobuf:"bytes,4,opt,name=baseDocNo,proto3" json:"baseDocNo,omitempty"`   // 对比的上一已确认政策文号
+	Diff       []string `protobuf:"bytes,5,rep,name=diff,proto3" json:"diff,omitempty"`             // 与上一已确认时段划分的差异
+	CreateTime string   `protobuf:"bytes,6,opt,name=createTime,proto3" json:"createTime,omitempty"` // 审核时间
+}
+
+func (x *DlgdHourReview) Reset() {
+	*x = DlgdHourReview{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cron_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DlgdHourReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DlgdHourReview) ProtoMessage() {}
+
+func (x *DlgdHourReview) ProtoReflect() protoreflect.Message {
+	mi := &file_cron_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DlgdHourReview.ProtoReflect.Descriptor instead.
+func (*DlgdHourReview) Descriptor() ([]byte, []int) {
+	return file_cron_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DlgdHourReview) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *DlgdHourReview) GetReviewer() string {
+	if x != nil {
+		return x.Reviewer
+	}
+	return ""
+}
+
+func (x *DlgdHourReview) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *DlgdHourReview) GetBaseDocNo() string {
+	if x != nil {
+		return x.BaseDocNo
+	}
+	return ""
+}
+
+func (x *DlgdHourReview) GetDiff() []string {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *DlgdHourReview) GetCreateTime() string {
+	if x != nil {
+		return x.CreateTime
+	}
+	return ""
+}
+
+type DlgdHoursRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hours     []*DlgdHour       `protobuf:"bytes,1,rep,name=hours,proto3" json:"hours,omitempty"`         // 用电时段列表
+	BaseDocNo string            `protobuf:"bytes,2,opt,name=baseDocNo,proto3" json:"baseDocNo,omitempty"` // 上一已确认政策文号
+	Diff      []string          `protobuf:"bytes,3,rep,name=diff,proto3" json:"diff,omitempty"`           // 与上一已确认时段划分的差异
+	Reviews   []*DlgdHourReview `protobuf:"bytes,4,rep,name=reviews,proto3" json:"reviews,omitempty"`     // 审核记录，最近在前
+	Issues    []string          `protobuf:"bytes,5,rep,name=issues,proto3" json:"issues,omitempty"`       // 时段覆盖校验问题：空档、重叠、时长不符
+}
+
+func (x *DlgdHoursRsp) Reset() {
+	*x = DlgdHoursRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cron_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DlgdHoursRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DlgdHoursRsp) ProtoMessage() {}
+
+func (x *DlgdHoursRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_cron_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DlgdHoursRsp.ProtoReflect.Descriptor instead.
+func (*DlgdHoursRsp) Descriptor() ([]byte, []int) {
+	return file_cron_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DlgdHoursRsp) GetHours() []*DlgdHour {
+	if x != nil {
+		return x.Hours
+	}
+	return nil
+}
+
+func (x *DlgdHoursRsp) GetBaseDocNo() string {
+	if x != nil {
+		return x.BaseDocNo
+	}
+	return ""
+}
+
+func (x *DlgdHoursRsp) GetDiff() []string {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *DlgdHoursRsp) GetReviews() []*DlgdHourReview {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *DlgdHoursRsp) GetIssues() []string {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+type DlgdHourSpan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule  string `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`    // 匹配规则，如：periodReg、periodBetweenReg
+	Start int64  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"` // 起始位置(字符偏移，含)
+	End   int64  `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`     // 结束位置(字符偏移，不含)
+	Text  string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`    // 匹配原文
+}
+
+func (x *DlgdHourSpan) Reset() {
+	*x = DlgdHourSpan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cron_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DlgdHourSpan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DlgdHourSpan) ProtoMessage() {}
+
+func (x *DlgdHourSpan) ProtoReflect() protoreflect.Message {
+	mi := &file_cron_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DlgdHourSpan.ProtoReflect.Descriptor instead.
+func (*DlgdHourSpan) Descriptor() ([]byte, []int) {
+	return file_cron_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DlgdHourSpan) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *DlgdHourSpan) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *DlgdHourSpan) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *DlgdHourSpan) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type DlgdHourDiagnostics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text       string          `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`               // 预处理后的原文，偏移以此为准
+	Spans      []*DlgdHourSpan `protobuf:"bytes,2,rep,name=spans,proto3" json:"spans,omitempty"`             // 各规则匹配的原文区间
+	Unmatched  []string        `protobuf:"bytes,3,rep,name=unmatched,proto3" json:"unmatched,omitempty"`     // 含时段名称或时间但未匹配的片段
+	Confidence float64         `protobuf:"fixed64,4,opt,name=confidence,proto3" json:"confidence,omitempty"` // 置信度(0-1)
+}
+
+func (x *DlgdHourDiagnostics) Reset() {
+	*x = DlgdHourDiagnostics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cron_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DlgdHourDiagnostics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DlgdHourDiagnostics) ProtoMessage() {}
+
+func (x *DlgdHourDiagnostics) ProtoReflect() protoreflect.Message {
+	mi := &file_cron_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DlgdHourDiagnostics.ProtoReflect.Descriptor instead.
+func (*DlgdHourDiagnostics) Descriptor() ([]byte, []int) {
+	return file_cron_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DlgdHourDiagnostics) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *DlgdHourDiagnostics) GetSpans() []*DlgdHourSpan {
+	if x != nil {
+		return x.Spans
+	}
+	return nil
+}
+
+func (x *DlgdHourDiagnostics) GetUnmatched() []string {
+	if x != nil {
+		return x.Unmatched
+	}
+	return nil
+}
+
+func (x *DlgdHourDiagnostics) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+type DlgdHoursParseRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message     string               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`         // 与ResultRsp兼容
+	Hours       []*DlgdHour          `protobuf:"bytes,2,rep,name=hours,proto3" json:"hours,omitempty"`             // 解析的用电时段
+	Diagnostics *DlgdHourDiagnostics `protobuf:"bytes,3,opt,name=diagnostics,proto3" json:"diagnostics,omitempty"` // 解析诊断
+	Issues      []string             `protobuf:"bytes,4,rep,name=issues,proto3" json:"issues,omitempty"`           // 时段覆盖校验问题
+}
+
+func (x *DlgdHoursParseRsp) Reset() {
+	*x = DlgdHoursParseRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cron_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DlgdHoursParseRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DlgdHoursParseRsp) ProtoMessage() {}
+
+func (x *DlgdHoursParseRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_cron_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DlgdHoursParseRsp.ProtoReflect.Descriptor instead.
+func (*DlgdHoursParseRsp) Descriptor() ([]byte, []int) {
+	return file_cron_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DlgdHoursParseRsp) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DlgdHoursParseRsp) GetHours() []*DlgdHour {
+	if x != nil {
+		return x.Hours
+	}
+	return nil
+}
+
+func (x *DlgdHoursParseRsp) GetDiagnostics() *DlgdHourDiagnostics {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+func (x *DlgdHoursParseRsp) GetIssues() []string {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+type TouCalendarReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Category  string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`   // 用电类别，例："福建>工商业,两部制>1-10（20）千伏"
+	StartDate string `protobuf:"bytes,2,opt,name=startDate,proto3" json:"startDate,omitempty"` // 开始日期，例："2025-08-01"
+	EndDate   string `protobuf:"bytes,3,opt,name=endDate,proto3" json:"endDate,omitempty"`     // 截止日期(含)，例："2025-08-31"，为空时取开始日期所在月末
+}
+
+func (x *TouCalendarReq) Reset() {
+	*x = TouCalendarReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cron_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TouCalendarReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TouCalendarReq) ProtoMessage() {}
+
+func (x *TouCalendarReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cron_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TouCalendarReq.ProtoReflect.Descriptor instead.
+func (*TouCalendarReq) Descriptor() ([]byte, []int) {
+	return file_cron_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *TouCalendarReq) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *TouCalendarReq) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *TouCalendarReq) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type TouCalendarRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileName  string `protobuf:"bytes,1,opt,name=fileName,proto3" json:"fileName,omitempty"`    // 文件名，例："福建_工商业_20250801-20250831.ics"
+	Content   string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`      // iCalendar(RFC 5545)文本
+	EventSize int64  `protobuf:"varint,3,opt,name=eventSize,proto3" json:"eventSize,omitempty"` // 事件数量
+}
+
+func (x *TouCalendarRsp) Reset() {
+	*x = TouCalendarRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cron_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TouCalendarRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TouCalendarRsp) ProtoMessage() {}
+
+func (x *TouCalendarRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_cron_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TouCalendarRsp.ProtoReflect.Descriptor instead.
+func (*TouCalendarRsp) Descriptor() ([]byte, []int) {
+	return file_cron_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *TouCalendarRsp) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *TouCalendarRsp) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *TouCalendarRsp) GetEventSize() int64 {
+	if x != nil {
+		return x.EventSize
+	}
+	return 0
+}
+
+type EmissionsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account  string     `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`   // 工程ID
+	Area     string     `protobuf:"bytes,2,opt,name=area,proto3" json:"area,omitempty"`         // 工程区域，可选区域名、支路名或设备ID等关键字，sql用like查询
+	Address  string     `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`   // 工程地址，用于匹配碳排因子，缺省时取用电类别所属省份
+	Bills    []*BillReq `protobuf:"bytes,4,rep,name=bills,proto3" json:"bills,omitempty"`       // 各月用电数据，account、area缺省时沿用本级
+	Csv      bool       `protobuf:"varint,5,opt,name=csv,proto3" json:"csv,omitempty"`          // 是否导出CSV
+	Category string     `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"` // 因子类型，缺省为emission
+}
+
+func (x *EmissionsReq) Reset() {
+	*x = EmissionsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cron_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmissionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmissionsReq) ProtoMessage() {}
+
+func (x *EmissionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cron_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmissionsReq.ProtoReflect.Descriptor instead.
+func (*EmissionsReq) Descriptor() ([]byte, []int) {
+	return file_cron_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *EmissionsReq) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *EmissionsReq) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+func (x *EmissionsReq) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *EmissionsReq) GetBills() []*BillReq {
+	if x != nil {
+		return x.Bills
+	}
+	return nil
+}
+
+func (x *EmissionsReq) GetCsv() bool {
+	if x != nil {
+		return x.Csv
+	}
+	return false
+}
+
+func (x *EmissionsReq) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type EmissionsPeriod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`             // 所属时段，例："flat"
+	Desc      string  `protobuf:"bytes,2,opt,name=desc,proto3" json:"desc,omitempty"`             // 时段描述，例："平段"
+	Usage     float64 `protobuf:"fixed64,3,opt,name=usage,proto3" json:"usage,omitempty"`         // 用电量(kWh)
+	Emissions float64 `protobuf:"fixed64,4,opt,name=emissions,proto3" json:"emissions,omitempty"` // 排放量(tCO2)
+}
+
+func (x *EmissionsPeriod) Reset() {
+	*x = EmissionsPeriod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cron_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmissionsPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmissionsPeriod) ProtoMessage() {}
+
+func (x *EmissionsPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_cron_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmissionsPeriod.ProtoReflect.Descriptor instead.
+func (*EmissionsPeriod) Descriptor() ([]byte, []int) {
+	return file_cron_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *EmissionsPeriod) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EmissionsPeriod) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
+func (x *EmissionsPeriod) GetUsage() float64 {
+	if x != nil {
+		return x.Usage
+	}
+	return 0
+}
+
+func (x *EmissionsPeriod) GetEmissions() float64 {
+	if x != nil {
+		return x.Emissions
+	}
+	return 0
+}
+
+type EmissionsMonth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Month          string             `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"`                     // 月份，例："2025-08"
+	Usage          float64            `protobuf:"fixed64,2,opt,name=usage,proto3" json:"usage,omitempty"`                   // 用电量(kWh)
+	Emissions      float64            `protobuf:"fixed64,3,opt,name=emissions,proto3" json:"emissions,omitempty"`           // 排放量(tCO2)
+	Factor         float64            `protobuf:"fixed64,4,opt,name=factor,proto3" json:"factor,omitempty"`                 // 碳排因子(kgCO2/kWh)
+	FactorYear     int64              `protobuf:"varint,5,opt,name=factorYear,proto3" json:"factorYear,omitempty"`          // 因子年份
+	FactorArea     string             `protobuf:"bytes,6,opt,name=factorArea,proto3" json:"factorArea,omitempty"`           // 因子区域
+	Fallback       bool               `protobuf:"varint,7,opt,name=fallback,proto3" json:"fallback,omitempty"`              // 是否回退至全国因子
+	EstimatedRatio float64            `protobuf:"fixed64,8,opt,name=estimatedRatio,proto3" json:"estimatedRatio,omitempty"` // 用电估算占比，仅readings有效
+	Periods        []*EmissionsPeriod `protobuf:"bytes,9,rep,name=periods,proto3" json:"periods,omitempty"`                 // 分时段明细
+	FactorLevel    string             `protobuf:"bytes,10,opt,name=factorLevel,proto3" json:"factorLevel,omitempty"`        // 因子层级：province、region、national
+	FactorSource   string             `protobuf:"bytes,11,opt,name=factorSource,proto3" json:"factorSource,omitempty"`      // 因子来源文件
+	FactorCategory string             `protobuf:"bytes,12,opt,name=factorCategory,proto3" json:"factorCategory,omitempty"`  // 因子类型
+}
+
+func (x *EmissionsMonth) Reset() {
+	*x = EmissionsMonth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cron_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmissionsMonth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmissionsMonth) ProtoMessage() {}
+
+func (x *EmissionsMonth) ProtoReflect() protoreflect.Message {
+	mi := &file_cron_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmissionsMonth.ProtoReflect.Descriptor instead.
+func (*EmissionsMonth) Descriptor() ([]byte, []int) {
+	return file_cron_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *EmissionsMonth) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *EmissionsMonth) GetUsage() float64 {
+	if x != nil {
+		return x.Usage
+	}
+	return 0
+}
+
+func (x *EmissionsMonth) GetEmissions() float64 {
+	if x != nil {
+		return x.Emissions
+	}
+	return 0
+}
+
+func (x *EmissionsMonth) GetFactor() float64 {
+	if x != nil {
+		return x.Factor
+	}
+	return 0
+}
+
+func (x *EmissionsMonth) GetFactorYear() int64 {
+	if x != nil {
+		return x.FactorYear
+	}
+	return 0
+}
+
+func (x *EmissionsMonth) GetFactorArea() string {
+	if x != nil {
+		return x.FactorArea
+	}
+	return ""
+}
+
+func (x *EmissionsMonth) GetFallback() bool {
+	if x != nil {
+		return x.Fallback
+	}
+	return false
+}
+
+func (x *EmissionsMonth) GetEstimatedRatio() float64 {
+	if x != nil {
+		return x.EstimatedRatio
+	}
+	return 0
+}
+
+func (x *EmissionsMonth) GetPeriods() []*EmissionsPeriod {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+func (x *EmissionsMonth) GetFactorLevel() string {
+	if x != nil {
+		return x.FactorLevel
+	}
+	return ""
+}
+
+func (x *EmissionsMonth) GetFactorSource() string {
+	if x != nil {
+		return x.FactorSource
+	}
+	return ""
+}
+
+func (x *EmissionsMonth) GetFactorCategory() string {
+	if x != nil {
+		return x.FactorCategory
+	}
+	return ""
+}
+
+type EmissionsRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Usage     float64           `protobuf:"fixed64,1,opt,name=usage,proto3" json:"usage,omitempty"`         // 总用电量(kWh)
+	Emissions float64           `protobuf:"fixed64,2,opt,name=emissions,proto3" json:"emissions,omitempty"` // 总排放量(tCO2)
+	Months    []*EmissionsMonth `protobuf:"bytes,3,rep,name=months,proto3" json:"months,omitempty"`         // 月度明细
+	Csv       string            `protobuf:"bytes,4,opt,name=csv,proto3" json:"csv,omitempty"`               // CSV文本，req.csv为true时有效
+}
+
+func (x *EmissionsRsp) Reset() {
+	*x = EmissionsRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cron_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmissionsRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmissionsRsp) ProtoMessage() {}
+
+func (x *EmissionsRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_cron_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmissionsRsp.ProtoReflect.Descriptor instead.
+func (*EmissionsRsp) Descriptor() ([]byte, []int) {
+	return file_cron_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *EmissionsRsp) GetUsage() float64 {
+	if x != nil {
+		return x.Usage
+	}
+	return 0
+}
+
+func (x *EmissionsRsp) GetEmissions() float64 {
+	if x != nil {
+		return x.Emissions
+	}
+	return 0
+}
+
+func (x *EmissionsRsp) GetMonths() []*EmissionsMonth {
+	if x != nil {
+		return x.Months
+	}
+	return nil
+}
+
+func (x *EmissionsRsp) GetCsv() string {
+	if x != nil {
+		return x.Csv
+	}
+	return ""
+}
+
+type UsagePeriod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartDate string  `protobuf:"bytes,1,opt,name=startDate,proto3" json:"startDate,omitempty"` // 开始日期
+	EndDate   string  `protobuf:"bytes,2,opt,name=endDate,proto3" json:"endDate,omitempty"`     // 结束日期(含)
+	Usage     float64 `protobuf:"fixed64,3,opt,name=usage,proto3" json:"usage,omitempty"`       // 用电量(kWh)
+}
+
+func (x *UsagePeriod) Reset() {
+	*x = UsagePeriod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cron_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsagePeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsagePeriod) ProtoMessage() {}
+
+func (x *UsagePeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_cron_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsagePeriod.ProtoReflect.Descriptor instead.
+func (*UsagePeriod) Descriptor() ([]byte, []int) {
+	return file_cron_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UsagePeriod) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *UsagePeriod) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *UsagePeriod) GetUsage() float64 {
+	if x != nil {
+		return x.Usage
+	}
+	return 0
+}
+
+type SavingsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account   string         `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`     // 用户账号，用于电价
+	Area      string         `protobuf:"bytes,2,opt,name=area,proto3" json:"area,omitempty"`           // 用户区域
+	Address   string         `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`     // 用户地址，用于气温及假日
+	Baseline  []*UsagePeriod `protobuf:"bytes,4,rep,name=baseline,proto3" json:"baseline,omitempty"`   // 基准期(改造前)各周期用电量，通常12个月
+	Reporting []*UsagePeriod `protobuf:"bytes,5,rep,name=reporting,proto3" json:"reporting,omitempty"` // 报告期(改造后)各周期用电量
+	HeatBase  float64        `protobuf:"fixed64,6,opt,name=heatBase,proto3" json:"heatBase,omitempty"` // 采暖度日基准温度，缺省18℃
+	CoolBase  float64        `protobuf:"fixed64,7,opt,name=coolBase,proto3" json:"coolBase,omitempty"` // 制冷度日基准温度，缺省26℃
+	Source    string         `protobuf:"bytes,8,opt,name=source,proto3" json:"source,omitempty"`       // 气温来源，同GetDegreeDays
+}
+
+func (x *SavingsReq) Reset() {
+	*x = SavingsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cron_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SavingsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavingsReq) ProtoMessage() {}
+
+func (x *SavingsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cron_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavingsReq.ProtoReflect.Descriptor instead.
+func (*SavingsReq) Descriptor() ([]byte, []int) {
+	return file_cron_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SavingsReq) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *SavingsReq) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+func (x *SavingsReq) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SavingsReq) GetBaseline() []*UsagePeriod {
+	if x != nil {
+		return x.Baseline
+	}
+	return nil
+}
+
+func (x *SavingsReq) GetReporting() []*UsagePeriod {
+	if x != nil {
+		return x.Reporting
+	}
+	return nil
+}
+
+func (x *SavingsReq) GetHeatBase() float64 {
+	if x != nil {
+		return x.HeatBase
+	}
+	return 0
+}
+
+func (x *SavingsReq) GetCoolBase() float64 {
+	if x != nil {
+		return x.CoolBase
+	}
+	return 0
+}
+
+func (x *SavingsReq) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type BaselineCoef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`     // 变量：days、workDays、hdd、cdd
+	Value float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"` // 回归系数(kWh/单位)
+}
+
+func (x *BaselineCoef) Reset() {
+	*x = BaselineCoef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cron_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BaselineCoef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BaselineCoef) ProtoMessage() {}
+
+func (x *BaselineCoef) ProtoReflect() protoreflect.Message {
+	mi := &file_cron_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BaselineCoef.ProtoReflect.Descriptor instead.
+func (*BaselineCoef) Descriptor() ([]byte, []int) {
+	return file_cron_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *BaselineCoef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BaselineCoef) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type SavingsPeriod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartDate        string  `protobuf:"bytes,1,opt,name=startDate,proto3" json:"startDate,omitempty"`                 // 开始日期
+	EndDate          string  `protobuf:"bytes,2,opt,name=endDate,proto3" json:"endDate,omitempty"`                     // 结束日期(含)
+	Usage            float64 `protobuf:"fixed64,3,opt,name=usage,proto3" json:"usage,omitempty"`                       // 实际用电量(kWh)
+	AdjustedBaseline float64 `protobuf:"fixed64,4,opt,name=adjustedBaseline,proto3" json:"adjustedBaseline,omitempty"` // 调整后基准用电量(kWh)
+	AvoidedEnergy    float64 `protobuf:"fixed64,5,opt,name=avoidedEnergy,proto3" json:"avoidedEnergy,omitempty"`       // 节约电量(kWh)
+	AvoidedCost      float64 `protobuf:"fixed64,6,opt,name=avoidedCost,proto3" json:"avoidedCost,omitempty"`           // 节约电费
+	Price            float64 `protobuf:"fixed64,7,opt,name=price,proto3" json:"price,omitempty"`                       // 平均电度电价
+	Days             int64   `protobuf:"varint,8,opt,name=days,proto3" json:"days,omitempty"`                          // 天数
+	WorkDays         int64   `protobuf:"varint,9,opt,name=workDays,proto3" json:"workDays,omitempty"`                  // 工作日数
+	Hdd              float64 `protobuf:"fixed64,10,opt,name=hdd,proto3" json:"hdd,omitempty"`                          // 采暖度日
+	Cdd              float64 `protobuf:"fixed64,11,opt,name=cdd,proto3" json:"cdd,omitempty"`                          // 制冷度日
+	Coverage         float64 `protobuf:"fixed64,12,opt,name=coverage,proto3" json:"coverage,omitempty"`                // 气温数据覆盖率
+}
+
+func (x *SavingsPeriod) Reset() {
+	*x = SavingsPeriod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cron_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SavingsPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavingsPeriod) ProtoMessage() {}
+
+func (x *SavingsPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_cron_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavingsPeriod.ProtoReflect.Descriptor instead.
+func (*SavingsPeriod) Descriptor() ([]byte, []int) {
+	return file_cron_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SavingsPeriod) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *SavingsPeriod) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *SavingsPeriod) GetUsage() float64 {
+	if x != nil {
+		return x.Usage
+	}
+	return 0
+}
+
+func (x *SavingsPeriod) GetAdjustedBaseline() float64 {
+	if x != nil {
+		return x.AdjustedBaseline
+	}
+	return 0
+}
+
+func (x *SavingsPeriod) GetAvoidedEnergy() float64 {
+	if x != nil {
+		return x.AvoidedEnergy
+	}
+	return 0
+}
+
+func (x *SavingsPeriod) GetAvoidedCost() float64 {
+	if x != nil {
+		return x.AvoidedCost
+	}
+	return 0
+}
+
+func (x *SavingsPeriod) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SavingsPeriod) GetDays() int64 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *SavingsPeriod) GetWorkDays() int64 {
+	if x != nil {
+		return x.WorkDays
+	}
+	return 0
+}
+
+func (x *SavingsPeriod) GetHdd() float64 {
+	if x != nil {
+		return x.Hdd
+	}
+	return 0
+}
+
+func (x *SavingsPeriod) GetCdd() float64 {
+	if x != nil {
+		return x.Cdd
+	}
+	return 0
+}
+
+func (x *SavingsPeriod) GetCoverage() float64 {
+	if x != nil {
+		return x.Coverage
+	}
+	return 0
+}
+
+type SavingsRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Coefs            []*BaselineCoef  `protobuf:"bytes,1,rep,name=coefs,proto3" json:"coefs,omitempty"`                         // 基准模型回归系数
+	R2               float64          `protobuf:"fixed64,2,opt,name=r2,proto3" json:"r2,omitempty"`                             // 决定系数
+	CvRmse           float64          `protobuf:"fixed64,3,opt,name=cvRmse,proto3" json:"cvRmse,omitempty"`                     // 均方根误差变异系数
+	BaselineSize     int64            `protobuf:"varint,4,opt,name=baselineSize,proto3" json:"baselineSize,omitempty"`          // 基准期周期数
+	Usage            float64          `protobuf:"fixed64,5,opt,name=usage,proto3" json:"usage,omitempty"`                       // 报告期实际用电量(kWh)
+	AdjustedBaseline float64          `protobuf:"fixed64,6,opt,name=adjustedBaseline,proto3" json:"adjustedBaseline,omitempty"` // 报告期调整后基准用电量(kWh)
+	AvoidedEnergy    float64          `protobuf:"fixed64,7,opt,name=avoidedEnergy,proto3" json:"avoidedEnergy,omitempty"`       // 节约电量(kWh)
+	AvoidedCost      float64          `protobuf:"fixed64,8,opt,name=avoidedCost,proto3" json:"avoidedCost,omitempty"`           // 节约电费
+	SavingsRatio     float64          `protobuf:"fixed64,9,opt,name=savingsRatio,proto3" json:"savingsRatio,omitempty"`         // 节能率
+	Periods          []*SavingsPeriod `protobuf:"bytes,10,rep,name=periods,proto3" json:"periods,omitempty"`                    // 报告期明细
+	Warnings         []string         `protobuf:"bytes,11,rep,name=warnings,proto3" json:"warnings,omitempty"`                  // 模型质量提示
+}
+
+func (x *SavingsRsp) Reset() {
+	*x = SavingsRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cron_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SavingsRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavingsRsp) ProtoMessage() {}
+
+func (x *SavingsRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_cron_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavingsRsp.ProtoReflect.Descriptor instead.
+func (*SavingsRsp) Descriptor() ([]byte, []int) {
+	return file_cron_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SavingsRsp) GetCoefs() []*BaselineCoef {
+	if x != nil {
+		return x.Coefs
+	}
+	return nil
+}
+
+func (x *SavingsRsp) GetR2() float64 {
+	if x != nil {
+		return x.R2
+	}
+	return 0
+}
+
+func (x *SavingsRsp) GetCvRmse() float64 {
+	if x != nil {
+		return x.CvRmse
+	}
+	return 0
+}
+
+func (x *SavingsRsp) GetBaselineSize() int64 {
+	if x != nil {
+		return x.BaselineSize
+	}
+	return 0
+}
+
+func (x *SavingsRsp) GetUsage() float64 {
+	if x != nil {
+		return x.Usage
+	}
+	return 0
+}
+
+func (x *SavingsRsp) GetAdjustedBaseline() float64 {
+	if x != nil {
+		return x.AdjustedBaseline
+	}
+	return 0
+}
+
+func (x *SavingsRsp) GetAvoidedEnergy() float64 {
+	if x != nil {
+		return x.AvoidedEnergy
+	}
+	return 0
+}
+
+func (x *SavingsRsp) GetAvoidedCost() float64 {
+	if x != nil {
+		return x.AvoidedCost
+	}
+	return 0
+}
+
+func (x *SavingsRsp) GetSavingsRatio() float64 {
+	if x != nil {
+		return x.SavingsRatio
+	}
+	return 0
+}
+
+func (x *SavingsRsp) GetPeriods() []*SavingsPeriod {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+func (x *SavingsRsp) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type OcrUsageReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartMonth string `protobuf:"bytes,1,opt,name=startMonth,proto3" json:"startMonth,omitempty"` // 开始月份，例："2025-01"
+	EndMonth   string `protobuf:"bytes,2,opt,name=endMonth,proto3" json:"endMonth,omitempty"`     // 结束月份(含)，缺省为开始月份
+	Provider   string `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`     // 转换服务：ali、pdf24、local，缺省为全部
+}
+
+func (x *OcrUsageReq) Reset() {
+	*x = OcrUsageReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cron_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OcrUsageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OcrUsageReq) ProtoMessage() {}
+
+func (x *OcrUsageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cron_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OcrUsageReq.ProtoReflect.Descriptor instead.
+func (*OcrUsageReq) Descriptor() ([]byte, []int) {
+	return file_cron_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *OcrUsageReq) GetStartMonth() string {
+	if x != nil {
+		return x.StartMonth
+	}
+	return ""
+}
+
+func (x *OcrUsageReq) GetEndMonth() string {
+	if x != nil {
+		return x.EndMonth
+	}
+	return ""
+}
+
+func (x *OcrUsageReq) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+type OcrUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider          string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`                    // 转换服务
+	Month             string `protobuf:"bytes,2,opt,name=month,proto3" json:"month,omitempty"`                          // 月份
+	Source            string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`                        // 任务类别，如dlgd、twdl
+	Conversions       int64  `protobuf:"varint,4,opt,name=conversions,proto3" json:"conversions,omitempty"`             // 转换次数
+	Pages             int64  `protobuf:"varint,5,opt,name=pages,proto3" json:"pages,omitempty"`                         // 转换页数
+	CachedConversions int64  `protobuf:"varint,6,opt,name=cachedConversions,proto3" json:"cachedConversions,omitempty"` // 缓存命中次数
+	CachedPages       int64  `protobuf:"varint,7,opt,name=cachedPages,proto3" json:"cachedPages,omitempty"`             // 缓存命中页数(节省页数)
+	Billable          bool   `protobuf:"varint,8,opt,name=billable,proto3" json:"billable,omitempty"`                   // 是否计费服务
+}
+
+func (x *OcrUsage) Reset() {
+	*x = OcrUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cron_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OcrUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OcrUsage) ProtoMessage() {}
+
+func (x *OcrUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_cron_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OcrUsage.ProtoReflect.Descriptor instead.
+func (*OcrUsage) Descriptor() ([]byte, []int) {
+	return file_cron_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *OcrUsage) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *OcrUsage) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *OcrUsage) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *OcrUsage) GetConversions() int64 {
+	if x != nil {
+		return x.Conversions
+	}
+	return 0
+}
+
+func (x *OcrUsage) GetPages() int64 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *OcrUsage) GetCachedConversions() int64 {
+	if x != nil {
+		return x.CachedConversions
+	}
+	return 0
+}
+
+func (x *OcrUsage) GetCachedPages() int64 {
+	if x != nil {
+		return x.CachedPages
+	}
+	return 0
+}
+
+func (x *OcrUsage) GetBillable() bool {
+	if x != nil {
+		return x.Billable
+	}
+	return false
+}
+
+type OcrUsageRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Usages        []*OcrUsage `protobuf:"bytes,1,rep,name=usages,proto3" json:"usages,omitempty"`                // 按月份、转换服务、任务类别统计
+	BillablePages int64       `protobuf:"varint,2,opt,name=billablePages,proto3" json:"billablePages,omitempty"` // 计费页数合计
+	SavedPages    int64       `protobuf:"varint,3,opt,name=savedPages,proto3" json:"savedPages,omitempty"`       // 计费服务缓存节省页数合计
+}
+
+func (x *OcrUsageRsp) Reset() {
+	*x = OcrUsageRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cron_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OcrUsageRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OcrUsageRsp) ProtoMessage() {}
+
+func (x *OcrUsageRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_cron_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OcrUsageRsp.ProtoReflect.Descriptor instead.
+func (*OcrUsageRsp) Descriptor() ([]byte, []int) {
+	return file_cron_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *OcrUsageRsp) GetUsages() []*OcrUsage {
+	if x != nil {
+		return x.Usages
+	}
+	return nil
+}
+
+func (x *OcrUsageRsp) GetBillablePages() int64 {
+	if x != nil {
+		return x.BillablePages
+	}
+	return 0
+}
+
+func (x *OcrUsageRsp) GetSavedPages() int64 {
+	if x != nil {
+		return x.SavedPages
+	}
+	return 0
+}
+
+type ArtifactReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 归档记录ID
+}
+
+func (x *ArtifactReq) Reset() {
+	*x = ArtifactReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cron_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArtifactReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtifactReq) ProtoMessage() {}
+
+func (x *ArtifactReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cron_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtifactReq.ProtoReflect.Descriptor instead.
+func (*ArtifactReq) Descriptor() ([]byte, []int) {
+	return file_cron_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ArtifactReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+var File_cron_proto protoreflect.FileDescriptor
+
+var file_cron_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x63, 0x72,
+	0x6f, 0x6e, 0x22, 0x18, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x25, 0x0a, 0x09,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x29, 0x0a, 0x0d, 0x51, 0x75, 0x69, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xd2,
+	0x01, 0x0a, 0x08, 0x43, 0x72, 0x6f, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x0a, 0x0a, 0x08, 0x43, 0x72, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x22,
+	0x30, 0x0a, 0x08, 0x43, 0x72, 0x6f, 0x6e, 0x73, 0x52, 0x73, 0x70, 0x12, 0x24, 0x0a, 0x05, 0x63,
+	0x72, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x72, 0x6f,
+	0x6e, 0x2e, 0x43, 0x72, 0x6f, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x05, 0x63, 0x72, 0x6f, 0x6e,
+	0x73, 0x22, 0x31, 0x0a, 0x0b, 0x54, 0x6f, 0x64, 0x6f, 0x43, 0x72, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x22, 0x55, 0x0a, 0x09, 0x43, 0x61, 0x72, 0x62, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x79,
+	0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x93, 0x01, 0x0a, 0x09,
+	0x43, 0x61, 0x72, 0x62, 0x6f, 0x6e, 0x52, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x22, 0x80, 0x01, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x62, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x22, 0x4f, 0x0a, 0x0b, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x07, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x79, 0x57, 0x65, 0x61, 0x74,
+	0x68, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x79, 0x57, 0x65,
+	0x61, 0x74, 0x68, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x61, 0x79, 0x54, 0x65, 0x6d, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x64, 0x61, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x12,
+	0x22, 0x0a, 0x0c, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x57, 0x65, 0x61, 0x74,
+	0x68, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x54, 0x65, 0x6d, 0x70,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x54, 0x65, 0x6d,
+	0x70, 0x22, 0x38, 0x0a, 0x0b, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x73, 0x52, 0x73, 0x70,
+	0x12, 0x29, 0x0a, 0x08, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65,
+	0x72, 0x52, 0x08, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x73, 0x22, 0xcd, 0x01, 0x0a, 0x0d,
+	0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x44, 0x61, 0x79, 0x73, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x44, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x68,
+	0x65, 0x61, 0x74, 0x42, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x68,
+	0x65, 0x61, 0x74, 0x42, 0x61, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6f, 0x6c, 0x42,
+	0x61, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x63, 0x6f, 0x6f, 0x6c, 0x42,
+	0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xad, 0x02, 0x0a, 0x09,
+	0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x44, 0x61, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x68, 0x64, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x68, 0x64, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63,
+	0x64, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x63, 0x64, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x6d, 0x65, 0x61, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x6d, 0x65, 0x61, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x22, 0x0a,
+	0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x44, 0x61, 0x79, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x44, 0x61, 0x79,
+	0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x44, 0x61, 0x79,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x64, 0x44, 0x61, 0x79, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x22, 0xdc, 0x01, 0x0a, 0x0d,
+	0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x44, 0x61, 0x79, 0x73, 0x52, 0x73, 0x70, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74,
+	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x65, 0x61, 0x74, 0x42, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x08, 0x68, 0x65, 0x61, 0x74, 0x42, 0x61, 0x73, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x6f, 0x6f, 0x6c, 0x42, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x63, 0x6f, 0x6f, 0x6c, 0x42, 0x61, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x68, 0x64, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x68, 0x64, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63,
+	0x64, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x63, 0x64, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65,
+	0x44, 0x61, 0x79, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x50, 0x0a, 0x0a, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x68, 0x0a, 0x0a,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x6d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x22, 0x3b, 0x0a, 0x0b, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61,
+	0x79, 0x73, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x79,
+	0x65, 0x61, 0x72, 0x22, 0x61, 0x0a, 0x07, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x38, 0x0a, 0x0b, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61,
+	0x79, 0x73, 0x52, 0x73, 0x70, 0x12, 0x29, 0x0a, 0x08, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x48,
+	0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x52, 0x08, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73,
+	0x22, 0x55, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x29, 0x0a, 0x08,
+	0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x52, 0x08, 0x68,
+	0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x22, 0x3a, 0x0a, 0x08, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x22, 0x5e, 0x0a, 0x08, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x73, 0x70, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73,
+	0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f,
+	0x6c, 0x6f, 0x72, 0x22, 0x52, 0x0a, 0x0e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61,
+	0x72, 0x65, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0xd8, 0x01, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x65,
+	0x73, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x68, 0x6f, 0x75, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x75, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x22, 0x3a, 0x0a, 0x0e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x73, 0x70, 0x12, 0x28, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x8b,
+	0x02, 0x0a, 0x07, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x16,
+	0x0a, 0x06, 0x65, 0x70, 0x33, 0x30, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06,
+	0x65, 0x70, 0x33, 0x30, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x71, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x65, 0x71, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x69, 0x6e, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x69,
+	0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x61, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x61, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x78, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x78, 0x22, 0x54, 0x0a, 0x0c,
+	0x4d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x64, 0x22, 0x76, 0x0a, 0x0a, 0x42, 0x69, 0x6c, 0x6c, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65,
+	0x73, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63,
+	0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x22, 0xcd, 0x03, 0x0a, 0x07, 0x42,
+	0x69, 0x6c, 0x6c, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x69,
+	0x63, 0x46, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x62, 0x61, 0x73, 0x69,
+	0x63, 0x46, 0x65, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x61, 0x67, 0x65, 0x46, 0x65, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x75, 0x73, 0x61, 0x67, 0x65, 0x46, 0x65, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x66, 0x46, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x70, 0x66, 0x46, 0x65, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x67, 0x65, 0x46,
+	0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x73, 0x74, 0x61, 0x67, 0x65, 0x46,
+	0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x72, 0x6f, 0x6e,
+	0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x07, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x64, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x65, 0x73, 0x74,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0e, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x52, 0x61, 0x74,
+	0x69, 0x6f, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x65, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x22, 0x0a, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x77, 0x61, 0x72, 0x64, 0x53, 0x69, 0x7a, 0x65,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x77, 0x61, 0x72, 0x64,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x6e, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65,
+	0x64, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x75, 0x6e, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x2f, 0x0a, 0x13, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x59, 0x0a, 0x13, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x46, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0c, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x40, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x22, 0x92, 0x03, 0x0a, 0x0e, 0x55, 0x73, 0x65,
+	0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x46, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63,
+	0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63,
+	0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x06, 0x64, 0x65, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x43, 0x61, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x43, 0x61, 0x70, 0x12,
+	0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x43, 0x61, 0x70, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x43, 0x61, 0x70, 0x12,
+	0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x65, 0x72, 0x43, 0x61, 0x70, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6e, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x65, 0x72,
+	0x43, 0x61, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x6d, 0x69, 0x50, 0x65, 0x61, 0x6b, 0x43,
+	0x61, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x65, 0x6d, 0x69, 0x50, 0x65,
+	0x61, 0x6b, 0x43, 0x61, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x61, 0x74, 0x53, 0x65, 0x6d, 0x69,
+	0x50, 0x65, 0x61, 0x6b, 0x43, 0x61, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x73,
+	0x61, 0x74, 0x53, 0x65, 0x6d, 0x69, 0x50, 0x65, 0x61, 0x6b, 0x43, 0x61, 0x70, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6f, 0x66, 0x66, 0x50, 0x65, 0x61, 0x6b, 0x43, 0x61, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0a, 0x6f, 0x66, 0x66, 0x50, 0x65, 0x61, 0x6b, 0x43, 0x61, 0x70, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3e, 0x0a,
+	0x0e, 0x41, 0x64, 0x64, 0x44, 0x6c, 0x67, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x52, 0x65, 0x71, 0x12,
+	0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61,
+	0x72, 0x65, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x6d, 0x0a,
+	0x0b, 0x44, 0x6c, 0x67, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04,
+	0x61, 0x72, 0x65, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x65, 0x61,
+	0x12, 0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x4e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x64, 0x6f, 0x63, 0x4e, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xb4, 0x01, 0x0a,
+	0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6c, 0x67, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x4e, 0x6f,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6f, 0x63, 0x4e, 0x6f, 0x12, 0x24, 0x0a,
+	0x05, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63,
+	0x72, 0x6f, 0x6e, 0x2e, 0x44, 0x6c, 0x67, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x52, 0x05, 0x68, 0x6f,
+	0x75, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x22, 0x9e, 0x02, 0x0a, 0x08, 0x44, 0x6c, 0x67, 0x64, 0x48, 0x6f, 0x75, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x61, 0x72, 0x65, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x4e, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6f, 0x63, 0x4e, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x65, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65,
+	0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x77, 0x65,
+	0x65, 0x6b, 0x65, 0x6e, 0x64, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x77, 0x65, 0x65, 0x6b, 0x65, 0x6e, 0x64, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x64, 0x22, 0xb0, 0x01, 0x0a, 0x0e, 0x44, 0x6c, 0x67, 0x64, 0x48, 0x6f, 0x75,
+	0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x61, 0x73, 0x65, 0x44, 0x6f, 0x63,
+	0x4e, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x44, 0x6f,
+	0x63, 0x4e, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xae, 0x01, 0x0a, 0x0c, 0x44, 0x6c, 0x67, 0x64,
+	0x48, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x73, 0x70, 0x12, 0x24, 0x0a, 0x05, 0x68, 0x6f, 0x75, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x44,
+	0x6c, 0x67, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x52, 0x05, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x62, 0x61, 0x73, 0x65, 0x44, 0x6f, 0x63, 0x4e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x44, 0x6f, 0x63, 0x4e, 0x6f, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x69, 0x66, 0x66, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66,
+	0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x44, 0x6c, 0x67, 0x64, 0x48, 0x6f, 0x75,
+	0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x22, 0x5e, 0x0a, 0x0c, 0x44, 0x6c, 0x67, 0x64,
+	0x48, 0x6f, 0x75, 0x72, 0x53, 0x70, 0x61, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x65, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x91, 0x01, 0x0a, 0x13, 0x44, 0x6c, 0x67,
+	0x64, 0x48, 0x6f, 0x75, 0x72, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x70, 0x61, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x44, 0x6c, 0x67, 0x64, 0x48,
+	0x6f, 0x75, 0x72, 0x53, 0x70, 0x61, 0x6e, 0x52, 0x05, 0x73, 0x70, 0x61, 0x6e, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x75, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x75, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xa8, 0x01, 0x0a,
+	0x11, 0x44, 0x6c, 0x67, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x50, 0x61, 0x72, 0x73, 0x65, 0x52,
+	0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x05,
+	0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x72,
+	0x6f, 0x6e, 0x2e, 0x44, 0x6c, 0x67, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x52, 0x05, 0x68, 0x6f, 0x75,
+	0x72, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x44,
+	0x6c, 0x67, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x22, 0x64, 0x0a, 0x0e, 0x54, 0x6f, 0x75, 0x43, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x64, 0x0a,
+	0x0e, 0x54, 0x6f, 0x75, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x73, 0x70, 0x12,
+	0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x69,
+	0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x53,
+	0x69, 0x7a, 0x65, 0x22, 0xa9, 0x01, 0x0a, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72,
+	0x65, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x05,
+	0x62, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x72,
+	0x6f, 0x6e, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x52, 0x05, 0x62, 0x69, 0x6c, 0x6c,
+	0x73, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x73, 0x76, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03,
+	0x63, 0x73, 0x76, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22,
+	0x6d, 0x0a, 0x0f, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x09, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x95,
+	0x03, 0x0a, 0x0e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x6f, 0x6e, 0x74,
+	0x68, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x59, 0x65, 0x61,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x59,
+	0x65, 0x61, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x41, 0x72, 0x65,
+	0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x41,
+	0x72, 0x65, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12,
+	0x26, 0x0a, 0x0e, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x52, 0x61, 0x74, 0x69,
+	0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x64, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x2f, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e,
+	0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52,
+	0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x26,
+	0x0a, 0x0e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x82, 0x01, 0x0a, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x6d,
+	0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x72,
+	0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x6f, 0x6e, 0x74,
+	0x68, 0x52, 0x06, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x73, 0x76,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x73, 0x76, 0x22, 0x5b, 0x0a, 0x0b, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x22, 0x84, 0x02, 0x0a, 0x0a, 0x53, 0x61, 0x76,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x61, 0x72, 0x65, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x2d, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x2f,
+	0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12,
+	0x1a, 0x0a, 0x08, 0x68, 0x65, 0x61, 0x74, 0x42, 0x61, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x08, 0x68, 0x65, 0x61, 0x74, 0x42, 0x61, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x6f, 0x6f, 0x6c, 0x42, 0x61, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x63,
+	0x6f, 0x6f, 0x6c, 0x42, 0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22,
+	0x38, 0x0a, 0x0c, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x65, 0x66, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xd7, 0x02, 0x0a, 0x0d, 0x53, 0x61,
+	0x76, 0x69, 0x6e, 0x67, 0x73, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64,
+	0x44, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x64, 0x6a,
+	0x75, 0x73, 0x74, 0x65, 0x64, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x10, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x42, 0x61, 0x73,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x76, 0x6f, 0x69, 0x64, 0x65, 0x64,
+	0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x61, 0x76,
+	0x6f, 0x69, 0x64, 0x65, 0x64, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x61,
+	0x76, 0x6f, 0x69, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0b, 0x61, 0x76, 0x6f, 0x69, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x44,
+	0x61, 0x79, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x44,
+	0x61, 0x79, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x68, 0x64, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x03, 0x68, 0x64, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x64, 0x64, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x03, 0x63, 0x64, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x22, 0xfb, 0x02, 0x0a, 0x0a, 0x53, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x73, 0x70, 0x12, 0x28, 0x0a, 0x05, 0x63, 0x6f, 0x65, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x43, 0x6f, 0x65, 0x66, 0x52, 0x05, 0x63, 0x6f, 0x65, 0x66, 0x73, 0x12, 0x0e, 0x0a, 0x02,
+	0x72, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x72, 0x32, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x76, 0x52, 0x6d, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x63, 0x76,
+	0x52, 0x6d, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a,
+	0x0a, 0x10, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74,
+	0x65, 0x64, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x76,
+	0x6f, 0x69, 0x64, 0x65, 0x64, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0d, 0x61, 0x76, 0x6f, 0x69, 0x64, 0x65, 0x64, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79,
+	0x12, 0x20, 0x0a, 0x0b, 0x61, 0x76, 0x6f, 0x69, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x61, 0x76, 0x6f, 0x69, 0x64, 0x65, 0x64, 0x43, 0x6f,
+	0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x61, 0x74,
+	0x69, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x2d, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x53,
+	0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x07, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x22, 0x65, 0x0a, 0x0b, 0x4f, 0x63, 0x72, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x6f, 0x6e, 0x74, 0x68,
+	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0xf8, 0x01, 0x0a, 0x08, 0x4f, 0x63, 0x72,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x70, 0x61, 0x67, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x64, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x11, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x50,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x64, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x69, 0x6c, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x62, 0x69, 0x6c, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x22, 0x7b, 0x0a, 0x0b, 0x4f, 0x63, 0x72, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x73, 0x70, 0x12, 0x26, 0x0a, 0x06, 0x75, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x4f, 0x63, 0x72, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x06, 0x75, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x69,
+	0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x62, 0x69, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x61, 0x76, 0x65, 0x64, 0x50, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x61, 0x76, 0x65, 0x64, 0x50, 0x61, 0x67, 0x65, 0x73,
+	0x22, 0x1d, 0x0a, 0x0b, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x32,
+	0xa1, 0x0e, 0x0a, 0x04, 0x43, 0x72, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x0a, 0x51, 0x75, 0x69, 0x63,
+	0x6b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x13, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x51, 0x75,
+	0x69, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x72,
+	0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x73, 0x70, 0x12, 0x2a, 0x0a, 0x08,
+	0x47, 0x65, 0x74, 0x43, 0x72, 0x6f, 0x6e, 0x73, 0x12, 0x0e, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e,
+	0x43, 0x72, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e,
+	0x43, 0x72, 0x6f, 0x6e, 0x73, 0x52, 0x73, 0x70, 0x12, 0x2a, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x43,
+	0x72, 0x6f, 0x6e, 0x12, 0x0e, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x43, 0x72, 0x6f, 0x6e, 0x42,
+	0x6f, 0x64, 0x79, 0x1a, 0x0f, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x73, 0x70, 0x12, 0x2d, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72,
+	0x6f, 0x6e, 0x12, 0x0e, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x43, 0x72, 0x6f, 0x6e, 0x42, 0x6f,
+	0x64, 0x79, 0x1a, 0x0f, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x73, 0x70, 0x12, 0x2b, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x72, 0x6f,
+	0x6e, 0x12, 0x0c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a,
+	0x0f, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x73, 0x70,
+	0x12, 0x2e, 0x0a, 0x08, 0x54, 0x6f, 0x64, 0x6f, 0x43, 0x72, 0x6f, 0x6e, 0x12, 0x11, 0x2e, 0x63,
+	0x72, 0x6f, 0x6e, 0x2e, 0x54, 0x6f, 0x64, 0x6f, 0x43, 0x72, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a,
+	0x0f, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x73, 0x70,
+	0x12, 0x2d, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x62, 0x6f, 0x6e, 0x12, 0x0f, 0x2e,
+	0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x43, 0x61, 0x72, 0x62, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0f,
+	0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x43, 0x61, 0x72, 0x62, 0x6f, 0x6e, 0x52, 0x73, 0x70, 0x12,
+	0x30, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x62, 0x6f, 0x6e, 0x12, 0x12, 0x2e, 0x63,
+	0x72, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x62, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x1a, 0x0f, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x73,
+	0x70, 0x12, 0x33, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x73,
+	0x12, 0x11, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68,
+	0x65, 0x72, 0x73, 0x52, 0x73, 0x70, 0x12, 0x39, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x44, 0x65, 0x67,
+	0x72, 0x65, 0x65, 0x44, 0x61, 0x79, 0x73, 0x12, 0x13, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x44,
+	0x65, 0x67, 0x72, 0x65, 0x65, 0x44, 0x61, 0x79, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x63,
+	0x72, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x44, 0x61, 0x79, 0x73, 0x52, 0x73,
+	0x70, 0x12, 0x3b, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x57, 0x65, 0x61, 0x74,
+	0x68, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x2e, 0x63, 0x72, 0x6f,
+	0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x63,
+	0x72, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x70, 0x12, 0x33,
+	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x12, 0x11, 0x2e,
+	0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x11, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73,
+	0x52, 0x73, 0x70, 0x12, 0x34, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61,
+	0x79, 0x73, 0x12, 0x14, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x48, 0x6f, 0x6c,
+	0x69, 0x64, 0x61, 0x79, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x73, 0x70, 0x12, 0x2e, 0x0a, 0x0d, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x12, 0x0c, 0x2e, 0x63, 0x72, 0x6f,
+	0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x73, 0x70, 0x12, 0x2a, 0x0a, 0x08, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x52, 0x73, 0x70, 0x12, 0x44, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x70, 0x63, 0x6f,
+	0x6d, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x14, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x73, 0x70, 0x12, 0x2e, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x42, 0x69, 0x6c, 0x6c, 0x12, 0x0d, 0x2e,
+	0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x63,
+	0x72, 0x6f, 0x6e, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x73, 0x70, 0x12, 0x4b, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x19, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e,
+	0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x73, 0x70, 0x12, 0x3d, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x63, 0x72, 0x6f, 0x6e,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x1a, 0x14, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x36, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x55, 0x73,
+	0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x0f,
+	0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x73, 0x70, 0x12,
+	0x39, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x0f, 0x2e, 0x63, 0x72, 0x6f, 0x6e,
+	0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x73, 0x70, 0x12, 0x31, 0x0a, 0x10, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c,
+	0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63,
+	0x72, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x73, 0x70, 0x12, 0x3d, 0x0a,
+	0x0c, 0x41, 0x64, 0x64, 0x44, 0x6c, 0x67, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x14, 0x2e,
+	0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x44, 0x6c, 0x67, 0x64, 0x48, 0x6f, 0x75, 0x72,
+	0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x44, 0x6c, 0x67, 0x64, 0x48,
+	0x6f, 0x75, 0x72, 0x73, 0x50, 0x61, 0x72, 0x73, 0x65, 0x52, 0x73, 0x70, 0x12, 0x3f, 0x0a, 0x0e,
+	0x50, 0x61, 0x72, 0x73, 0x65, 0x44, 0x6c, 0x67, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x14,
+	0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x44, 0x6c, 0x67, 0x64, 0x48, 0x6f, 0x75,
+	0x72, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x44, 0x6c, 0x67, 0x64,
+	0x48, 0x6f, 0x75, 0x72, 0x73, 0x50, 0x61, 0x72, 0x73, 0x65, 0x52, 0x73, 0x70, 0x12, 0x36, 0x0a,
+	0x10, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x44, 0x6c, 0x67, 0x64, 0x48, 0x6f, 0x75, 0x72,
+	0x73, 0x12, 0x11, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x44, 0x6c, 0x67, 0x64, 0x48, 0x6f, 0x75,
+	0x72, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x73, 0x70, 0x12, 0x3c, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44,
+	0x6c, 0x67, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x18, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6c, 0x67, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x73, 0x70, 0x12, 0x35, 0x0a, 0x0f, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x6c, 0x67,
+	0x64, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x11, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x44, 0x6c,
+	0x67, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x72, 0x6f, 0x6e,
+	0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x73, 0x70, 0x12, 0x35, 0x0a, 0x0c, 0x47, 0x65,
+	0x74, 0x44, 0x6c, 0x67, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x11, 0x2e, 0x63, 0x72, 0x6f,
+	0x6e, 0x2e, 0x44, 0x6c, 0x67, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e,
+	0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x44, 0x6c, 0x67, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x73,
+	0x70, 0x12, 0x3f, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f, 0x75, 0x43, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x14, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x54, 0x6f,
+	0x75, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63,
+	0x72, 0x6f, 0x6e, 0x2e, 0x54, 0x6f, 0x75, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52,
+	0x73, 0x70, 0x12, 0x3c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e,
+	0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x63,
+	0x72, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x73, 0x70,
+	0x12, 0x33, 0x0a, 0x0d, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x61, 0x76, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x10, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x53, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x53, 0x61, 0x76, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x73, 0x70, 0x12, 0x33, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4f, 0x63, 0x72, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x11, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x4f, 0x63, 0x72, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x4f,
+	0x63, 0x72, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x73, 0x70, 0x12, 0x37, 0x0a, 0x11, 0x52, 0x65,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12,
+	0x11, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x73, 0x70, 0x42, 0x08, 0x5a, 0x06, 0x2e, 0x2f, 0x63, 0x72, 0x6f, 0x6e, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cron_proto_rawDescOnce sync.Once
+	file_cron_proto_rawDescData = file_cron_proto_rawDesc
+)
+
+func file_cron_proto_rawDescGZIP() []byte {
+	file_cron_proto_rawDescOnce.Do(func() {
+		file_cron_proto_rawDescData = protoimpl.X.CompressGZIP(file_cron_proto_rawDescData)
+	})
+	return file_cron_proto_rawDescData
+}
+
+var file_cron_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
+var file_cron_proto_goTypes = []interface{}{
+	(*DelReq)(nil),              // 0: cron.DelReq
+	(*ResultRsp)(nil),           // 1: cron.ResultRsp
+	(*QuickStartReq)(nil),       // 2: cron.QuickStartReq
+	(*CronBody)(nil),            // 3: cron.CronBody
+	(*CronsReq)(nil),            // 4: cron.CronsReq
+	(*CronsRsp)(nil),            // 5: cron.CronsRsp
+	(*TodoCronReq)(nil),         // 6: cron.TodoCronReq
+	(*CarbonReq)(nil),           // 7: cron.CarbonReq
+	(*CarbonRsp)(nil),           // 8: cron.CarbonRsp
+	(*AddCarbonReq)(nil),        // 9: cron.AddCarbonReq
+	(*WeathersReq)(nil),         // 10: cron.WeathersReq
+	(*Weather)(nil),             // 11: cron.Weather
+	(*WeathersRsp)(nil),         // 12: cron.WeathersRsp
+	(*DegreeDaysReq)(nil),       // 13: cron.DegreeDaysReq
+	(*DegreeDay)(nil),           // 14: cron.DegreeDay
+	(*DegreeDaysRsp)(nil),       // 15: cron.DegreeDaysRsp
+	(*StationReq)(nil),          // 16: cron.StationReq
+	(*StationRsp)(nil),          // 17: cron.StationRsp
+	(*HolidaysReq)(nil),         // 18: cron.HolidaysReq
+	(*Holiday)(nil),             // 19: cron.Holiday
+	(*HolidaysRsp)(nil),         // 20: cron.HolidaysRsp
+	(*AddHolidaysReq)(nil),      // 21: cron.AddHolidaysReq
+	(*PriceReq)(nil),            // 22: cron.PriceReq
+	(*PriceRsp)(nil),            // 23: cron.PriceRsp
+	(*PriceEventsReq)(nil),      // 24: cron.PriceEventsReq
+	(*PriceEvent)(nil),          // 25: cron.PriceEvent
+	(*PriceEventsRsp)(nil),      // 26: cron.PriceEventsRsp
+	(*BillReq)(nil),             // 27: cron.BillReq
+	(*MeterReading)(nil),        // 28: cron.MeterReading
+	(*BillDetail)(nil),          // 29: cron.BillDetail
+	(*BillRsp)(nil),             // 30: cron.BillRsp
+	(*AvailableOptionsReq)(nil), // 31: cron.AvailableOptionsReq
+	(*AvailableOptionsRsp)(nil), // 32: cron.AvailableOptionsRsp
+	(*GetUserOptionReq)(nil),    // 33: cron.GetUserOptionReq
+	(*UserOptionBody)(nil),      // 34: cron.UserOptionBody
+	(*AddDlgdHourReq)(nil),      // 35: cron.AddDlgdHourReq
+	(*DlgdHourReq)(nil),         // 36: cron.DlgdHourReq
+	(*UpdateDlgdHoursReq)(nil),  // 37: cron.UpdateDlgdHoursReq
+	(*DlgdHour)(nil),            // 38: cron.DlgdHour
+	(*DlgdHourReview)(nil),      // 39: cron.DlgdHourReview
+	(*DlgdHoursRsp)(nil),        // 40: cron.DlgdHoursRsp
+	(*DlgdHourSpan)(nil),        // 41: cron.DlgdHourSpan
+	(*DlgdHourDiagnostics)(nil), // 42: cron.DlgdHourDiagnostics
+	(*DlgdHoursParseRsp)(nil),   // 43: cron.DlgdHoursParseRsp
+	(*TouCalendarReq)(nil),      // 44: cron.TouCalendarReq
+	(*TouCalendarRsp)(nil),      // 45: cron.TouCalendarRsp
+	(*EmissionsReq)(nil),        // 46: cron.EmissionsReq
+	(*EmissionsPeriod)(nil),     // 47: cron.EmissionsPeriod
+	(*EmissionsMonth)(nil),      // 48: cron.EmissionsMonth
+	(*EmissionsRsp)(nil),        // 49: cron.EmissionsRsp
+	(*UsagePeriod)(nil),         // 50: cron.UsagePeriod
+	(*SavingsReq)(nil),          // 51: cron.SavingsReq
+	(*BaselineCoef)(nil),        // 52: cron.BaselineCoef
+	(*SavingsPeriod)(nil),       // 53: cron.SavingsPeriod
+	(*SavingsRsp)(nil),          // 54: cron.SavingsRsp
+	(*OcrUsageReq)(nil),         // 55: cron.OcrUsageReq
+	(*OcrUsage)(nil),            // 56: cron.OcrUsage
+	(*OcrUsageRsp)(nil),         // 57: cron.OcrUsageRsp
+	(*ArtifactReq)(nil),         // 58: cron.ArtifactReq
+}
+var file_cron_proto_depIdxs = []int32{
+	3,  // 0: cron.CronsRsp.crons:type_name -> cron.CronBody
+	11, // 1: cron.WeathersRsp.weathers:type_name -> cron.Weather
+	14, // 2: cron.DegreeDaysRsp.items:type_name -> cron.DegreeDay
+	19, // 3: cron.HolidaysRsp.holidays:type_name -> cron.Holiday
+	19, // 4: cron.AddHolidaysReq.holidays:type_name -> cron.Holiday
+	25, // 5: cron.PriceEventsRsp.events:type_name -> cron.PriceEvent
+	28, // 6: cron.BillReq.readings:type_name -> cron.MeterReading
+	29, // 7: cron.BillRsp.details:type_name -> cron.BillDetail
+	38, // 8: cron.UpdateDlgdHoursReq.hours:type_name -> cron.DlgdHour
+	38, // 9: cron.DlgdHoursRsp.hours:type_name -> cron.DlgdHour
+	39, // 10: cron.DlgdHoursRsp.reviews:type_name -> cron.DlgdHourReview
+	41, // 11: cron.DlgdHourDiagnostics.spans:type_name -> cron.DlgdHourSpan
+	38, // 12: cron.DlgdHoursParseRsp.hours:type_name -> cron.DlgdHour
+	42, // 13: cron.DlgdHoursParseRsp.diagnostics:type_name -> cron.DlgdHourDiagnostics
+	27, // 14: cron.EmissionsReq.bills:type_name -> cron.BillReq
+	47, // 15: cron.EmissionsMonth.periods:type_name -> cron.EmissionsPeriod
+	48, // 16: cron.EmissionsRsp.months:type_name -> cron.EmissionsMonth
+	50, // 17: cron.SavingsReq.baseline:type_name -> cron.UsagePeriod
+	50, // 18: cron.SavingsReq.reporting:type_name -> cron.UsagePeriod
+	52, // 19: cron.SavingsRsp.coefs:type_name -> cron.BaselineCoef
+	53, // 20: cron.SavingsRsp.periods:type_name -> cron.SavingsPeriod
+	56, // 21: cron.OcrUsageRsp.usages:type_name -> cron.OcrUsage
+	2,  // 22: cron.Cron.QuickStart:input_type -> cron.QuickStartReq
+	4,  // 23: cron.Cron.GetCrons:input_type -> cron.CronsReq
+	3,  // 24: cron.Cron.AddCron:input_type -> cron.CronBody
+	3,  // 25: cron.Cron.UpdateCron:input_type -> cron.CronBody
+	0,  // 26: cron.Cron.DeleteCron:input_type -> cron.DelReq
+	6,  // 27: cron.Cron.TodoCron:input_type -> cron.TodoCronReq
+	7,  // 28: cron.Cron.GetCarbon:input_type -> cron.CarbonReq
+	9,  // 29: cron.Cron.AddCarbon:input_type -> cron.AddCarbonReq
+	10, // 30: cron.Cron.GetWeathers:input_type -> cron.WeathersReq
+	13, // 31: cron.Cron.GetDegreeDays:input_type -> cron.DegreeDaysReq
+	16, // 32: cron.Cron.ResolveWeatherStation:input_type -> cron.StationReq
+	18, // 33: cron.Cron.GetHolidays:input_type -> cron.HolidaysReq
+	21, // 34: cron.Cron.AddHolidays:input_type -> cron.AddHolidaysReq
+	0,  // 35: cron.Cron.DeleteHoliday:input_type -> cron.DelReq
+	22, // 36: cron.Cron.GetPrice:input_type -> cron.PriceReq
+	24, // 37: cron.Cron.GetUpcomingPriceEvents:input_type -> cron.PriceEventsReq
+	27, // 38: cron.Cron.GetMonthlyBill:input_type -> cron.BillReq
+	31, // 39: cron.Cron.GetAvailableOptions:input_type -> cron.AvailableOptionsReq
+	33, // 40: cron.Cron.GetUserOption:input_type -> cron.GetUserOptionReq
+	34, // 41: cron.Cron.AddUserOption:input_type -> cron.UserOptionBody
+	34, // 42: cron.Cron.UpdateUserOption:input_type -> cron.UserOptionBody
+	0,  // 43: cron.Cron.DeleteUserOption:input_type -> cron.DelReq
+	35, // 44: cron.Cron.AddDlgdHours:input_type -> cron.AddDlgdHourReq
+	35, // 45: cron.Cron.ParseDlgdHours:input_type -> cron.AddDlgdHourReq
+	36, // 46: cron.Cron.ConfirmDlgdHours:input_type -> cron.DlgdHourReq
+	37, // 47: cron.Cron.UpdateDlgdHours:input_type -> cron.UpdateDlgdHoursReq
+	36, // 48: cron.Cron.RejectDlgdHours:input_type -> cron.DlgdHourReq
+	36, // 49: cron.Cron.GetDlgdHours:input_type -> cron.DlgdHourReq
+	44, // 50: cron.Cron.ExportTouCalendar:input_type -> cron.TouCalendarReq
+	46, // 51: cron.Cron.GetEmissionsReport:input_type -> cron.EmissionsReq
+	51, // 52: cron.Cron.VerifySavings:input_type -> cron.SavingsReq
+	55, // 53: cron.Cron.GetOcrUsage:input_type -> cron.OcrUsageReq
+	58, // 54: cron.Cron.ReprocessArtifact:input_type -> cron.ArtifactReq
+	1,  // 55: cron.Cron.QuickStart:output_type -> cron.ResultRsp
+	5,  // 56: cron.Cron.GetCrons:output_type -> cron.CronsRsp
+	1,  // 57: cron.Cron.AddCron:output_type -> cron.ResultRsp
+	1,  // 58: cron.Cron.UpdateCron:output_type -> cron.ResultRsp
+	1,  // 59: cron.Cron.DeleteCron:output_type -> cron.ResultRsp
+	1,  // 60: cron.Cron.TodoCron:output_type -> cron.ResultRsp
+	8,  // 61: cron.Cron.GetCarbon:output_type -> cron.CarbonRsp
+	1,  // 62: cron.Cron.AddCarbon:output_type -> cron.ResultRsp
+	12, // 63: cron.Cron.GetWeathers:output_type -> cron.WeathersRsp
+	15, // 64: cron.Cron.GetDegreeDays:output_type -> cron.DegreeDaysRsp
+	17, // 65: cron.Cron.ResolveWeatherStation:output_type -> cron.StationRsp
+	20, // 66: cron.Cron.GetHolidays:output_type -> cron.HolidaysRsp
+	1,  // 67: cron.Cron.AddHolidays:output_type -> cron.ResultRsp
+	1,  // 68: cron.Cron.DeleteHoliday:output_type -> cron.ResultRsp
+	23, // 69: cron.Cron.GetPrice:output_type -> cron.PriceRsp
+	26, // 70: cron.Cron.GetUpcomingPriceEvents:output_type -> cron.PriceEventsRsp
+	30, // 71: cron.Cron.GetMonthlyBill:output_type -> cron.BillRsp
+	32, // 72: cron.Cron.GetAvailableOptions:output_type -> cron.AvailableOptionsRsp
+	34, // 73: cron.Cron.GetUserOption:output_type -> cron.UserOptionBody
+	1,  // 74: cron.Cron.AddUserOption:output_type -> cron.ResultRsp
+	1,  // 75: cron.Cron.UpdateUserOption:output_type -> cron.ResultRsp
+	1,  // 76: cron.Cron.DeleteUserOption:output_type -> cron.ResultRsp
+	43, // 77: cron.Cron.AddDlgdHours:output_type -> cron.DlgdHoursParseRsp
+	43, // 78: cron.Cron.ParseDlgdHours:output_type -> cron.DlgdHoursParseRsp
+	1,  // 79: cron.Cron.ConfirmDlgdHours:output_type -> cron.ResultRsp
+	1,  // 80: cron.Cron.UpdateDlgdHours:output_type -> cron.ResultRsp
+	1,  // 81: cron.Cron.RejectDlgdHours:output_type -> cron.ResultRsp
+	40, // 82: cron.Cron.GetDlgdHours:output_type -> cron.DlgdHoursRsp
+	45, // 83: cron.Cron.ExportTouCalendar:output_type -> cron.TouCalendarRsp
+	49, // 84: cron.Cron.GetEmissionsReport:output_type -> cron.EmissionsRsp
+	54, // 85: cron.Cron.VerifySavings:output_type -> cron.SavingsRsp
+	57, // 86: cron.Cron.GetOcrUsage:output_type -> cron.OcrUsageRsp
+	1,  // 87: cron.Cron.ReprocessArtifact:output_type -> cron.ResultRsp
+	55, // [55:88] is the sub-list for method output_type
+	22, // [22:55] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
+}
+
+func init() { file_cron_proto_init() }
+func file_cron_proto_init() {
+	if File_cron_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_cron_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cron_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResultRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cron_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuickStartReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cron_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CronBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cron_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CronsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cron_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CronsRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cron_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TodoCronReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cron_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CarbonReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cron_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CarbonRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cron_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCarbonReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cron_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WeathersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cron_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Weather); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cron_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WeathersRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cron_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DegreeDaysReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cron_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DegreeDay); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cron_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DegreeDaysRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cron_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StationReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cron_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StationRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cron_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HolidaysReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cron_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Holiday); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cron_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HolidaysRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cron_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddHolidaysReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cron_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cron_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cron_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceEventsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cron_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2322,8 +5670,8 @@ func file_cron_proto_init() {
 				return nil
 			}
 		}
-		file_cron_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResultRsp); i {
+		file_cron_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceEventsRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2334,8 +5682,8 @@ func file_cron_proto_init() {
 				return nil
 			}
 		}
-		file_cron_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuickStartReq); i {
+		file_cron_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BillReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2346,8 +5694,8 @@ func file_cron_proto_init() {
 				return nil
 			}
 		}
-		file_cron_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CronBody); i {
+		file_cron_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeterReading); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2358,8 +5706,8 @@ func file_cron_proto_init() {
 				return nil
 			}
 		}
-		file_cron_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CronsReq); i {
+		file_cron_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BillDetail); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2370,8 +5718,8 @@ func file_cron_proto_init() {
 				return nil
 			}
 		}
-		file_cron_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CronsRsp); i {
+		file_cron_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BillRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2382,8 +5730,8 @@ func file_cron_proto_init() {
 				return nil
 			}
 		}
-		file_cron_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TodoCronReq); i {
+		file_cron_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AvailableOptionsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2394,8 +5742,8 @@ func file_cron_proto_init() {
 				return nil
 			}
 		}
-		file_cron_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CarbonReq); i {
+		file_cron_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AvailableOptionsRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2406,8 +5754,8 @@ func file_cron_proto_init() {
 				return nil
 			}
 		}
-		file_cron_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CarbonRsp); i {
+		file_cron_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserOptionReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2418,8 +5766,8 @@ func file_cron_proto_init() {
 				return nil
 			}
 		}
-		file_cron_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddCarbonReq); i {
+		file_cron_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserOptionBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2430,8 +5778,8 @@ func file_cron_proto_init() {
 				return nil
 			}
 		}
-		file_cron_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WeathersReq); i {
+		file_cron_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddDlgdHourReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2442,8 +5790,8 @@ func file_cron_proto_init() {
 				return nil
 			}
 		}
-		file_cron_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Weather); i {
+		file_cron_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DlgdHourReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2454,8 +5802,8 @@ func file_cron_proto_init() {
 				return nil
 			}
 		}
-		file_cron_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WeathersRsp); i {
+		file_cron_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateDlgdHoursReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2466,8 +5814,8 @@ func file_cron_proto_init() {
 				return nil
 			}
 		}
-		file_cron_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HolidaysReq); i {
+		file_cron_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DlgdHour); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2478,8 +5826,8 @@ func file_cron_proto_init() {
 				return nil
 			}
 		}
-		file_cron_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Holiday); i {
+		file_cron_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DlgdHourReview); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2490,8 +5838,8 @@ func file_cron_proto_init() {
 				return nil
 			}
 		}
-		file_cron_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HolidaysRsp); i {
+		file_cron_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DlgdHoursRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2502,8 +5850,8 @@ func file_cron_proto_init() {
 				return nil
 			}
 		}
-		file_cron_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddHolidaysReq); i {
+		file_cron_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DlgdHourSpan); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2514,8 +5862,8 @@ func file_cron_proto_init() {
 				return nil
 			}
 		}
-		file_cron_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PriceReq); i {
+		file_cron_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DlgdHourDiagnostics); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2526,8 +5874,8 @@ func file_cron_proto_init() {
 				return nil
 			}
 		}
-		file_cron_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PriceRsp); i {
+		file_cron_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DlgdHoursParseRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2538,8 +5886,8 @@ func file_cron_proto_init() {
 				return nil
 			}
 		}
-		file_cron_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BillReq); i {
+		file_cron_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TouCalendarReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2550,8 +5898,8 @@ func file_cron_proto_init() {
 				return nil
 			}
 		}
-		file_cron_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BillDetail); i {
+		file_cron_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TouCalendarRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2562,8 +5910,8 @@ func file_cron_proto_init() {
 				return nil
 			}
 		}
-		file_cron_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BillRsp); i {
+		file_cron_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmissionsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2574,8 +5922,8 @@ func file_cron_proto_init() {
 				return nil
 			}
 		}
-		file_cron_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AvailableOptionsReq); i {
+		file_cron_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmissionsPeriod); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2586,8 +5934,8 @@ func file_cron_proto_init() {
 				return nil
 			}
 		}
-		file_cron_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AvailableOptionsRsp); i {
+		file_cron_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmissionsMonth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2598,8 +5946,8 @@ func file_cron_proto_init() {
 				return nil
 			}
 		}
-		file_cron_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserOptionReq); i {
+		file_cron_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmissionsRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2610,8 +5958,8 @@ func file_cron_proto_init() {
 				return nil
 			}
 		}
-		file_cron_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserOptionBody); i {
+		file_cron_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsagePeriod); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2622,8 +5970,8 @@ func file_cron_proto_init() {
 				return nil
 			}
 		}
-		file_cron_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddDlgdHourReq); i {
+		file_cron_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SavingsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2634,8 +5982,8 @@ func file_cron_proto_init() {
 				return nil
 			}
 		}
-		file_cron_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DlgdHourReq); i {
+		file_cron_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BaselineCoef); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2646,8 +5994,8 @@ func file_cron_proto_init() {
 				return nil
 			}
 		}
-		file_cron_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DlgdHour); i {
+		file_cron_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SavingsPeriod); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2658,8 +6006,56 @@ func file_cron_proto_init() {
 				return nil
 			}
 		}
-		file_cron_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DlgdHoursRsp); i {
+		file_cron_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SavingsRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cron_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OcrUsageReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cron_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OcrUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cron_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OcrUsageRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cron_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArtifactReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2677,7 +6073,7 @@ func file_cron_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cron_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   30,
+			NumMessages:   59,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
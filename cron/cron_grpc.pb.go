@@ -40,6 +40,10 @@ type CronClient interface {
 	AddCarbon(ctx context.Context, in *AddCarbonReq, opts ...grpc.CallOption) (*ResultRsp, error)
 	// 获取天气预报列表
 	GetWeathers(ctx context.Context, in *WeathersReq, opts ...grpc.CallOption) (*WeathersRsp, error)
+	// 获取采暖/制冷度日
+	GetDegreeDays(ctx context.Context, in *DegreeDaysReq, opts ...grpc.CallOption) (*DegreeDaysRsp, error)
+	// 查询/指定气象站代码
+	ResolveWeatherStation(ctx context.Context, in *StationReq, opts ...grpc.CallOption) (*StationRsp, error)
 	// 获取假日列表
 	GetHolidays(ctx context.Context, in *HolidaysReq, opts ...grpc.CallOption) (*HolidaysRsp, error)
 	// 新增/更新假日
@@ -48,6 +52,8 @@ type CronClient interface {
 	DeleteHoliday(ctx context.Context, in *DelReq, opts ...grpc.CallOption) (*ResultRsp, error)
 	// 获取电价
 	GetPrice(ctx context.Context, in *PriceReq, opts ...grpc.CallOption) (*PriceRsp, error)
+	// 获取近期电价预警(气温触发尖峰/高峰)
+	GetUpcomingPriceEvents(ctx context.Context, in *PriceEventsReq, opts ...grpc.CallOption) (*PriceEventsRsp, error)
 	// 获取账单
 	GetMonthlyBill(ctx context.Context, in *BillReq, opts ...grpc.CallOption) (*BillRsp, error)
 	// 获取用电档案可选项
@@ -61,11 +67,27 @@ type CronClient interface {
 	// 删除用电档案
 	DeleteUserOption(ctx context.Context, in *DelReq, opts ...grpc.CallOption) (*ResultRsp, error)
 	// 新增用电时段
-	AddDlgdHours(ctx context.Context, in *AddDlgdHourReq, opts ...grpc.CallOption) (*ResultRsp, error)
+	AddDlgdHours(ctx context.Context, in *AddDlgdHourReq, opts ...grpc.CallOption) (*DlgdHoursParseRsp, error)
+	// 预览用电时段解析结果(不保存)
+	ParseDlgdHours(ctx context.Context, in *AddDlgdHourReq, opts ...grpc.CallOption) (*DlgdHoursParseRsp, error)
 	// 确认用电时段
 	ConfirmDlgdHours(ctx context.Context, in *DlgdHourReq, opts ...grpc.CallOption) (*ResultRsp, error)
+	// 修改用电时段
+	UpdateDlgdHours(ctx context.Context, in *UpdateDlgdHoursReq, opts ...grpc.CallOption) (*ResultRsp, error)
+	// 驳回用电时段
+	RejectDlgdHours(ctx context.Context, in *DlgdHourReq, opts ...grpc.CallOption) (*ResultRsp, error)
 	// 查询用电时段
 	GetDlgdHours(ctx context.Context, in *DlgdHourReq, opts ...grpc.CallOption) (*DlgdHoursRsp, error)
+	// 导出分时日历(iCalendar)
+	ExportTouCalendar(ctx context.Context, in *TouCalendarReq, opts ...grpc.CallOption) (*TouCalendarRsp, error)
+	// 获取碳排报告(范围二)
+	GetEmissionsReport(ctx context.Context, in *EmissionsReq, opts ...grpc.CallOption) (*EmissionsRsp, error)
+	// 节能量核证(IPMVP选项C)
+	VerifySavings(ctx context.Context, in *SavingsReq, opts ...grpc.CallOption) (*SavingsRsp, error)
+	// 获取OCR用量
+	GetOcrUsage(ctx context.Context, in *OcrUsageReq, opts ...grpc.CallOption) (*OcrUsageRsp, error)
+	// 重新解析归档的电价原始文件
+	ReprocessArtifact(ctx context.Context, in *ArtifactReq, opts ...grpc.CallOption) (*ResultRsp, error)
 }
 
 type cronClient struct {
@@ -157,6 +179,24 @@ func (c *cronClient) GetWeathers(ctx context.Context, in *WeathersReq, opts ...g
 	return out, nil
 }
 
+func (c *cronClient) GetDegreeDays(ctx context.Context, in *DegreeDaysReq, opts ...grpc.CallOption) (*DegreeDaysRsp, error) {
+	out := new(DegreeDaysRsp)
+	err := c.cc.Invoke(ctx, "/cron.Cron/GetDegreeDays", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cronClient) ResolveWeatherStation(ctx context.Context, in *StationReq, opts ...grpc.CallOption) (*StationRsp, error) {
+	out := new(StationRsp)
+	err := c.cc.Invoke(ctx, "/cron.Cron/ResolveWeatherStation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cronClient) GetHolidays(ctx context.Context, in *HolidaysReq, opts ...grpc.CallOption) (*HolidaysRsp, error) {
 	out := new(HolidaysRsp)
 	err := c.cc.Invoke(ctx, "/cron.Cron/GetHolidays", in, out, opts...)
@@ -193,6 +233,15 @@ func (c *cronClient) GetPrice(ctx context.Context, in *PriceReq, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *cronClient) GetUpcomingPriceEvents(ctx context.Context, in *PriceEventsReq, opts ...grpc.CallOption) (*PriceEventsRsp, error) {
+	out := new(PriceEventsRsp)
+	err := c.cc.Invoke(ctx, "/cron.Cron/GetUpcomingPriceEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cronClient) GetMonthlyBill(ctx context.Context, in *BillReq, opts ...grpc.CallOption) (*BillRsp, error) {
 	out := new(BillRsp)
 	err := c.cc.Invoke(ctx, "/cron.Cron/GetMonthlyBill", in, out, opts...)
@@ -247,8 +296,8 @@ func (c *cronClient) DeleteUserOption(ctx context.Context, in *DelReq, opts ...g
 	return out, nil
 }
 
-func (c *cronClient) AddDlgdHours(ctx context.Context, in *AddDlgdHourReq, opts ...grpc.CallOption) (*ResultRsp, error) {
-	out := new(ResultRsp)
+func (c *cronClient) AddDlgdHours(ctx context.Context, in *AddDlgdHourReq, opts ...grpc.CallOption) (*DlgdHoursParseRsp, error) {
+	out := new(DlgdHoursParseRsp)
 	err := c.cc.Invoke(ctx, "/cron.Cron/AddDlgdHours", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -256,6 +305,15 @@ func (c *cronClient) AddDlgdHours(ctx context.Context, in *AddDlgdHourReq, opts
 	return out, nil
 }
 
+func (c *cronClient) ParseDlgdHours(ctx context.Context, in *AddDlgdHourReq, opts ...grpc.CallOption) (*DlgdHoursParseRsp, error) {
+	out := new(DlgdHoursParseRsp)
+	err := c.cc.Invoke(ctx, "/cron.Cron/ParseDlgdHours", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cronClient) ConfirmDlgdHours(ctx context.Context, in *DlgdHourReq, opts ...grpc.CallOption) (*ResultRsp, error) {
 	out := new(ResultRsp)
 	err := c.cc.Invoke(ctx, "/cron.Cron/ConfirmDlgdHours", in, out, opts...)
@@ -265,6 +323,24 @@ func (c *cronClient) ConfirmDlgdHours(ctx context.Context, in *DlgdHourReq, opts
 	return out, nil
 }
 
+func (c *cronClient) UpdateDlgdHours(ctx context.Context, in *UpdateDlgdHoursReq, opts ...grpc.CallOption) (*ResultRsp, error) {
+	out := new(ResultRsp)
+	err := c.cc.Invoke(ctx, "/cron.Cron/UpdateDlgdHours", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cronClient) RejectDlgdHours(ctx context.Context, in *DlgdHourReq, opts ...grpc.CallOption) (*ResultRsp, error) {
+	out := new(ResultRsp)
+	err := c.cc.Invoke(ctx, "/cron.Cron/RejectDlgdHours", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cronClient) GetDlgdHours(ctx context.Context, in *DlgdHourReq, opts ...grpc.CallOption) (*DlgdHoursRsp, error) {
 	out := new(DlgdHoursRsp)
 	err := c.cc.Invoke(ctx, "/cron.Cron/GetDlgdHours", in, out, opts...)
@@ -274,6 +350,51 @@ func (c *cronClient) GetDlgdHours(ctx context.Context, in *DlgdHourReq, opts ...
 	return out, nil
 }
 
+func (c *cronClient) ExportTouCalendar(ctx context.Context, in *TouCalendarReq, opts ...grpc.CallOption) (*TouCalendarRsp, error) {
+	out := new(TouCalendarRsp)
+	err := c.cc.Invoke(ctx, "/cron.Cron/ExportTouCalendar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cronClient) GetEmissionsReport(ctx context.Context, in *EmissionsReq, opts ...grpc.CallOption) (*EmissionsRsp, error) {
+	out := new(EmissionsRsp)
+	err := c.cc.Invoke(ctx, "/cron.Cron/GetEmissionsReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cronClient) VerifySavings(ctx context.Context, in *SavingsReq, opts ...grpc.CallOption) (*SavingsRsp, error) {
+	out := new(SavingsRsp)
+	err := c.cc.Invoke(ctx, "/cron.Cron/VerifySavings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cronClient) GetOcrUsage(ctx context.Context, in *OcrUsageReq, opts ...grpc.CallOption) (*OcrUsageRsp, error) {
+	out := new(OcrUsageRsp)
+	err := c.cc.Invoke(ctx, "/cron.Cron/GetOcrUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cronClient) ReprocessArtifact(ctx context.Context, in *ArtifactReq, opts ...grpc.CallOption) (*ResultRsp, error) {
+	out := new(ResultRsp)
+	err := c.cc.Invoke(ctx, "/cron.Cron/ReprocessArtifact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CronServer is the server API for Cron service.
 // All implementations must embed UnimplementedCronServer
 // for forward compatibility
@@ -296,6 +417,10 @@ type CronServer interface {
 	AddCarbon(context.Context, *AddCarbonReq) (*ResultRsp, error)
 	// 获取天气预报列表
 	GetWeathers(context.Context, *WeathersReq) (*WeathersRsp, error)
+	// 获取采暖/制冷度日
+	GetDegreeDays(context.Context, *DegreeDaysReq) (*DegreeDaysRsp, error)
+	// 查询/指定气象站代码
+	ResolveWeatherStation(context.Context, *StationReq) (*StationRsp, error)
 	// 获取假日列表
 	GetHolidays(context.Context, *HolidaysReq) (*HolidaysRsp, error)
 	// 新增/更新假日
@@ -304,6 +429,8 @@ type CronServer interface {
 	DeleteHoliday(context.Context, *DelReq) (*ResultRsp, error)
 	// 获取电价
 	GetPrice(context.Context, *PriceReq) (*PriceRsp, error)
+	// 获取近期电价预警(气温触发尖峰/高峰)
+	GetUpcomingPriceEvents(context.Context, *PriceEventsReq) (*PriceEventsRsp, error)
 	// 获取账单
 	GetMonthlyBill(context.Context, *BillReq) (*BillRsp, error)
 	// 获取用电档案可选项
@@ -317,11 +444,27 @@ type CronServer interface {
 	// 删除用电档案
 	DeleteUserOption(context.Context, *DelReq) (*ResultRsp, error)
 	// 新增用电时段
-	AddDlgdHours(context.Context, *AddDlgdHourReq) (*ResultRsp, error)
+	AddDlgdHours(context.Context, *AddDlgdHourReq) (*DlgdHoursParseRsp, error)
+	// 预览用电时段解析结果(不保存)
+	ParseDlgdHours(context.Context, *AddDlgdHourReq) (*DlgdHoursParseRsp, error)
 	// 确认用电时段
 	ConfirmDlgdHours(context.Context, *DlgdHourReq) (*ResultRsp, error)
+	// 修改用电时段
+	UpdateDlgdHours(context.Context, *UpdateDlgdHoursReq) (*ResultRsp, error)
+	// 驳回用电时段
+	RejectDlgdHours(context.Context, *DlgdHourReq) (*ResultRsp, error)
 	// 查询用电时段
 	GetDlgdHours(context.Context, *DlgdHourReq) (*DlgdHoursRsp, error)
+	// 导出分时日历(iCalendar)
+	ExportTouCalendar(context.Context, *TouCalendarReq) (*TouCalendarRsp, error)
+	// 获取碳排报告(范围二)
+	GetEmissionsReport(context.Context, *EmissionsReq) (*EmissionsRsp, error)
+	// 节能量核证(IPMVP选项C)
+	VerifySavings(context.Context, *SavingsReq) (*SavingsRsp, error)
+	// 获取OCR用量
+	GetOcrUsage(context.Context, *OcrUsageReq) (*OcrUsageRsp, error)
+	// 重新解析归档的电价原始文件
+	ReprocessArtifact(context.Context, *ArtifactReq) (*ResultRsp, error)
 	mustEmbedUnimplementedCronServer()
 }
 
@@ -356,6 +499,12 @@ func (UnimplementedCronServer) AddCarbon(context.Context, *AddCarbonReq) (*Resul
 func (UnimplementedCronServer) GetWeathers(context.Context, *WeathersReq) (*WeathersRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWeathers not implemented")
 }
+func (UnimplementedCronServer) GetDegreeDays(context.Context, *DegreeDaysReq) (*DegreeDaysRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDegreeDays not implemented")
+}
+func (UnimplementedCronServer) ResolveWeatherStation(context.Context, *StationReq) (*StationRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveWeatherStation not implemented")
+}
 func (UnimplementedCronServer) GetHolidays(context.Context, *HolidaysReq) (*HolidaysRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHolidays not implemented")
 }
@@ -368,6 +517,9 @@ func (UnimplementedCronServer) DeleteHoliday(context.Context, *DelReq) (*ResultR
 func (UnimplementedCronServer) GetPrice(context.Context, *PriceReq) (*PriceRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPrice not implemented")
 }
+func (UnimplementedCronServer) GetUpcomingPriceEvents(context.Context, *PriceEventsReq) (*PriceEventsRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUpcomingPriceEvents not implemented")
+}
 func (UnimplementedCronServer) GetMonthlyBill(context.Context, *BillReq) (*BillRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMonthlyBill not implemented")
 }
@@ -386,15 +538,39 @@ func (UnimplementedCronServer) UpdateUserOption(context.Context, *UserOptionBody
 func (UnimplementedCronServer) DeleteUserOption(context.Context, *DelReq) (*ResultRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserOption not implemented")
 }
-func (UnimplementedCronServer) AddDlgdHours(context.Context, *AddDlgdHourReq) (*ResultRsp, error) {
+func (UnimplementedCronServer) AddDlgdHours(context.Context, *AddDlgdHourReq) (*DlgdHoursParseRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddDlgdHours not implemented")
 }
+func (UnimplementedCronServer) ParseDlgdHours(context.Context, *AddDlgdHourReq) (*DlgdHoursParseRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParseDlgdHours not implemented")
+}
 func (UnimplementedCronServer) ConfirmDlgdHours(context.Context, *DlgdHourReq) (*ResultRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConfirmDlgdHours not implemented")
 }
+func (UnimplementedCronServer) UpdateDlgdHours(context.Context, *UpdateDlgdHoursReq) (*ResultRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDlgdHours not implemented")
+}
+func (UnimplementedCronServer) RejectDlgdHours(context.Context, *DlgdHourReq) (*ResultRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectDlgdHours not implemented")
+}
 func (UnimplementedCronServer) GetDlgdHours(context.Context, *DlgdHourReq) (*DlgdHoursRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDlgdHours not implemented")
 }
+func (UnimplementedCronServer) ExportTouCalendar(context.Context, *TouCalendarReq) (*TouCalendarRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportTouCalendar not implemented")
+}
+func (UnimplementedCronServer) GetEmissionsReport(context.Context, *EmissionsReq) (*EmissionsRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmissionsReport not implemented")
+}
+func (UnimplementedCronServer) VerifySavings(context.Context, *SavingsReq) (*SavingsRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifySavings not implemented")
+}
+func (UnimplementedCronServer) GetOcrUsage(context.Context, *OcrUsageReq) (*OcrUsageRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOcrUsage not implemented")
+}
+func (UnimplementedCronServer) ReprocessArtifact(context.Context, *ArtifactReq) (*ResultRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReprocessArtifact not implemented")
+}
 func (UnimplementedCronServer) mustEmbedUnimplementedCronServer() {}
 
 // UnsafeCronServer may be embedded to opt out of forward compatibility for this service.
@@ -570,6 +746,42 @@ func _Cron_GetWeathers_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cron_GetDegreeDays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DegreeDaysReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronServer).GetDegreeDays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cron.Cron/GetDegreeDays",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronServer).GetDegreeDays(ctx, req.(*DegreeDaysReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cron_ResolveWeatherStation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronServer).ResolveWeatherStation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cron.Cron/ResolveWeatherStation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronServer).ResolveWeatherStation(ctx, req.(*StationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Cron_GetHolidays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HolidaysReq)
 	if err := dec(in); err != nil {
@@ -642,6 +854,24 @@ func _Cron_GetPrice_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cron_GetUpcomingPriceEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceEventsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronServer).GetUpcomingPriceEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cron.Cron/GetUpcomingPriceEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronServer).GetUpcomingPriceEvents(ctx, req.(*PriceEventsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Cron_GetMonthlyBill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BillReq)
 	if err := dec(in); err != nil {
@@ -768,6 +998,24 @@ func _Cron_AddDlgdHours_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cron_ParseDlgdHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDlgdHourReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronServer).ParseDlgdHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cron.Cron/ParseDlgdHours",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronServer).ParseDlgdHours(ctx, req.(*AddDlgdHourReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Cron_ConfirmDlgdHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DlgdHourReq)
 	if err := dec(in); err != nil {
@@ -786,6 +1034,42 @@ func _Cron_ConfirmDlgdHours_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cron_UpdateDlgdHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDlgdHoursReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronServer).UpdateDlgdHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cron.Cron/UpdateDlgdHours",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronServer).UpdateDlgdHours(ctx, req.(*UpdateDlgdHoursReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cron_RejectDlgdHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DlgdHourReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronServer).RejectDlgdHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cron.Cron/RejectDlgdHours",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronServer).RejectDlgdHours(ctx, req.(*DlgdHourReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Cron_GetDlgdHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DlgdHourReq)
 	if err := dec(in); err != nil {
@@ -804,6 +1088,96 @@ func _Cron_GetDlgdHours_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cron_ExportTouCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TouCalendarReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronServer).ExportTouCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cron.Cron/ExportTouCalendar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronServer).ExportTouCalendar(ctx, req.(*TouCalendarReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cron_GetEmissionsReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmissionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronServer).GetEmissionsReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cron.Cron/GetEmissionsReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronServer).GetEmissionsReport(ctx, req.(*EmissionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cron_VerifySavings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavingsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronServer).VerifySavings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cron.Cron/VerifySavings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronServer).VerifySavings(ctx, req.(*SavingsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cron_GetOcrUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OcrUsageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronServer).GetOcrUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cron.Cron/GetOcrUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronServer).GetOcrUsage(ctx, req.(*OcrUsageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cron_ReprocessArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArtifactReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronServer).ReprocessArtifact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cron.Cron/ReprocessArtifact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronServer).ReprocessArtifact(ctx, req.(*ArtifactReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Cron_ServiceDesc is the grpc.ServiceDesc for Cron service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -847,6 +1221,14 @@ var Cron_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetWeathers",
 			Handler:    _Cron_GetWeathers_Handler,
 		},
+		{
+			MethodName: "GetDegreeDays",
+			Handler:    _Cron_GetDegreeDays_Handler,
+		},
+		{
+			MethodName: "ResolveWeatherStation",
+			Handler:    _Cron_ResolveWeatherStation_Handler,
+		},
 		{
 			MethodName: "GetHolidays",
 			Handler:    _Cron_GetHolidays_Handler,
@@ -863,6 +1245,10 @@ var Cron_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPrice",
 			Handler:    _Cron_GetPrice_Handler,
 		},
+		{
+			MethodName: "GetUpcomingPriceEvents",
+			Handler:    _Cron_GetUpcomingPriceEvents_Handler,
+		},
 		{
 			MethodName: "GetMonthlyBill",
 			Handler:    _Cron_GetMonthlyBill_Handler,
@@ -891,14 +1277,46 @@ var Cron_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddDlgdHours",
 			Handler:    _Cron_AddDlgdHours_Handler,
 		},
+		{
+			MethodName: "ParseDlgdHours",
+			Handler:    _Cron_ParseDlgdHours_Handler,
+		},
 		{
 			MethodName: "ConfirmDlgdHours",
 			Handler:    _Cron_ConfirmDlgdHours_Handler,
 		},
+		{
+			MethodName: "UpdateDlgdHours",
+			Handler:    _Cron_UpdateDlgdHours_Handler,
+		},
+		{
+			MethodName: "RejectDlgdHours",
+			Handler:    _Cron_RejectDlgdHours_Handler,
+		},
 		{
 			MethodName: "GetDlgdHours",
 			Handler:    _Cron_GetDlgdHours_Handler,
 		},
+		{
+			MethodName: "ExportTouCalendar",
+			Handler:    _Cron_ExportTouCalendar_Handler,
+		},
+		{
+			MethodName: "GetEmissionsReport",
+			Handler:    _Cron_GetEmissionsReport_Handler,
+		},
+		{
+			MethodName: "VerifySavings",
+			Handler:    _Cron_VerifySavings_Handler,
+		},
+		{
+			MethodName: "GetOcrUsage",
+			Handler:    _Cron_GetOcrUsage_Handler,
+		},
+		{
+			MethodName: "ReprocessArtifact",
+			Handler:    _Cron_ReprocessArtifact_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cron.proto",
@@ -17,8 +17,10 @@ type (
 	AddCarbonReq        = cron.AddCarbonReq
 	AddDlgdHourReq      = cron.AddDlgdHourReq
 	AddHolidaysReq      = cron.AddHolidaysReq
+	ArtifactReq         = cron.ArtifactReq
 	AvailableOptionsReq = cron.AvailableOptionsReq
 	AvailableOptionsRsp = cron.AvailableOptionsRsp
+	BaselineCoef        = cron.BaselineCoef
 	BillDetail          = cron.BillDetail
 	BillReq             = cron.BillReq
 	BillRsp             = cron.BillRsp
@@ -27,19 +29,46 @@ type (
 	CronBody            = cron.CronBody
 	CronsReq            = cron.CronsReq
 	CronsRsp            = cron.CronsRsp
+	DegreeDay           = cron.DegreeDay
+	DegreeDaysReq       = cron.DegreeDaysReq
+	DegreeDaysRsp       = cron.DegreeDaysRsp
 	DelReq              = cron.DelReq
 	DlgdHour            = cron.DlgdHour
+	DlgdHourDiagnostics = cron.DlgdHourDiagnostics
 	DlgdHourReq         = cron.DlgdHourReq
+	DlgdHourReview      = cron.DlgdHourReview
+	DlgdHourSpan        = cron.DlgdHourSpan
+	DlgdHoursParseRsp   = cron.DlgdHoursParseRsp
 	DlgdHoursRsp        = cron.DlgdHoursRsp
+	EmissionsMonth      = cron.EmissionsMonth
+	EmissionsPeriod     = cron.EmissionsPeriod
+	EmissionsReq        = cron.EmissionsReq
+	EmissionsRsp        = cron.EmissionsRsp
 	GetUserOptionReq    = cron.GetUserOptionReq
 	Holiday             = cron.Holiday
 	HolidaysReq         = cron.HolidaysReq
 	HolidaysRsp         = cron.HolidaysRsp
+	MeterReading        = cron.MeterReading
+	OcrUsage            = cron.OcrUsage
+	OcrUsageReq         = cron.OcrUsageReq
+	OcrUsageRsp         = cron.OcrUsageRsp
+	PriceEvent          = cron.PriceEvent
+	PriceEventsReq      = cron.PriceEventsReq
+	PriceEventsRsp      = cron.PriceEventsRsp
 	PriceReq            = cron.PriceReq
 	PriceRsp            = cron.PriceRsp
 	QuickStartReq       = cron.QuickStartReq
 	ResultRsp           = cron.ResultRsp
+	SavingsPeriod       = cron.SavingsPeriod
+	SavingsReq          = cron.SavingsReq
+	SavingsRsp          = cron.SavingsRsp
+	StationReq          = cron.StationReq
+	StationRsp          = cron.StationRsp
 	TodoCronReq         = cron.TodoCronReq
+	TouCalendarReq      = cron.TouCalendarReq
+	TouCalendarRsp      = cron.TouCalendarRsp
+	UpdateDlgdHoursReq  = cron.UpdateDlgdHoursReq
+	UsagePeriod         = cron.UsagePeriod
 	UserOptionBody      = cron.UserOptionBody
 	Weather             = cron.Weather
 	WeathersReq         = cron.WeathersReq
@@ -64,6 +93,10 @@ type (
 		AddCarbon(ctx context.Context, in *AddCarbonReq, opts ...grpc.CallOption) (*ResultRsp, error)
 		// 获取天气预报列表
 		GetWeathers(ctx context.Context, in *WeathersReq, opts ...grpc.CallOption) (*WeathersRsp, error)
+		// 获取采暖/制冷度日
+		GetDegreeDays(ctx context.Context, in *DegreeDaysReq, opts ...grpc.CallOption) (*DegreeDaysRsp, error)
+		// 查询/指定气象站代码
+		ResolveWeatherStation(ctx context.Context, in *StationReq, opts ...grpc.CallOption) (*StationRsp, error)
 		// 获取假日列表
 		GetHolidays(ctx context.Context, in *HolidaysReq, opts ...grpc.CallOption) (*HolidaysRsp, error)
 		// 新增/更新假日
@@ -72,6 +105,8 @@ type (
 		DeleteHoliday(ctx context.Context, in *DelReq, opts ...grpc.CallOption) (*ResultRsp, error)
 		// 获取电价
 		GetPrice(ctx context.Context, in *PriceReq, opts ...grpc.CallOption) (*PriceRsp, error)
+		// 获取近期电价预警(气温触发尖峰/高峰)
+		GetUpcomingPriceEvents(ctx context.Context, in *PriceEventsReq, opts ...grpc.CallOption) (*PriceEventsRsp, error)
 		// 获取账单
 		GetMonthlyBill(ctx context.Context, in *BillReq, opts ...grpc.CallOption) (*BillRsp, error)
 		// 获取用电档案可选项
@@ -85,11 +120,27 @@ type (
 		// 删除用电档案
 		DeleteUserOption(ctx context.Context, in *DelReq, opts ...grpc.CallOption) (*ResultRsp, error)
 		// 新增用电时段
-		AddDlgdHours(ctx context.Context, in *AddDlgdHourReq, opts ...grpc.CallOption) (*ResultRsp, error)
+		AddDlgdHours(ctx context.Context, in *AddDlgdHourReq, opts ...grpc.CallOption) (*DlgdHoursParseRsp, error)
+		// 预览用电时段解析结果(不保存)
+		ParseDlgdHours(ctx context.Context, in *AddDlgdHourReq, opts ...grpc.CallOption) (*DlgdHoursParseRsp, error)
 		// 确认用电时段
 		ConfirmDlgdHours(ctx context.Context, in *DlgdHourReq, opts ...grpc.CallOption) (*ResultRsp, error)
+		// 修改用电时段
+		UpdateDlgdHours(ctx context.Context, in *UpdateDlgdHoursReq, opts ...grpc.CallOption) (*ResultRsp, error)
+		// 驳回用电时段
+		RejectDlgdHours(ctx context.Context, in *DlgdHourReq, opts ...grpc.CallOption) (*ResultRsp, error)
 		// 查询用电时段
 		GetDlgdHours(ctx context.Context, in *DlgdHourReq, opts ...grpc.CallOption) (*DlgdHoursRsp, error)
+		// 导出分时日历(iCalendar)
+		ExportTouCalendar(ctx context.Context, in *TouCalendarReq, opts ...grpc.CallOption) (*TouCalendarRsp, error)
+		// 获取碳排报告(范围二)
+		GetEmissionsReport(ctx context.Context, in *EmissionsReq, opts ...grpc.CallOption) (*EmissionsRsp, error)
+		// 节能量核证(IPMVP选项C)
+		VerifySavings(ctx context.Context, in *SavingsReq, opts ...grpc.CallOption) (*SavingsRsp, error)
+		// 获取OCR用量
+		GetOcrUsage(ctx context.Context, in *OcrUsageReq, opts ...grpc.CallOption) (*OcrUsageRsp, error)
+		// 重新解析归档的电价原始文件
+		ReprocessArtifact(ctx context.Context, in *ArtifactReq, opts ...grpc.CallOption) (*ResultRsp, error)
 	}
 
 	defaultCron struct {
@@ -157,6 +208,18 @@ func (m *defaultCron) GetWeathers(ctx context.Context, in *WeathersReq, opts ...
 	return client.GetWeathers(ctx, in, opts...)
 }
 
+// 获取采暖/制冷度日
+func (m *defaultCron) GetDegreeDays(ctx context.Context, in *DegreeDaysReq, opts ...grpc.CallOption) (*DegreeDaysRsp, error) {
+	client := cron.NewCronClient(m.cli.Conn())
+	return client.GetDegreeDays(ctx, in, opts...)
+}
+
+// 查询/指定气象站代码
+func (m *defaultCron) ResolveWeatherStation(ctx context.Context, in *StationReq, opts ...grpc.CallOption) (*StationRsp, error) {
+	client := cron.NewCronClient(m.cli.Conn())
+	return client.ResolveWeatherStation(ctx, in, opts...)
+}
+
 // 获取假日列表
 func (m *defaultCron) GetHolidays(ctx context.Context, in *HolidaysReq, opts ...grpc.CallOption) (*HolidaysRsp, error) {
 	client := cron.NewCronClient(m.cli.Conn())
@@ -181,6 +244,12 @@ func (m *defaultCron) GetPrice(ctx context.Context, in *PriceReq, opts ...grpc.C
 	return client.GetPrice(ctx, in, opts...)
 }
 
+// 获取近期电价预警(气温触发尖峰/高峰)
+func (m *defaultCron) GetUpcomingPriceEvents(ctx context.Context, in *PriceEventsReq, opts ...grpc.CallOption) (*PriceEventsRsp, error) {
+	client := cron.NewCronClient(m.cli.Conn())
+	return client.GetUpcomingPriceEvents(ctx, in, opts...)
+}
+
 // 获取账单
 func (m *defaultCron) GetMonthlyBill(ctx context.Context, in *BillReq, opts ...grpc.CallOption) (*BillRsp, error) {
 	client := cron.NewCronClient(m.cli.Conn())
@@ -218,19 +287,67 @@ func (m *defaultCron) DeleteUserOption(ctx context.Context, in *DelReq, opts ...
 }
 
 // 新增用电时段
-func (m *defaultCron) AddDlgdHours(ctx context.Context, in *AddDlgdHourReq, opts ...grpc.CallOption) (*ResultRsp, error) {
+func (m *defaultCron) AddDlgdHours(ctx context.Context, in *AddDlgdHourReq, opts ...grpc.CallOption) (*DlgdHoursParseRsp, error) {
 	client := cron.NewCronClient(m.cli.Conn())
 	return client.AddDlgdHours(ctx, in, opts...)
 }
 
+// 预览用电时段解析结果(不保存)
+func (m *defaultCron) ParseDlgdHours(ctx context.Context, in *AddDlgdHourReq, opts ...grpc.CallOption) (*DlgdHoursParseRsp, error) {
+	client := cron.NewCronClient(m.cli.Conn())
+	return client.ParseDlgdHours(ctx, in, opts...)
+}
+
 // 确认用电时段
 func (m *defaultCron) ConfirmDlgdHours(ctx context.Context, in *DlgdHourReq, opts ...grpc.CallOption) (*ResultRsp, error) {
 	client := cron.NewCronClient(m.cli.Conn())
 	return client.ConfirmDlgdHours(ctx, in, opts...)
 }
 
+// 修改用电时段
+func (m *defaultCron) UpdateDlgdHours(ctx context.Context, in *UpdateDlgdHoursReq, opts ...grpc.CallOption) (*ResultRsp, error) {
+	client := cron.NewCronClient(m.cli.Conn())
+	return client.UpdateDlgdHours(ctx, in, opts...)
+}
+
+// 驳回用电时段
+func (m *defaultCron) RejectDlgdHours(ctx context.Context, in *DlgdHourReq, opts ...grpc.CallOption) (*ResultRsp, error) {
+	client := cron.NewCronClient(m.cli.Conn())
+	return client.RejectDlgdHours(ctx, in, opts...)
+}
+
 // 查询用电时段
 func (m *defaultCron) GetDlgdHours(ctx context.Context, in *DlgdHourReq, opts ...grpc.CallOption) (*DlgdHoursRsp, error) {
 	client := cron.NewCronClient(m.cli.Conn())
 	return client.GetDlgdHours(ctx, in, opts...)
 }
+
+// 导出分时日历(iCalendar)
+func (m *defaultCron) ExportTouCalendar(ctx context.Context, in *TouCalendarReq, opts ...grpc.CallOption) (*TouCalendarRsp, error) {
+	client := cron.NewCronClient(m.cli.Conn())
+	return client.ExportTouCalendar(ctx, in, opts...)
+}
+
+// 获取碳排报告(范围二)
+func (m *defaultCron) GetEmissionsReport(ctx context.Context, in *EmissionsReq, opts ...grpc.CallOption) (*EmissionsRsp, error) {
+	client := cron.NewCronClient(m.cli.Conn())
+	return client.GetEmissionsReport(ctx, in, opts...)
+}
+
+// 节能量核证(IPMVP选项C)
+func (m *defaultCron) VerifySavings(ctx context.Context, in *SavingsReq, opts ...grpc.CallOption) (*SavingsRsp, error) {
+	client := cron.NewCronClient(m.cli.Conn())
+	return client.VerifySavings(ctx, in, opts...)
+}
+
+// 获取OCR用量
+func (m *defaultCron) GetOcrUsage(ctx context.Context, in *OcrUsageReq, opts ...grpc.CallOption) (*OcrUsageRsp, error) {
+	client := cron.NewCronClient(m.cli.Conn())
+	return client.GetOcrUsage(ctx, in, opts...)
+}
+
+// 重新解析归档的电价原始文件
+func (m *defaultCron) ReprocessArtifact(ctx context.Context, in *ArtifactReq, opts ...grpc.CallOption) (*ResultRsp, error) {
+	client := cron.NewCronClient(m.cli.Conn())
+	return client.ReprocessArtifact(ctx, in, opts...)
+}
@@ -14,9 +14,24 @@ type Config struct {
 	CacheRedis cache.CacheConf
 	Mail       cronx.MailConfig
 	Ocr        struct {
-		Endpoint        string
-		AccessKeyId     string
-		AccessKeySecret string
+		Provider        string `json:",optional"` // 文档转换服务：ali(默认)、pdf24、local
+		Endpoint        string `json:",optional"`
+		AccessKeyId     string `json:",optional"`
+		AccessKeySecret string `json:",optional"`
+		Path            string `json:",optional"`         // local预录结果目录，或ali/pdf24录制目录
+		Command         string `json:",optional"`         // local本地转换命令
+		CacheDir        string `json:",default=ocrcache"` // 转换结果缓存目录
+		CacheDays       int    `json:",default=30"`       // 缓存保留天数
+	}
+	Archive struct {
+		Dir string `json:",default=archive"` // 电价原始文件及识别结果归档目录
+	}
+	Checkpoint struct {
+		Dir   string `json:",default=checkpoint"` // 任务断点目录，失败重试时自首个失败步骤继续
+		Hours int    `json:",default=24"`         // 断点有效期(小时)
+	}
+	DlgdCheck struct {
+		RatioTolerance float64 `json:",default=0.1"` // 分时电价与政策所述浮动比例的允许偏差
 	}
 	Migrate struct {
 		Dir     string
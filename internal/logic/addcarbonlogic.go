@@ -8,6 +8,7 @@ import (
 	"seeccloud.com/edscron/internal/svc"
 	"seeccloud.com/edscron/model"
 	"seeccloud.com/edscron/pkg/copierx"
+	"seeccloud.com/edscron/pkg/cronx"
 	"seeccloud.com/edscron/pkg/vars"
 	"seeccloud.com/edscron/pkg/x/expx"
 
@@ -35,7 +36,13 @@ func (l *AddCarbonLogic) AddCarbon(in *cron.AddCarbonReq) (*cron.ResultRsp, erro
 		return nil, err
 	}
 
-	carbon, _ := l.svcCtx.CarbonModel.FindOneByAreaYear(l.ctx, in.Area, in.Year)
+	category, err := cronx.ParseCarbonCategory(in.Category)
+	if err != nil {
+		return nil, err
+	}
+	in.Category = string(category)
+
+	carbon, _ := l.svcCtx.CarbonModel.FindOneByAreaCategoryYear(l.ctx, in.Area, in.Category, in.Year)
 	var c model.Carbon
 	copierx.MustCopy(&c, in)
 	// 创建或更新
@@ -5,7 +5,6 @@ import (
 
 	"seeccloud.com/edscron/cron"
 	"seeccloud.com/edscron/internal/svc"
-	"seeccloud.com/edscron/pkg/vars"
 	"seeccloud.com/edscron/pkg/x/expx"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -26,18 +25,16 @@ func NewAddDlgdHoursLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AddD
 }
 
 // 新增用电时段
-func (l *AddDlgdHoursLogic) AddDlgdHours(in *cron.AddDlgdHourReq) (*cron.ResultRsp, error) {
+func (l *AddDlgdHoursLogic) AddDlgdHours(in *cron.AddDlgdHourReq) (*cron.DlgdHoursParseRsp, error) {
 	err := expx.HasZeroError(in, "Area", "Comment")
 	if err != nil {
 		return nil, err
 	}
 
-	err = l.svcCtx.DlgdHourModel.InsertAll(l.ctx, in.Area, in.Comment)
+	hours, diag, err := l.svcCtx.DlgdHourModel.InsertAll(l.ctx, in.Area, in.Comment)
 	if err != nil {
 		return nil, err
 	}
 
-	return &cron.ResultRsp{
-		Message: vars.SuccessMessage,
-	}, nil
+	return newDlgdHoursParseRsp(hours, diag), nil
 }
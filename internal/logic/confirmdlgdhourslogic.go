@@ -2,13 +2,16 @@ package logic
 
 import (
 	"context"
+	"fmt"
 
 	"seeccloud.com/edscron/cron"
 	"seeccloud.com/edscron/internal/svc"
+	"seeccloud.com/edscron/model"
 	"seeccloud.com/edscron/pkg/vars"
 	"seeccloud.com/edscron/pkg/x/expx"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
 )
 
 type ConfirmDlgdHoursLogic struct {
@@ -32,12 +35,45 @@ func (l *ConfirmDlgdHoursLogic) ConfirmDlgdHours(in *cron.DlgdHourReq) (*cron.Re
 		return nil, err
 	}
 
+	if err := checkDlgdHoursDocNo(l.ctx, l.svcCtx, in.Area, in.DocNo); err != nil {
+		return nil, err
+	}
+
 	err = l.svcCtx.DlgdHourModel.ConfirmAll(l.ctx, in.Area, in.DocNo)
 	if err != nil {
 		return nil, err
 	}
 
+	if _, err := l.svcCtx.AddDlgdHourReview(l.ctx, in.Area, in.DocNo, model.DlgdHourReviewApprove, in.Reviewer, in.Comment); err != nil {
+		return nil, err
+	}
+
+	rerunDlgd(l.svcCtx, in.Area)
+
 	return &cron.ResultRsp{
 		Message: vars.SuccessMessage,
 	}, nil
 }
+
+// checkDlgdHoursDocNo 校验区域下存在该政策文号的时段划分，避免文号有误时静默更新0条记录
+func checkDlgdHoursDocNo(ctx context.Context, svcCtx *svc.ServiceContext, area, docNo string) error {
+	size, err := svcCtx.DlgdHourModel.CountByAreaDocNo(ctx, area, docNo)
+	if err != nil {
+		return err
+	}
+
+	if size == 0 {
+		return fmt.Errorf("%s无政策文号(%s)的用电时段", area, docNo)
+	}
+
+	return nil
+}
+
+// rerunDlgd 后台补抓区域本月待确认的代理购电
+func rerunDlgd(svcCtx *svc.ServiceContext, area string) {
+	threading.GoSafe(func() {
+		if err := svcCtx.RerunDlgd(context.Background(), area); err != nil {
+			logx.Errorf("补抓%s代理购电失败: %v", area, err)
+		}
+	})
+}
@@ -0,0 +1,219 @@
+package logic
+
+import (
+	"context"
+	"crypto/md5"
+	"fmt"
+	"strings"
+	"time"
+
+	"seeccloud.com/edscron/cron"
+	"seeccloud.com/edscron/internal/svc"
+	"seeccloud.com/edscron/model"
+	"seeccloud.com/edscron/pkg/cronx"
+	"seeccloud.com/edscron/pkg/vars"
+	"seeccloud.com/edscron/pkg/x/expx"
+	"seeccloud.com/edscron/pkg/x/slicex"
+	"seeccloud.com/edscron/pkg/x/timex"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	touCalendarMaxDays = 366 // 单次导出最大天数
+)
+
+var (
+	// 需导出为日历事件的时段
+	dlgdCalendarPeriods = []string{cronx.PeriodSharp.Name, cronx.PeriodPeak.Name, cronx.PeriodDeep.Name}
+	twdlCalendarPeriods = []string{cronx.PeriodWeekdayPeak.Name}
+)
+
+type ExportTouCalendarLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewExportTouCalendarLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ExportTouCalendarLogic {
+	return &ExportTouCalendarLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 导出分时日历(iCalendar)
+func (l *ExportTouCalendarLogic) ExportTouCalendar(in *cron.TouCalendarReq) (*cron.TouCalendarRsp, error) {
+	if err := expx.HasZeroError(in, "Category", "StartDate"); err != nil {
+		return nil, err
+	}
+
+	start := timex.MustDate(in.StartDate)
+	end := time.Date(start.Year(), start.Month()+1, 0, 0, 0, 0, 0, time.Local)
+	if len(in.EndDate) > 0 {
+		end = timex.MustDate(in.EndDate)
+	}
+
+	if end.Before(start) {
+		return nil, fmt.Errorf("截止日期%s早于开始日期%s", end.Format(vars.DateFormat), start.Format(vars.DateFormat))
+	}
+
+	if int(end.Sub(start).Hours()/24) >= touCalendarMaxDays {
+		return nil, fmt.Errorf("日期范围不能超过%d天", touCalendarMaxDays)
+	}
+
+	// UID由用电类别、日期和时段序号组成，电价更新后重新导出可覆盖同一事件
+	uidPrefix := fmt.Sprintf("%x", md5.Sum([]byte(in.Category)))[:8]
+
+	var events []cronx.IcsEvent
+	var err error
+	area, tzid := cronx.ChinaArea, "Asia/Shanghai"
+	if slicex.Contains(cronx.TwdlCategories, in.Category) {
+		area, tzid = cronx.TaiwanArea, "Asia/Taipei"
+		events, err = l.twdlEvents(in.Category, uidPrefix, start, end)
+	} else {
+		events, err = l.dlgdEvents(in.Category, uidPrefix, start, end)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	holidays, err := l.holidayEvents(area, uidPrefix, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	events = append(events, holidays...)
+
+	name := strings.NewReplacer(cronx.CategorySep, "_", ",", "_", " ", "").Replace(in.Category)
+	return &cron.TouCalendarRsp{
+		FileName:  fmt.Sprintf("%s_%s-%s.ics", name, start.Format("20060102"), end.Format("20060102")),
+		Content:   cronx.BuildIcs(in.Category, tzid, events),
+		EventSize: int64(len(events)),
+	}, nil
+}
+
+func (l *ExportTouCalendarLogic) dlgdEvents(category, uidPrefix string, start, end time.Time) ([]cronx.IcsEvent, error) {
+	infos := strings.Split(category, cronx.CategorySep)
+	if len(infos) < 3 {
+		return nil, fmt.Errorf("req.Category格式错误, 正确格式: %s", model.CategoryFormatTip)
+	}
+
+	var events []cronx.IcsEvent
+	var one *model.Dlgd
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		// 每月首日或首次进入时切换电价表，无当月电价时使用临近电价
+		if one == nil || day.Day() == 1 {
+			monthStart := time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.Local).Format(vars.DatetimeFormat)
+			var err error
+			one, err = l.svcCtx.DlgdModel.FindFirstByAreaStartTimeCategoryVoltage(l.ctx, infos[0], monthStart, infos[1], infos[2])
+			if err == model.ErrNotFound {
+				one, err = l.svcCtx.DlgdModel.FindOneByAreaCategoryVoltageAtNearlyStartTime(l.ctx, infos[0], monthStart, infos[1], infos[2])
+			}
+
+			if err != nil {
+				return nil, fmt.Errorf("未找到%s>%d年%d月>%s>%s电价表: %v", infos[0], day.Year(), day.Month(), infos[1], infos[2], err)
+			}
+		}
+
+		dayEvents, err := touDayEvents(category, uidPrefix, day, dlgdCalendarPeriods, func(t time.Time) (*cronx.Period, error) {
+			return l.svcCtx.GetDlgdPrice(t, one)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, dayEvents...)
+	}
+
+	return events, nil
+}
+
+func (l *ExportTouCalendarLogic) twdlEvents(category, uidPrefix string, start, end time.Time) ([]cronx.IcsEvent, error) {
+	var events []cronx.IcsEvent
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		one, err := l.svcCtx.TwdlModel.FindOneByDayStartTimeCategory(l.ctx, day.Format(vars.DatetimeFormat), category)
+		if err != nil {
+			return nil, fmt.Errorf("未找到%s>%s电价表: %v", category, day.Format(vars.DateFormat), err)
+		}
+
+		holiday, _ := l.svcCtx.HolidayModel.FindOneByAreaDateCache(l.ctx, string(cronx.TaiwanArea), day.Format(vars.DateFormat))
+		isOffPeakDay := holiday != nil && holiday.Category == string(cronx.HolidayPeakOff)
+
+		dayEvents, err := touDayEvents(category, uidPrefix, day, twdlCalendarPeriods, func(t time.Time) (*cronx.Period, error) {
+			period := one.GetPrice(t.Format(vars.DatetimeFormat), isOffPeakDay)
+			return &period, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, dayEvents...)
+	}
+
+	return events, nil
+}
+
+func (l *ExportTouCalendarLogic) holidayEvents(area cronx.AreaCategory, uidPrefix string, start, end time.Time) ([]cronx.IcsEvent, error) {
+	var events []cronx.IcsEvent
+	for year := start.Year(); year <= end.Year(); year++ {
+		all, err := l.svcCtx.HolidayModel.FindAllByAreaYear(l.ctx, string(area), year)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, hol := range *all {
+			day := timex.MustDate(hol.Date)
+			if day.Before(start) || day.After(end) {
+				continue
+			}
+
+			events = append(events, cronx.IcsEvent{
+				Uid:     fmt.Sprintf("%s-%s-holiday@edscron", uidPrefix, day.Format("20060102")),
+				Summary: strings.TrimSpace(hol.Category + " " + hol.Detail),
+				Start:   day,
+				End:     day.AddDate(0, 0, 1),
+				AllDay:  true,
+			})
+		}
+	}
+
+	return events, nil
+}
+
+// touDayEvents 按30分钟粒度计算一天内各时段，合并连续的目标时段为日历事件
+func touDayEvents(category, uidPrefix string, day time.Time, names []string, priceOf func(t time.Time) (*cronx.Period, error)) ([]cronx.IcsEvent, error) {
+	var events []cronx.IcsEvent
+	var last *cronx.IcsEvent
+	lastName := ""
+	for i := 0; i < 48; i++ {
+		t := day.Add(time.Minute * 30 * time.Duration(i))
+		period, err := priceOf(t)
+		if err != nil {
+			return nil, err
+		}
+
+		if !slicex.Contains(names, period.Name) {
+			last, lastName = nil, ""
+			continue
+		}
+
+		if last != nil && lastName == period.Name {
+			last.End = t.Add(time.Minute * 30)
+			continue
+		}
+
+		events = append(events, cronx.IcsEvent{
+			Uid:         fmt.Sprintf("%s-%s-%s-%d@edscron", uidPrefix, day.Format("20060102"), period.Name, len(events)),
+			Summary:     period.Desc,
+			Description: fmt.Sprintf("%s\n电价: %.4f", category, period.Price),
+			Start:       t,
+			End:         t.Add(time.Minute * 30),
+		})
+		last, lastName = &events[len(events)-1], period.Name
+	}
+
+	return events, nil
+}
@@ -90,6 +90,14 @@ func TestGetCarbonLogic(t *testing.T) {
 				Value: 0.509,
 			},
 		},
+		{
+			name: "不支持的因子类型",
+			req: &cron.CarbonReq{
+				Address:  "福建省厦门市",
+				Category: "co2",
+			},
+			wantErr: true,
+		},
 	}
 
 	setup := testsetup.SetupTest(t)
@@ -0,0 +1,100 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"seeccloud.com/edscron/cron"
+	"seeccloud.com/edscron/internal/svc"
+	"seeccloud.com/edscron/pkg/cronx"
+	"seeccloud.com/edscron/pkg/vars"
+	"seeccloud.com/edscron/pkg/x/expx"
+	"seeccloud.com/edscron/pkg/x/timex"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 度日统计最大天数
+const degreeDaysMax = 731
+
+type GetDegreeDaysLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetDegreeDaysLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetDegreeDaysLogic {
+	return &GetDegreeDaysLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取采暖/制冷度日
+func (l *GetDegreeDaysLogic) GetDegreeDays(in *cron.DegreeDaysReq) (*cron.DegreeDaysRsp, error) {
+	if err := expx.HasZeroError(in, "Address", "StartDate"); err != nil {
+		return nil, err
+	}
+
+	_, city := cronx.ExtractAddress(in.Address, true)
+	if len(city) == 0 {
+		return nil, fmt.Errorf("依提供地址无法筛查市级信息, Address: %s", in.Address)
+	}
+
+	start := timex.MustDate(in.StartDate)
+	end := timex.MustDate(expx.If(len(in.EndDate) == 0, time.Now().Format(vars.DateFormat), in.EndDate))
+	if days := int(end.Sub(start).Hours()/24) + 1; days > degreeDaysMax {
+		return nil, fmt.Errorf("统计范围不能超过%d天", degreeDaysMax)
+	}
+
+	heatBase := expx.If(in.HeatBase == 0, cronx.DefaultHeatBase, in.HeatBase)
+	coolBase := expx.If(in.CoolBase == 0, cronx.DefaultCoolBase, in.CoolBase)
+	temps, err := l.svcCtx.GetDailyTemps(l.ctx, city, start, end, cronx.TempSource(in.Source))
+	if err != nil {
+		return nil, err
+	}
+
+	dds, err := cronx.DegreeDays(temps, start, end, cronx.DegreeInterval(in.Interval), heatBase, coolBase)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := cron.DegreeDaysRsp{
+		City:     city,
+		HeatBase: heatBase,
+		CoolBase: coolBase,
+	}
+	var days, expectedDays int
+	for _, d := range dds {
+		rsp.Items = append(rsp.Items, toDegreeDay(d))
+		rsp.Hdd += d.Hdd
+		rsp.Cdd += d.Cdd
+		rsp.Missing = append(rsp.Missing, d.Missing...)
+		days += d.Days
+		expectedDays += d.ExpectedDays
+	}
+
+	rsp.Hdd = math.Round(rsp.Hdd*100) / 100
+	rsp.Cdd = math.Round(rsp.Cdd*100) / 100
+	rsp.Coverage = math.Round(float64(days)/float64(expectedDays)*10000) / 10000
+	return &rsp, nil
+}
+
+func toDegreeDay(d cronx.DegreeDay) *cron.DegreeDay {
+	return &cron.DegreeDay{
+		Period:       d.Period,
+		StartDate:    d.Start.Format(vars.DateFormat),
+		EndDate:      d.End.Format(vars.DateFormat),
+		Hdd:          d.Hdd,
+		Cdd:          d.Cdd,
+		MeanTemp:     d.MeanTemp,
+		Days:         int64(d.Days),
+		ExpectedDays: int64(d.ExpectedDays),
+		ObservedDays: int64(d.ObservedDays),
+		Coverage:     math.Round(d.Coverage()*10000) / 10000,
+		Missing:      d.Missing,
+	}
+}
@@ -2,10 +2,13 @@ package logic
 
 import (
 	"context"
+	"strings"
 
 	"seeccloud.com/edscron/cron"
 	"seeccloud.com/edscron/internal/svc"
 	"seeccloud.com/edscron/pkg/copierx"
+	"seeccloud.com/edscron/pkg/cronx"
+	"seeccloud.com/edscron/pkg/vars"
 	"seeccloud.com/edscron/pkg/x/expx"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -40,7 +43,35 @@ func (l *GetDlgdHoursLogic) GetDlgdHours(in *cron.DlgdHourReq) (*cron.DlgdHoursR
 	var hours []*cron.DlgdHour
 	copierx.MustCopy(&hours, all)
 
-	return &cron.DlgdHoursRsp{
-		Hours: hours,
-	}, nil
+	var cronHours []cronx.DlgdHour
+	copierx.MustCopy(&cronHours, all)
+
+	diffs, baseDocNo, err := l.svcCtx.DiffDlgdHours(l.ctx, in.Area, in.DocNo)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews, err := l.svcCtx.ReviewModel.FindAllByAreaDocNo(l.ctx, in.Area, in.DocNo)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := cron.DlgdHoursRsp{
+		Hours:     hours,
+		BaseDocNo: baseDocNo,
+		Diff:      diffs,
+		Issues:    cronx.ValidateCoverage(cronHours),
+	}
+	for _, r := range *reviews {
+		rsp.Reviews = append(rsp.Reviews, &cron.DlgdHourReview{
+			Action:     r.Action,
+			Reviewer:   r.Reviewer,
+			Comment:    r.Comment,
+			BaseDocNo:  r.BaseDocNo,
+			Diff:       expx.If(len(r.Diff) > 0, strings.Split(r.Diff, "\n"), nil),
+			CreateTime: r.CreateTime.Format(vars.DatetimeFormat),
+		})
+	}
+
+	return &rsp, nil
 }
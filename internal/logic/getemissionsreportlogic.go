@@ -0,0 +1,148 @@
+package logic
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"seeccloud.com/edscron/cron"
+	"seeccloud.com/edscron/internal/svc"
+	"seeccloud.com/edscron/model"
+	"seeccloud.com/edscron/pkg/cronx"
+	"seeccloud.com/edscron/pkg/vars"
+	"seeccloud.com/edscron/pkg/x/expx"
+	"seeccloud.com/edscron/pkg/x/slicex"
+	"seeccloud.com/edscron/pkg/x/timex"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetEmissionsReportLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetEmissionsReportLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetEmissionsReportLogic {
+	return &GetEmissionsReportLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取碳排报告(范围二)
+func (l *GetEmissionsReportLogic) GetEmissionsReport(in *cron.EmissionsReq) (*cron.EmissionsRsp, error) {
+	if err := expx.HasZeroError(in, "Account", "Bills"); err != nil {
+		return nil, err
+	}
+
+	option, err := l.svcCtx.OptionModel.FindOneByAccountNearlyArea(l.ctx, in.Account, in.Area)
+	if err != nil {
+		return nil, err
+	}
+
+	// 地址缺省时，取用电类别所属省份
+	province := cronx.TaiwanAreaName
+	if !slicex.Contains(cronx.TwdlCategories, option.Category) {
+		province = strings.Split(option.Category, cronx.CategorySep)[0]
+	}
+
+	var rsp cron.EmissionsRsp
+	billLogic := NewGetMonthlyBillLogic(l.ctx, l.svcCtx)
+	for _, bill := range in.Bills {
+		bill.Account = expx.If(len(bill.Account) == 0, in.Account, bill.Account)
+		bill.Area = expx.If(len(bill.Area) == 0, in.Area, bill.Area)
+		month := timex.MustMonth(bill.Month)
+
+		billRsp, err := billLogic.GetMonthlyBill(bill)
+		if err != nil {
+			return nil, fmt.Errorf("计算%d年%d月账单失败: %v", month.Year(), month.Month(), err)
+		}
+
+		// 因子年份同账单年份，未发布时回退至最新年份
+		var c *model.Carbon
+		var level cronx.CarbonLevel
+		if len(in.Address) > 0 {
+			c, level, err = l.svcCtx.GetCarbonFactor(l.ctx, in.Address, cronx.CarbonCategory(in.Category), int64(month.Year()))
+		} else {
+			c, level, err = l.svcCtx.GetCarbonFactorByArea(l.ctx, province, cronx.CarbonCategory(in.Category), int64(month.Year()))
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		periods := slicex.MapFunc(billRsp.Details, func(d *cron.BillDetail) *cron.EmissionsPeriod {
+			return &cron.EmissionsPeriod{
+				Name:      d.Name,
+				Desc:      d.Desc,
+				Usage:     d.Usage,
+				Emissions: toEmissions(d.Usage, c.Value),
+			}
+		})
+		sort.Slice(periods, func(i, j int) bool {
+			return periods[i].Name < periods[j].Name
+		})
+
+		rsp.Months = append(rsp.Months, &cron.EmissionsMonth{
+			Month:          month.Format(vars.MonthFormat),
+			Usage:          billRsp.Usage,
+			Emissions:      toEmissions(billRsp.Usage, c.Value),
+			Factor:         c.Value,
+			FactorYear:     c.Year,
+			FactorArea:     c.Area,
+			Fallback:       level == cronx.CarbonLevelNational,
+			EstimatedRatio: billRsp.EstimatedRatio,
+			Periods:        periods,
+			FactorLevel:    string(level),
+			FactorSource:   c.Source,
+			FactorCategory: c.Category,
+		})
+		rsp.Usage += billRsp.Usage
+		rsp.Emissions += toEmissions(billRsp.Usage, c.Value)
+	}
+
+	rsp.Emissions = math.Round(rsp.Emissions*10000) / 10000
+	if in.Csv {
+		rsp.Csv, err = emissionsCsv(&rsp)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &rsp, nil
+}
+
+// toEmissions 电量(kWh)×因子(kgCO2/kWh)→排放量(tCO2)
+func toEmissions(usage, factor float64) float64 {
+	return math.Round(usage*factor/1000*10000) / 10000
+}
+
+// emissionsCsv 按GHG Protocol范围二(基于位置法)披露格式输出CSV
+func emissionsCsv(rsp *cron.EmissionsRsp) (string, error) {
+	var buf bytes.Buffer
+	// UTF-8 BOM，避免Excel打开中文乱码
+	buf.WriteString("\xEF\xBB\xBF")
+	w := csv.NewWriter(&buf)
+	w.Write([]string{"月份", "时段", "用电量(kWh)", "碳排因子(kgCO2/kWh)", "因子类型", "因子年份", "因子区域", "因子层级", "回退全国因子", "因子来源", "估算占比", "排放量(tCO2)"})
+
+	f := func(v float64) string {
+		return fmt.Sprintf("%g", v)
+	}
+	for _, m := range rsp.Months {
+		factorInfo := []string{f(m.Factor), m.FactorCategory, fmt.Sprintf("%d", m.FactorYear), m.FactorArea, m.FactorLevel, fmt.Sprintf("%t", m.Fallback), m.FactorSource, f(m.EstimatedRatio)}
+		for _, p := range m.Periods {
+			w.Write(append(append([]string{m.Month, p.Desc, f(p.Usage)}, factorInfo...), f(p.Emissions)))
+		}
+		w.Write(append(append([]string{m.Month, "小计", f(m.Usage)}, factorInfo...), f(m.Emissions)))
+	}
+	w.Write([]string{"合计", "", f(rsp.Usage), "", "", "", "", "", "", "", "", f(rsp.Emissions)})
+
+	w.Flush()
+	return buf.String(), w.Error()
+}
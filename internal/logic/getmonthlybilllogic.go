@@ -36,10 +36,14 @@ func NewGetMonthlyBillLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Ge
 
 // 获取账单
 func (l *GetMonthlyBillLogic) GetMonthlyBill(in *cron.BillReq) (*cron.BillRsp, error) {
-	if err := expx.HasZeroError(in, "Account", "Time", "Ep30Ms"); err != nil {
+	if err := expx.HasZeroError(in, "Account", "Time"); err != nil {
 		return nil, err
 	}
 
+	if len(in.Ep30Ms) == 0 && len(in.Readings) == 0 {
+		return nil, fmt.Errorf("BillReq.Ep30Ms和BillReq.Readings不能同时为空")
+	}
+
 	monthStart := timex.MustMonth(in.Month)
 
 	option, err := l.svcCtx.OptionModel.FindOneByAccountNearlyArea(l.ctx, in.Account, in.Area)
@@ -54,15 +58,44 @@ func (l *GetMonthlyBillLogic) GetMonthlyBill(in *cron.BillReq) (*cron.BillRsp, e
 
 	ep30Ms := in.Ep30Ms
 	ep30MsMax := monthStart.AddDate(0, 1, -1).Day() * 48
+
+	// 带时间戳的读数转换为30min网格
+	var grid *cronx.MeterGrid
+	if len(ep30Ms) == 0 {
+		readings := slicex.MapFunc(in.Readings, func(r *cron.MeterReading) cronx.MeterReading {
+			return cronx.MeterReading{Time: timex.MustTime(r.Time), Value: r.Value, Replaced: r.Replaced}
+		})
+		grid, err = cronx.NewMeterGrid(monthStart, ep30MsMax, cronx.ReadingKind(in.ReadingKind), cronx.GapPolicy(in.GapPolicy), in.MeterMax, readings)
+		if err != nil {
+			return nil, err
+		}
+
+		ep30Ms = grid.Values
+	}
+
 	if len(ep30Ms) > ep30MsMax {
 		ep30Ms = ep30Ms[:ep30MsMax]
 	}
 
+	var rsp *cron.BillRsp
 	if slicex.Contains(cronx.TwdlCategories, option.Category) {
-		return GetTwdlBill(l, *option, monthStart, ep30Ms)
+		rsp, err = GetTwdlBill(l, *option, monthStart, ep30Ms)
+	} else {
+		rsp, err = GetDlgdBill(l, *option, monthStart, ep30Ms, in.EqTotal)
+	}
+
+	if err != nil || grid == nil {
+		return rsp, err
 	}
 
-	return GetDlgdBill(l, *option, monthStart, ep30Ms, in.EqTotal)
+	rsp.EstimatedSize = int64(grid.EstimatedSize)
+	rsp.EstimatedRatio = math.Round(float64(grid.EstimatedSize)/float64(len(ep30Ms))*10000) / 10000
+	rsp.EstimatedUsage = math.Round(grid.EstimatedUsage*100) / 100
+	rsp.RolloverSize = int64(grid.RolloverSize)
+	rsp.ReplaceSize = int64(grid.ReplaceSize)
+	rsp.BackwardSize = int64(grid.BackwardSize)
+	rsp.UncoveredSize = int64(grid.UncoveredSize)
+	return rsp, nil
 }
 
 func GetTwdlBill(l *GetMonthlyBillLogic, option model.UserOption, monthStart time.Time, ep30Ms []float64) (*cron.BillRsp, error) {
@@ -0,0 +1,55 @@
+package logic
+
+import (
+	"context"
+
+	"seeccloud.com/edscron/cron"
+	"seeccloud.com/edscron/internal/svc"
+	"seeccloud.com/edscron/pkg/copierx"
+	"seeccloud.com/edscron/pkg/cronx"
+	"seeccloud.com/edscron/pkg/x/expx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetOcrUsageLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetOcrUsageLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetOcrUsageLogic {
+	return &GetOcrUsageLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取OCR用量
+func (l *GetOcrUsageLogic) GetOcrUsage(in *cron.OcrUsageReq) (*cron.OcrUsageRsp, error) {
+	err := expx.HasZeroError(in, "StartMonth")
+	if err != nil {
+		return nil, err
+	}
+
+	endMonth := expx.If(in.EndMonth == "", in.StartMonth, in.EndMonth)
+	all, err := l.svcCtx.UsageModel.FindAllByMonthRange(l.ctx, in.Provider, in.StartMonth, endMonth)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := cron.OcrUsageRsp{}
+	for _, u := range *all {
+		usage := cron.OcrUsage{}
+		copierx.MustCopy(&usage, u)
+		usage.Billable = cronx.IsBillableConverter(u.Provider)
+		if usage.Billable {
+			rsp.BillablePages += u.Pages
+			rsp.SavedPages += u.CachedPages
+		}
+		rsp.Usages = append(rsp.Usages, &usage)
+	}
+
+	return &rsp, nil
+}
@@ -0,0 +1,61 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"seeccloud.com/edscron/cron"
+	"seeccloud.com/edscron/internal/svc"
+	"seeccloud.com/edscron/pkg/cronx"
+	"seeccloud.com/edscron/pkg/vars"
+	"seeccloud.com/edscron/pkg/x/expx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetUpcomingPriceEventsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetUpcomingPriceEventsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetUpcomingPriceEventsLogic {
+	return &GetUpcomingPriceEventsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取近期电价预警(气温触发尖峰/高峰)
+func (l *GetUpcomingPriceEventsLogic) GetUpcomingPriceEvents(in *cron.PriceEventsReq) (*cron.PriceEventsRsp, error) {
+	if err := expx.HasZeroError(in, "Account"); err != nil {
+		return nil, err
+	}
+
+	if in.Date == "" {
+		in.Date = time.Now().Format(vars.DateFormat)
+	}
+
+	events, err := l.svcCtx.EventModel.FindAllByAccountNearlyAreaFromDate(l.ctx, in.Account, in.Area, in.Date)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := cron.PriceEventsRsp{}
+	for _, e := range *events {
+		rsp.Events = append(rsp.Events, &cron.PriceEvent{
+			Area:        e.Area,
+			Category:    e.Category,
+			Date:        e.Date,
+			Name:        e.Period,
+			Desc:        expx.If(e.Period == cronx.PeriodSharp.Name, cronx.PeriodSharp.Desc, cronx.PeriodPeak.Desc),
+			Hour:        e.Hour,
+			Price:       e.Price,
+			Rule:        e.Rule,
+			Provisional: e.Provisional == 1,
+		})
+	}
+
+	return &rsp, nil
+}
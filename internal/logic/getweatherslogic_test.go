@@ -35,9 +35,9 @@ func TestGetHiTempSize(t *testing.T) {
 	}
 
 	for _, tt := range tests {
-		got, err := setup.SvcCtx.WeatherModel.FindHiTempSize(setup.Ctx, tt.date, tt.city, tt.hiTemp)
+		got, err := setup.SvcCtx.GetHiTempSize(setup.Ctx, tt.date, tt.city, tt.hiTemp)
 		if err != nil {
-			t.Errorf("FindHiTempSize(%v, %v, %v) = %v, want %v", tt.city, tt.date, tt.hiTemp, got, tt.want)
+			t.Errorf("GetHiTempSize(%v, %v, %v) = %v, want %v", tt.city, tt.date, tt.hiTemp, got, tt.want)
 		}
 
 		if got != tt.want {
@@ -0,0 +1,52 @@
+package logic
+
+import (
+	"context"
+
+	"seeccloud.com/edscron/cron"
+	"seeccloud.com/edscron/internal/svc"
+	"seeccloud.com/edscron/pkg/copierx"
+	"seeccloud.com/edscron/pkg/cronx"
+	"seeccloud.com/edscron/pkg/vars"
+	"seeccloud.com/edscron/pkg/x/expx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ParseDlgdHoursLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewParseDlgdHoursLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ParseDlgdHoursLogic {
+	return &ParseDlgdHoursLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 预览用电时段解析结果(不保存)
+func (l *ParseDlgdHoursLogic) ParseDlgdHours(in *cron.AddDlgdHourReq) (*cron.DlgdHoursParseRsp, error) {
+	err := expx.HasZeroError(in, "Area", "Comment")
+	if err != nil {
+		return nil, err
+	}
+
+	hours, diag := cronx.ParseDlgdHours(in.Area, in.Comment)
+	return newDlgdHoursParseRsp(hours, diag), nil
+}
+
+// newDlgdHoursParseRsp 组装时段解析结果，附带覆盖校验问题
+func newDlgdHoursParseRsp(hours []cronx.DlgdHour, diag cronx.DlgdHourDiagnostics) *cron.DlgdHoursParseRsp {
+	rsp := cron.DlgdHoursParseRsp{
+		Message:     vars.SuccessMessage,
+		Diagnostics: &cron.DlgdHourDiagnostics{},
+		Issues:      cronx.ValidateCoverage(hours),
+	}
+	copierx.MustCopy(&rsp.Hours, hours)
+	copierx.MustCopy(rsp.Diagnostics, diag)
+
+	return &rsp
+}
@@ -41,6 +41,7 @@ func (l *QuickStartLogic) QuickStart(in *cron.QuickStartReq) (*cron.ResultRsp, e
 	}
 
 	crons = append(crons,
+		model.NewCron(model.CategoryStation, address),
 		model.NewCron(model.CategoryWeather, address),
 		model.NewCron(model.CategoryHoliday, address),
 	)
@@ -51,11 +52,14 @@ func (l *QuickStartLogic) QuickStart(in *cron.QuickStartReq) (*cron.ResultRsp, e
 			return nil, err
 		}
 
+		// 高温触发尖峰：预报用于未来日期，实测用于已过日期
+		capitalAddress := model.Address{
+			Province: prv,
+			City:     capital,
+		}
 		crons = append(crons,
-			model.NewCron(model.CategoryWeather, model.Address{
-				Province: prv,
-				City:     capital,
-			}),
+			model.NewCron(model.CategoryWeather, capitalAddress),
+			model.NewCron(model.CategoryObserved, capitalAddress),
 		)
 	}
 
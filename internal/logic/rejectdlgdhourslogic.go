@@ -0,0 +1,52 @@
+package logic
+
+import (
+	"context"
+
+	"seeccloud.com/edscron/cron"
+	"seeccloud.com/edscron/internal/svc"
+	"seeccloud.com/edscron/model"
+	"seeccloud.com/edscron/pkg/vars"
+	"seeccloud.com/edscron/pkg/x/expx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RejectDlgdHoursLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRejectDlgdHoursLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RejectDlgdHoursLogic {
+	return &RejectDlgdHoursLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 驳回用电时段
+func (l *RejectDlgdHoursLogic) RejectDlgdHours(in *cron.DlgdHourReq) (*cron.ResultRsp, error) {
+	err := expx.HasZeroError(in, "Area", "Reviewer", "Comment")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkDlgdHoursDocNo(l.ctx, l.svcCtx, in.Area, in.DocNo); err != nil {
+		return nil, err
+	}
+
+	err = l.svcCtx.DlgdHourModel.RejectAll(l.ctx, in.Area, in.DocNo)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := l.svcCtx.AddDlgdHourReview(l.ctx, in.Area, in.DocNo, model.DlgdHourReviewReject, in.Reviewer, in.Comment); err != nil {
+		return nil, err
+	}
+
+	return &cron.ResultRsp{
+		Message: vars.SuccessMessage,
+	}, nil
+}
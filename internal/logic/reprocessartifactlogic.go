@@ -0,0 +1,49 @@
+package logic
+
+import (
+	"context"
+
+	"seeccloud.com/edscron/cron"
+	"seeccloud.com/edscron/internal/svc"
+	"seeccloud.com/edscron/pkg/x/expx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+type ReprocessArtifactLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReprocessArtifactLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReprocessArtifactLogic {
+	return &ReprocessArtifactLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 重新解析归档的电价原始文件
+func (l *ReprocessArtifactLogic) ReprocessArtifact(in *cron.ArtifactReq) (*cron.ResultRsp, error) {
+	if err := expx.HasZeroError(in, "Id"); err != nil {
+		return nil, err
+	}
+
+	if _, err := l.svcCtx.ArtifactModel.FindOne(l.ctx, in.Id); err != nil {
+		return nil, err
+	}
+
+	// OCR识别耗时较长，只启动，不等待结果；结果记录于归档记录message字段并邮件通知
+	svcCtx := l.svcCtx
+	threading.GoSafe(func() {
+		if err := svcCtx.ReprocessArtifact(context.Background(), in.Id); err != nil {
+			logx.Errorf("重新解析归档%d失败: %v", in.Id, err)
+		}
+	})
+
+	return &cron.ResultRsp{
+		Message: "已开始重新解析，结果见归档记录及邮件通知",
+	}, nil
+}
@@ -0,0 +1,66 @@
+package logic
+
+import (
+	"context"
+
+	"seeccloud.com/edscron/cron"
+	"seeccloud.com/edscron/internal/svc"
+	"seeccloud.com/edscron/model"
+	"seeccloud.com/edscron/pkg/x/expx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ResolveWeatherStationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewResolveWeatherStationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ResolveWeatherStationLogic {
+	return &ResolveWeatherStationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 查询/指定气象站代码
+func (l *ResolveWeatherStationLogic) ResolveWeatherStation(in *cron.StationReq) (*cron.StationRsp, error) {
+	if err := expx.HasZeroError(in, "Province", "City"); err != nil {
+		return nil, err
+	}
+
+	// 指定代码时，以请求名称写入人工映射，刷新任务不覆盖
+	if len(in.Code) > 0 {
+		station, err := l.svcCtx.StationModel.FindOneByProvinceCity(l.ctx, in.Province, in.City)
+		if err == nil {
+			station.Code = in.Code
+			station.Manual = 1
+			err = l.svcCtx.StationModel.Update(l.ctx, station)
+		} else if err == model.ErrNotFound {
+			_, err = l.svcCtx.StationModel.Insert(l.ctx, &model.WeatherStation{
+				Province: in.Province,
+				City:     in.City,
+				Code:     in.Code,
+				Manual:   1,
+			})
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	station, err := l.svcCtx.ResolveWeatherStation(l.ctx, in.Province, in.City)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cron.StationRsp{
+		Province: station.Province,
+		City:     station.City,
+		Code:     station.Code,
+		Manual:   station.Manual == 1,
+	}, nil
+}
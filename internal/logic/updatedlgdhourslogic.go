@@ -0,0 +1,80 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"seeccloud.com/edscron/cron"
+	"seeccloud.com/edscron/internal/svc"
+	"seeccloud.com/edscron/model"
+	"seeccloud.com/edscron/pkg/copierx"
+	"seeccloud.com/edscron/pkg/cronx"
+	"seeccloud.com/edscron/pkg/vars"
+	"seeccloud.com/edscron/pkg/x/expx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateDlgdHoursLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateDlgdHoursLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateDlgdHoursLogic {
+	return &UpdateDlgdHoursLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 修改用电时段
+func (l *UpdateDlgdHoursLogic) UpdateDlgdHours(in *cron.UpdateDlgdHoursReq) (*cron.ResultRsp, error) {
+	err := expx.HasZeroError(in, "Area", "Reviewer")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(in.Hours) == 0 {
+		return nil, fmt.Errorf("UpdateDlgdHoursReq.Hours不能为空")
+	}
+
+	if err := checkDlgdHoursDocNo(l.ctx, l.svcCtx, in.Area, in.DocNo); err != nil {
+		return nil, err
+	}
+
+	hours := make([]model.DlgdHour, 0, len(in.Hours))
+	for _, h := range in.Hours {
+		var hour cronx.DlgdHour
+		copierx.MustCopy(&hour, h)
+		if err := hour.Validate(); err != nil {
+			return nil, err
+		}
+
+		row := model.DlgdHour{}
+		copierx.MustCopy(&row, &hour)
+		row.Area = in.Area
+		row.DocNo = in.DocNo
+		row.Confirm = expx.If(in.Approve, model.DlgdHourConfirmCode, 0)
+		hours = append(hours, row)
+	}
+
+	// 整体替换该文号的时段划分
+	if err := l.svcCtx.DlgdHourModel.MustInertAll(l.ctx, &hours); err != nil {
+		return nil, err
+	}
+
+	action := expx.If(in.Approve, model.DlgdHourReviewApprove, model.DlgdHourReviewUpdate)
+	if _, err := l.svcCtx.AddDlgdHourReview(l.ctx, in.Area, in.DocNo, action, in.Reviewer, in.Comment); err != nil {
+		return nil, err
+	}
+
+	if in.Approve {
+		rerunDlgd(l.svcCtx, in.Area)
+	}
+
+	return &cron.ResultRsp{
+		Message: vars.SuccessMessage,
+	}, nil
+}
@@ -0,0 +1,219 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"seeccloud.com/edscron/cron"
+	"seeccloud.com/edscron/internal/svc"
+	"seeccloud.com/edscron/pkg/cronx"
+	"seeccloud.com/edscron/pkg/vars"
+	"seeccloud.com/edscron/pkg/x/expx"
+	"seeccloud.com/edscron/pkg/x/timex"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type VerifySavingsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewVerifySavingsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *VerifySavingsLogic {
+	return &VerifySavingsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 节能量核证(IPMVP选项C)
+func (l *VerifySavingsLogic) VerifySavings(in *cron.SavingsReq) (*cron.SavingsRsp, error) {
+	if err := expx.HasZeroError(in, "Account", "Address", "Baseline", "Reporting"); err != nil {
+		return nil, err
+	}
+
+	_, city := cronx.ExtractAddress(in.Address, true)
+	if len(city) == 0 {
+		return nil, fmt.Errorf("依提供地址无法筛查市级信息, Address: %s", in.Address)
+	}
+
+	holidays, err := l.loadHolidays(cronx.EitherChinaOrTaiwan(in.Address), slices.Concat(in.Baseline, in.Reporting))
+	if err != nil {
+		return nil, err
+	}
+
+	heatBase := expx.If(in.HeatBase == 0, cronx.DefaultHeatBase, in.HeatBase)
+	coolBase := expx.If(in.CoolBase == 0, cronx.DefaultCoolBase, in.CoolBase)
+	toPeriod := func(v *cron.UsagePeriod) (cronx.UsagePeriod, float64, error) {
+		return l.usagePeriod(v, city, holidays, cronx.TempSource(in.Source), heatBase, coolBase)
+	}
+
+	baseline := make([]cronx.UsagePeriod, 0, len(in.Baseline))
+	for _, v := range in.Baseline {
+		p, _, err := toPeriod(v)
+		if err != nil {
+			return nil, err
+		}
+		baseline = append(baseline, p)
+	}
+
+	m, err := cronx.FitBaseline(baseline)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := cron.SavingsRsp{
+		R2:           math.Round(m.R2*10000) / 10000,
+		CvRmse:       math.Round(m.CvRmse*10000) / 10000,
+		BaselineSize: int64(m.Size),
+		Warnings:     m.Warnings(),
+	}
+	for i, name := range m.Vars {
+		rsp.Coefs = append(rsp.Coefs, &cron.BaselineCoef{Name: name, Value: math.Round(m.Coefs[i]*10000) / 10000})
+	}
+
+	prices := map[string]float64{}
+	for _, v := range in.Reporting {
+		p, coverage, err := toPeriod(v)
+		if err != nil {
+			return nil, err
+		}
+
+		price, err := l.periodPrice(in, prices, p.Start, p.End)
+		if err != nil {
+			return nil, err
+		}
+
+		adjusted := math.Round(m.Predict(p)*100) / 100
+		avoided := math.Round((adjusted-p.Usage)*100) / 100
+		rsp.Periods = append(rsp.Periods, &cron.SavingsPeriod{
+			StartDate:        v.StartDate,
+			EndDate:          v.EndDate,
+			Usage:            p.Usage,
+			AdjustedBaseline: adjusted,
+			AvoidedEnergy:    avoided,
+			AvoidedCost:      math.Round(avoided*price*100) / 100,
+			Price:            math.Round(price*10000) / 10000,
+			Days:             int64(p.Days),
+			WorkDays:         int64(p.WorkDays),
+			Hdd:              math.Round(p.Hdd*100) / 100,
+			Cdd:              math.Round(p.Cdd*100) / 100,
+			Coverage:         math.Round(coverage*10000) / 10000,
+		})
+		rsp.Usage += p.Usage
+		rsp.AdjustedBaseline += adjusted
+		rsp.AvoidedEnergy += avoided
+		rsp.AvoidedCost += math.Round(avoided*price*100) / 100
+	}
+
+	rsp.AdjustedBaseline = math.Round(rsp.AdjustedBaseline*100) / 100
+	rsp.AvoidedEnergy = math.Round(rsp.AvoidedEnergy*100) / 100
+	rsp.AvoidedCost = math.Round(rsp.AvoidedCost*100) / 100
+	if rsp.AdjustedBaseline != 0 {
+		rsp.SavingsRatio = math.Round(rsp.AvoidedEnergy/rsp.AdjustedBaseline*10000) / 10000
+	}
+
+	return &rsp, nil
+}
+
+// usagePeriod 汇总周期的天数、工作日数及度日，气温缺数时按覆盖率折算度日
+func (l *VerifySavingsLogic) usagePeriod(v *cron.UsagePeriod, city string, holidays map[string]cronx.HolidayCategory, source cronx.TempSource, heatBase, coolBase float64) (cronx.UsagePeriod, float64, error) {
+	start, end := timex.MustDate(v.StartDate), timex.MustDate(v.EndDate)
+	if end.Before(start) {
+		return cronx.UsagePeriod{}, 0, fmt.Errorf("周期%s~%s结束日期早于开始日期", v.StartDate, v.EndDate)
+	}
+
+	temps, err := l.svcCtx.GetDailyTemps(l.ctx, city, start, end, source)
+	if err != nil {
+		return cronx.UsagePeriod{}, 0, err
+	}
+
+	dds, err := cronx.DegreeDays(temps, start, end, cronx.IntervalMonth, heatBase, coolBase)
+	if err != nil {
+		return cronx.UsagePeriod{}, 0, err
+	}
+
+	p := cronx.UsagePeriod{Start: start, End: end, Usage: v.Usage}
+	var days int
+	for _, d := range dds {
+		p.Hdd += d.Hdd
+		p.Cdd += d.Cdd
+		p.Days += float64(d.ExpectedDays)
+		days += d.Days
+	}
+
+	if days == 0 {
+		return cronx.UsagePeriod{}, 0, fmt.Errorf("周期%s~%s无%s气温数据", v.StartDate, v.EndDate, city)
+	}
+
+	coverage := float64(days) / p.Days
+	p.Hdd /= coverage
+	p.Cdd /= coverage
+
+	p.WorkDays = float64(cronx.WorkDays(start, end, holidays))
+
+	return p, coverage, nil
+}
+
+// loadHolidays 加载各周期涉及年份的假日
+func (l *VerifySavingsLogic) loadHolidays(area cronx.AreaCategory, periods []*cron.UsagePeriod) (map[string]cronx.HolidayCategory, error) {
+	years := map[int]bool{}
+	for _, v := range periods {
+		for year := timex.MustDate(v.StartDate).Year(); year <= timex.MustDate(v.EndDate).Year(); year++ {
+			years[year] = true
+		}
+	}
+
+	holidays := map[string]cronx.HolidayCategory{}
+	for year := range years {
+		all, err := l.svcCtx.HolidayModel.FindAllByAreaYear(l.ctx, string(area), year)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, hol := range *all {
+			holidays[hol.Date] = cronx.HolidayCategory(hol.Category)
+		}
+	}
+
+	return holidays, nil
+}
+
+// periodPrice 周期平均电度电价，按各月天数加权；月度电价取平负荷账单的电度电费/电量，不含基本电费
+func (l *VerifySavingsLogic) periodPrice(in *cron.SavingsReq, prices map[string]float64, start, end time.Time) (float64, error) {
+	var total, days float64
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		month := day.Format(vars.MonthFormat)
+		price, ok := prices[month]
+		if !ok {
+			monthStart := timex.MustMonth(month)
+			ep30Ms := make([]float64, monthStart.AddDate(0, 1, -1).Day()*48)
+			for i := range ep30Ms {
+				ep30Ms[i] = 1
+			}
+
+			bill, err := NewGetMonthlyBillLogic(l.ctx, l.svcCtx).GetMonthlyBill(&cron.BillReq{
+				Account: in.Account,
+				Area:    in.Area,
+				Month:   month,
+				Ep30Ms:  ep30Ms,
+			})
+			if err != nil {
+				return 0, fmt.Errorf("获取%s电价失败: %v", month, err)
+			}
+
+			price = bill.UsageFee / bill.Usage
+			prices[month] = price
+		}
+
+		total += price
+		days++
+	}
+
+	return total / days, nil
+}
@@ -77,6 +77,18 @@ func (s *CronServer) GetWeathers(ctx context.Context, in *cron.WeathersReq) (*cr
 	return l.GetWeathers(in)
 }
 
+// 获取采暖/制冷度日
+func (s *CronServer) GetDegreeDays(ctx context.Context, in *cron.DegreeDaysReq) (*cron.DegreeDaysRsp, error) {
+	l := logic.NewGetDegreeDaysLogic(ctx, s.svcCtx)
+	return l.GetDegreeDays(in)
+}
+
+// 查询/指定气象站代码
+func (s *CronServer) ResolveWeatherStation(ctx context.Context, in *cron.StationReq) (*cron.StationRsp, error) {
+	l := logic.NewResolveWeatherStationLogic(ctx, s.svcCtx)
+	return l.ResolveWeatherStation(in)
+}
+
 // 获取假日列表
 func (s *CronServer) GetHolidays(ctx context.Context, in *cron.HolidaysReq) (*cron.HolidaysRsp, error) {
 	l := logic.NewGetHolidaysLogic(ctx, s.svcCtx)
@@ -101,6 +113,12 @@ func (s *CronServer) GetPrice(ctx context.Context, in *cron.PriceReq) (*cron.Pri
 	return l.GetPrice(in)
 }
 
+// 获取近期电价预警(气温触发尖峰/高峰)
+func (s *CronServer) GetUpcomingPriceEvents(ctx context.Context, in *cron.PriceEventsReq) (*cron.PriceEventsRsp, error) {
+	l := logic.NewGetUpcomingPriceEventsLogic(ctx, s.svcCtx)
+	return l.GetUpcomingPriceEvents(in)
+}
+
 // 获取账单
 func (s *CronServer) GetMonthlyBill(ctx context.Context, in *cron.BillReq) (*cron.BillRsp, error) {
 	l := logic.NewGetMonthlyBillLogic(ctx, s.svcCtx)
@@ -138,19 +156,67 @@ func (s *CronServer) DeleteUserOption(ctx context.Context, in *cron.DelReq) (*cr
 }
 
 // 新增用电时段
-func (s *CronServer) AddDlgdHours(ctx context.Context, in *cron.AddDlgdHourReq) (*cron.ResultRsp, error) {
+func (s *CronServer) AddDlgdHours(ctx context.Context, in *cron.AddDlgdHourReq) (*cron.DlgdHoursParseRsp, error) {
 	l := logic.NewAddDlgdHoursLogic(ctx, s.svcCtx)
 	return l.AddDlgdHours(in)
 }
 
+// 预览用电时段解析结果(不保存)
+func (s *CronServer) ParseDlgdHours(ctx context.Context, in *cron.AddDlgdHourReq) (*cron.DlgdHoursParseRsp, error) {
+	l := logic.NewParseDlgdHoursLogic(ctx, s.svcCtx)
+	return l.ParseDlgdHours(in)
+}
+
 // 确认用电时段
 func (s *CronServer) ConfirmDlgdHours(ctx context.Context, in *cron.DlgdHourReq) (*cron.ResultRsp, error) {
 	l := logic.NewConfirmDlgdHoursLogic(ctx, s.svcCtx)
 	return l.ConfirmDlgdHours(in)
 }
 
+// 修改用电时段
+func (s *CronServer) UpdateDlgdHours(ctx context.Context, in *cron.UpdateDlgdHoursReq) (*cron.ResultRsp, error) {
+	l := logic.NewUpdateDlgdHoursLogic(ctx, s.svcCtx)
+	return l.UpdateDlgdHours(in)
+}
+
+// 驳回用电时段
+func (s *CronServer) RejectDlgdHours(ctx context.Context, in *cron.DlgdHourReq) (*cron.ResultRsp, error) {
+	l := logic.NewRejectDlgdHoursLogic(ctx, s.svcCtx)
+	return l.RejectDlgdHours(in)
+}
+
 // 查询用电时段
 func (s *CronServer) GetDlgdHours(ctx context.Context, in *cron.DlgdHourReq) (*cron.DlgdHoursRsp, error) {
 	l := logic.NewGetDlgdHoursLogic(ctx, s.svcCtx)
 	return l.GetDlgdHours(in)
 }
+
+// 导出分时日历(iCalendar)
+func (s *CronServer) ExportTouCalendar(ctx context.Context, in *cron.TouCalendarReq) (*cron.TouCalendarRsp, error) {
+	l := logic.NewExportTouCalendarLogic(ctx, s.svcCtx)
+	return l.ExportTouCalendar(in)
+}
+
+// 获取碳排报告(范围二)
+func (s *CronServer) GetEmissionsReport(ctx context.Context, in *cron.EmissionsReq) (*cron.EmissionsRsp, error) {
+	l := logic.NewGetEmissionsReportLogic(ctx, s.svcCtx)
+	return l.GetEmissionsReport(in)
+}
+
+// 节能量核证(IPMVP选项C)
+func (s *CronServer) VerifySavings(ctx context.Context, in *cron.SavingsReq) (*cron.SavingsRsp, error) {
+	l := logic.NewVerifySavingsLogic(ctx, s.svcCtx)
+	return l.VerifySavings(in)
+}
+
+// 获取OCR用量
+func (s *CronServer) GetOcrUsage(ctx context.Context, in *cron.OcrUsageReq) (*cron.OcrUsageRsp, error) {
+	l := logic.NewGetOcrUsageLogic(ctx, s.svcCtx)
+	return l.GetOcrUsage(in)
+}
+
+// 重新解析归档的电价原始文件
+func (s *CronServer) ReprocessArtifact(ctx context.Context, in *cron.ArtifactReq) (*cron.ResultRsp, error) {
+	l := logic.NewReprocessArtifactLogic(ctx, s.svcCtx)
+	return l.ReprocessArtifact(in)
+}
@@ -0,0 +1,178 @@
+package svc
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"seeccloud.com/edscron/model"
+	"seeccloud.com/edscron/pkg/cronx"
+	"seeccloud.com/edscron/pkg/x/expx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 归档记录解析结果最大长度，同数据库字段
+const artifactMessageSize = 500
+
+// 重新解析状态，失败时message为失败原因
+const (
+	artifactReprocessing = "重新解析中"
+	artifactReprocessed  = "重新解析成功"
+)
+
+// archiveStore 原始文件归档配置，任务结束时归档结果写入artifact
+func (svc *ServiceContext) archiveStore(artifact *cronx.Artifact) cronx.ArtifactStore {
+	return cronx.ArtifactStore{
+		Dir: svc.Config.Archive.Dir,
+		OnArchive: func(a cronx.Artifact) {
+			*artifact = a
+		},
+	}
+}
+
+// saveArtifact 保存归档记录，返回记录ID；无归档文件或保存失败时返回0，不影响任务结果
+func (svc *ServiceContext) saveArtifact(ctx context.Context, category model.CronCategory, area, docNo string, task []byte, a cronx.Artifact, runErr error) int64 {
+	if len(a.Files) == 0 {
+		return 0
+	}
+
+	files, err := json.Marshal(a.Files)
+	if err != nil {
+		logx.Errorf("序列化归档文件失败: %v", err)
+		return 0
+	}
+
+	ret, err := svc.ArtifactModel.Insert(ctx, &model.Artifact{
+		Category:  string(category),
+		Area:      area,
+		DocNo:     docNo,
+		Url:       a.Url,
+		FetchedAt: sql.NullTime{Time: a.FetchedAt, Valid: !a.FetchedAt.IsZero()},
+		Files:     string(files),
+		Task:      string(task),
+		Message:   artifactMessage(runErr),
+	})
+	if err != nil {
+		logx.Errorf("保存归档记录失败: %v", err)
+		return 0
+	}
+
+	id, _ := ret.LastInsertId()
+	return id
+}
+
+// artifactMessage 解析结果，成功为空
+func artifactMessage(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	msg := []rune(err.Error())
+	if len(msg) > artifactMessageSize {
+		msg = msg[:artifactMessageSize]
+	}
+	return string(msg)
+}
+
+// ReprocessArtifact 以当前解析程序重新解析归档的原始文件，并保存电价结果
+// 执行中及完成后的状态记录于归档记录的message字段，并邮件通知结果；解析或保存失败时返回错误
+func (svc *ServiceContext) ReprocessArtifact(ctx context.Context, id int64) error {
+	record, err := svc.ArtifactModel.FindOne(ctx, id)
+	if err != nil {
+		return fmt.Errorf("获取归档记录失败: %v", err)
+	}
+
+	record.Message = artifactReprocessing
+	if err := svc.ArtifactModel.Update(ctx, record); err != nil {
+		return fmt.Errorf("更新归档记录失败: %v", err)
+	}
+
+	err = svc.reprocessArtifact(ctx, record)
+	record.Message = expx.If(err == nil, artifactReprocessed, artifactMessage(err))
+	if err1 := svc.ArtifactModel.Update(ctx, record); err1 != nil {
+		logx.Errorf("更新归档记录失败: %v", err1)
+	}
+
+	svc.Config.Mail.Send(cronx.ReprocessTemplate{
+		Id:       record.Id,
+		Category: record.Category,
+		Area:     record.Area,
+		Message:  artifactMessage(err),
+	})
+
+	return err
+}
+
+// reprocessArtifact 重新解析并保存，解析成功后的保存失败(如时段划分待确认)同样返回错误
+func (svc *ServiceContext) reprocessArtifact(ctx context.Context, record *model.Artifact) error {
+	artifact := cronx.Artifact{Url: record.Url, FetchedAt: record.FetchedAt.Time}
+	if err := json.Unmarshal([]byte(record.Files), &artifact.Files); err != nil {
+		return fmt.Errorf("解析归档文件失败: %v", err)
+	}
+
+	var save func() error
+	var err error
+	switch model.CronCategory(record.Category) {
+	case model.CategoryDlgd:
+		save, err = svc.reprocessDlgd(ctx, record, artifact)
+	case model.CategoryTwdl:
+		save, err = svc.reprocessTwdl(ctx, record, artifact)
+	default:
+		return fmt.Errorf("不支持重新解析的任务类型: %s", record.Category)
+	}
+
+	if err != nil {
+		return fmt.Errorf("重新解析失败: %v", err)
+	}
+
+	if err := save(); err != nil {
+		return fmt.Errorf("保存重新解析结果失败: %v", err)
+	}
+
+	return nil
+}
+
+// reprocessDlgd 重新解析代理购电原始文件，更新归档记录的政策文号，返回保存操作
+func (svc *ServiceContext) reprocessDlgd(ctx context.Context, record *model.Artifact, artifact cronx.Artifact) (func() error, error) {
+	var mini cronx.MiniDlgdConfig
+	if err := json.Unmarshal([]byte(record.Task), &mini); err != nil {
+		return nil, fmt.Errorf("解析代理购电任务配置失败: %v", err)
+	}
+
+	cfg := cronx.NewDlgdConfig(mini, svc.ocrConfig(model.CategoryDlgd))
+	cfg.Archive = cronx.ArtifactStore{Dir: svc.Config.Archive.Dir}
+	cfg.Hooks = svc.stepHooks()
+
+	rows, hours, diag, err := cfg.Reprocess(&svc.Config.Mail, artifact)
+	if err != nil {
+		return nil, err
+	}
+
+	record.DocNo = dlgdDocNo(hours)
+	return func() error {
+		return svc.saveDlgd(ctx, cfg, mini, rows, hours, diag, record.Id)
+	}, nil
+}
+
+// reprocessTwdl 重新解析台湾电价表及电价日历，返回保存操作
+func (svc *ServiceContext) reprocessTwdl(ctx context.Context, record *model.Artifact, artifact cronx.Artifact) (func() error, error) {
+	var cfg cronx.TwdlConfig
+	if err := json.Unmarshal([]byte(record.Task), &cfg); err != nil {
+		return nil, fmt.Errorf("解析台湾电力配置失败: %v", err)
+	}
+
+	cfg.Ocr = svc.ocrConfig(model.CategoryTwdl)
+	cfg.Archive = cronx.ArtifactStore{Dir: svc.Config.Archive.Dir}
+	cfg.Hooks = svc.stepHooks()
+
+	rows, days, err := (&cfg).Reprocess(&svc.Config.Mail, artifact)
+	if err != nil {
+		return nil, err
+	}
+
+	return func() error {
+		return svc.saveTwdl(ctx, rows, days, record.Id)
+	}, nil
+}
@@ -0,0 +1,53 @@
+package svc
+
+import (
+	"context"
+	"fmt"
+
+	"seeccloud.com/edscron/model"
+	"seeccloud.com/edscron/pkg/cronx"
+)
+
+// GetCarbonFactor 依地址匹配碳排因子，逐级查找：省级→区域电网→全国
+func (svc *ServiceContext) GetCarbonFactor(ctx context.Context, address string, category cronx.CarbonCategory, year int64) (*model.Carbon, cronx.CarbonLevel, error) {
+	province, _ := cronx.ExtractAddress(address, true)
+	if len(province) == 0 {
+		return nil, "", fmt.Errorf("依提供地址无法筛查省级信息, Address: %s", address)
+	}
+
+	return svc.GetCarbonFactorByArea(ctx, province, category, year)
+}
+
+// GetCarbonFactorByArea 依省级区域名(如福建、台湾)匹配碳排因子，指定年份未发布时取该级最新年份
+func (svc *ServiceContext) GetCarbonFactorByArea(ctx context.Context, province string, category cronx.CarbonCategory, year int64) (*model.Carbon, cronx.CarbonLevel, error) {
+	if len(category) == 0 {
+		category = cronx.CarbonEmission
+	}
+
+	c, err := svc.CarbonModel.FindOneByAreaCategoryNearlyYear(ctx, province, string(category), year)
+	if err == nil {
+		return c, cronx.CarbonLevelProvince, nil
+	} else if err != model.ErrNotFound {
+		return nil, "", err
+	}
+
+	if region := cronx.CarbonRegion(province); len(region) > 0 {
+		c, err = svc.CarbonModel.FindOneByAreaCategoryNearlyYear(ctx, region, string(category), year)
+		if err == nil {
+			return c, cronx.CarbonLevelRegion, nil
+		} else if err != model.ErrNotFound {
+			return nil, "", err
+		}
+	}
+
+	for _, area := range cronx.CarbonNationalAreas {
+		c, err = svc.CarbonModel.FindOneByAreaCategoryNearlyYear(ctx, area, string(category), year)
+		if err == nil {
+			return c, cronx.CarbonLevelNational, nil
+		} else if err != model.ErrNotFound {
+			return nil, "", err
+		}
+	}
+
+	return nil, "", fmt.Errorf("未找到%s碳排因子, 类型: %s", province, category)
+}
@@ -12,12 +12,19 @@ import (
 	"seeccloud.com/edscron/model"
 	"seeccloud.com/edscron/pkg/copierx"
 	"seeccloud.com/edscron/pkg/cronx"
+	"seeccloud.com/edscron/pkg/vars"
 	"seeccloud.com/edscron/pkg/x/expx"
 	"seeccloud.com/edscron/pkg/x/slicex"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/metric"
 )
 
 // runReDlgd 执行重试电量购电任务
 func runReDlgd(ctx context.Context, svc *ServiceContext) error {
+	svc.reDlgdMu.Lock()
+	defer svc.reDlgdMu.Unlock()
+
 	// 获取所有定时任务
 	crons, err := svc.CronModel.FindAll(ctx)
 	if err != nil {
@@ -49,6 +56,118 @@ func runReDlgd(ctx context.Context, svc *ServiceContext) error {
 	return nil
 }
 
+// RerunDlgd 重新执行区域内本月未成功的代理购电任务，用于时段划分确认后补抓电价
+// 单个任务失败不影响其他任务，返回全部失败原因
+func (svc *ServiceContext) RerunDlgd(ctx context.Context, area string) error {
+	svc.reDlgdMu.Lock()
+	defer svc.reDlgdMu.Unlock()
+
+	crons, err := svc.CronModel.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("获取定时任务列表失败: %v", err)
+	}
+
+	now := time.Now()
+	var errs []error
+	for _, cron := range *crons {
+		if cron.Category != string(model.CategoryDlgd) || (now.Year() == cron.StartTime.Year() && now.Month() == cron.StartTime.Month()) {
+			continue
+		}
+
+		task := []byte(strings.ReplaceAll(cron.Task, cron.Time, now.Format(cron.Time)))
+		var mini cronx.MiniDlgdConfig
+		if err := json.Unmarshal(task, &mini); err != nil {
+			continue
+		}
+
+		if cronx.NewDlgdConfig(mini, cronx.OcrConfig{}).Area != area {
+			continue
+		}
+
+		if err := runDlgd(ctx, svc, task); err != nil {
+			err = fmt.Errorf("重新执行代理购电任务(%d)失败: %v", cron.Id, err)
+			logx.Error(err)
+			errs = append(errs, err)
+			continue
+		}
+
+		cron.StartTime = time.Now()
+		if err := svc.CronModel.Update(ctx, &cron); err != nil {
+			err = fmt.Errorf("更新任务(%d)开始时间失败: %v", cron.Id, err)
+			logx.Error(err)
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ocrConfig 文档转换服务配置，按任务类别source统计用量
+func (svc *ServiceContext) ocrConfig(source model.CronCategory) cronx.OcrConfig {
+	return cronx.OcrConfig{
+		Provider: svc.Config.Ocr.Provider,
+		AliOcr: cronx.AliOcr{
+			Endpoint:        svc.Config.Ocr.Endpoint,
+			AccessKeyId:     svc.Config.Ocr.AccessKeyId,
+			AccessKeySecret: svc.Config.Ocr.AccessKeySecret,
+		},
+		Path:      svc.Config.Ocr.Path,
+		Command:   svc.Config.Ocr.Command,
+		CacheDir:  svc.Config.Ocr.CacheDir,
+		CacheDays: svc.Config.Ocr.CacheDays,
+		OnUsage: func(u cronx.OcrUsage) {
+			month := time.Now().Format(vars.MonthFormat)
+			if err := svc.UsageModel.Increase(context.Background(), u.Provider, month, string(source), u.Pages, u.Cached); err != nil {
+				logx.Errorf("记录OCR用量失败: %v", err)
+			}
+		},
+	}
+}
+
+// checkpointConfig 任务断点配置，失败重试时自首个失败步骤继续
+func (svc *ServiceContext) checkpointConfig() cronx.CheckpointConfig {
+	return cronx.CheckpointConfig{
+		Dir:   svc.Config.Checkpoint.Dir,
+		Hours: svc.Config.Checkpoint.Hours,
+	}
+}
+
+// 任务步骤耗时及执行次数，标签：流水线、步骤、结果(ok/fail)
+var (
+	stepDuration = metric.NewHistogramVec(&metric.HistogramVecOpts{
+		Namespace: "edscron",
+		Subsystem: "step",
+		Name:      "duration_ms",
+		Help:      "task step duration(ms).",
+		Labels:    []string{"pipeline", "step", "result"},
+		Buckets:   []float64{100, 1000, 5000, 10000, 30000, 60000, 180000, 600000},
+	})
+	stepTotal = metric.NewCounterVec(&metric.CounterVecOpts{
+		Namespace: "edscron",
+		Subsystem: "step",
+		Name:      "total",
+		Help:      "task step attempts.",
+		Labels:    []string{"pipeline", "step", "result"},
+	})
+)
+
+// stepHooks 任务步骤钩子，统计步骤耗时并记录执行进度
+func (svc *ServiceContext) stepHooks() cronx.StepHooks {
+	return cronx.StepHooks{
+		Before: func(e cronx.StepEvent) {
+			logx.Debugf("%s任务执行步骤%d/%d(%s)，第%d次", e.Pipeline, e.Index+1, e.Total, e.Step, e.Attempt)
+		},
+		After: func(e cronx.StepEvent) {
+			result := expx.If(e.Err == nil, "ok", "fail")
+			stepDuration.Observe(e.Elapsed.Milliseconds(), e.Pipeline, e.Step, result)
+			stepTotal.Inc(e.Pipeline, e.Step, result)
+			if e.Err != nil {
+				logx.Infof("%s任务步骤%s执行失败(第%d次，耗时%v): %v", e.Pipeline, e.Step, e.Attempt, e.Elapsed, e.Err)
+			}
+		},
+	}
+}
+
 // runDlgd 执行电量购电任务
 func runDlgd(ctx context.Context, svc *ServiceContext, task []byte) error {
 	var mini cronx.MiniDlgdConfig
@@ -58,21 +177,47 @@ func runDlgd(ctx context.Context, svc *ServiceContext, task []byte) error {
 		return fmt.Errorf("解析代理购电任务配置失败: %v", err)
 	}
 
-	cfg := cronx.NewDlgdConfig(mini, cronx.AliOcr{
-		Endpoint:        svc.Config.Ocr.Endpoint,
-		AccessKeyId:     svc.Config.Ocr.AccessKeyId,
-		AccessKeySecret: svc.Config.Ocr.AccessKeySecret,
-	})
+	cfg := cronx.NewDlgdConfig(mini, svc.ocrConfig(model.CategoryDlgd))
+	artifact := cronx.Artifact{}
+	cfg.Archive = svc.archiveStore(&artifact)
+	cfg.Checkpoint = svc.checkpointConfig()
+	cfg.Hooks = svc.stepHooks()
 
-	// 执行任务
-	dlgdRows, dlgdHours, err := cfg.Run(&svc.Config.Mail)
+	// 执行任务，无论成功与否均保存归档记录，以便解析程序修正后重新解析
+	dlgdRows, dlgdHours, diag, err := cfg.Run(&svc.Config.Mail)
+	artifactId := svc.saveArtifact(ctx, model.CategoryDlgd, cfg.Area, dlgdDocNo(dlgdHours), task, artifact, err)
 	if err != nil {
 		return fmt.Errorf("执行代理购电任务失败: %v", err)
 	}
 
+	return svc.saveDlgd(ctx, cfg, mini, dlgdRows, dlgdHours, diag, artifactId)
+}
+
+// dlgdDocNo 时段划分所属的电价政策文号
+func dlgdDocNo(hours *[]cronx.DlgdHour) string {
+	if hours == nil || len(*hours) == 0 {
+		return ""
+	}
+	return (*hours)[0].DocNo
+}
+
+// saveDlgd 校验时段划分及电价并保存代理购电结果，artifactId为原始文件归档记录ID
+func (svc *ServiceContext) saveDlgd(ctx context.Context, cfg cronx.DlgdConfig, mini cronx.MiniDlgdConfig, dlgdRows *[]cronx.DlgdRow, dlgdHours *[]cronx.DlgdHour, diag *cronx.DlgdHourDiagnostics, artifactId int64) error {
+	var err error
+
 	// 时段校验
-	docNo := expx.If(len(*dlgdHours) > 0, (*dlgdHours)[0].DocNo, "")
+	docNo := dlgdDocNo(dlgdHours)
 	oldHours, _ := svc.DlgdHourModel.QueryAll(ctx, cfg.Area, docNo)
+	if oldHours != nil && len(*oldHours) > 0 && (*oldHours)[0].Confirm == model.DlgdHourRejectCode {
+		return errors.New("时段划分已驳回，待修改后确认")
+	}
+
+	// 已人工修改的待确认时段不再覆盖
+	if reviews, _ := svc.ReviewModel.FindAllByAreaDocNo(ctx, cfg.Area, docNo); reviews != nil && len(*reviews) > 0 &&
+		oldHours != nil && len(*oldHours) > 0 && (*oldHours)[0].Confirm != model.DlgdHourConfirmCode {
+		return errors.New("时段划分待确认")
+	}
+
 	if oldHours == nil || len(*oldHours) == 0 || (*oldHours)[0].Confirm != model.DlgdHourConfirmCode {
 		hours := []model.DlgdHour{}
 		copierx.MustCopy(&hours, dlgdHours)
@@ -85,22 +230,47 @@ func runDlgd(ctx context.Context, svc *ServiceContext, task []byte) error {
 			Month:  cfg.Month,
 			DocNo:  docNo,
 			Detail: template.HTML(model.FormatHtmlDlgdHours(&hours)),
+			Issues: cronx.ValidateCoverage(*dlgdHours),
+			Diag:   diag,
 		})
 
 		return errors.New("时段划分待确认")
 	}
 
-	// 保存电价记录
+	// 校验并保存电价记录
 	confirmHours := []cronx.DlgdHour{}
 	copierx.MustCopy(&confirmHours, oldHours)
 	cfg.AutoFill(dlgdRows, &confirmHours)
 
+	rows := make([]model.Dlgd, 0, len(*dlgdRows))
 	for _, r := range *dlgdRows {
 		row := model.Dlgd{}
 		copierx.MustCopy(&row, &r)
+		if err := row.ValidateDates(); err != nil {
+			return fmt.Errorf("代理购电日期条件校验失败: %v", err)
+		}
+		row.ArtifactId = artifactId
+		rows = append(rows, row)
+	}
+
+	// 入库校验未通过时不保存，邮件通知人工核对(确认无误后任务配置uncheckedMonth以跳过当月校验)
+	if !mini.Unchecked() {
+		if issues := svc.validateDlgd(ctx, cfg.Area, *dlgdRows, diag); len(issues) > 0 {
+			svc.Config.Mail.Send(cronx.DlgdValidateTemplate{
+				Area:   cfg.Area,
+				Month:  cfg.Month,
+				Issues: issues,
+				Rows:   *dlgdRows,
+			})
+			return fmt.Errorf("代理购电校验未通过: %s等%d项问题", issues[0], len(issues))
+		}
+	}
+
+	for _, row := range rows {
 		old, _ := svc.DlgdModel.FindOneByAreaStartTimeCategoryVoltageStage(ctx, row.Area, row.StartTime, row.Category, row.Voltage, row.Stage)
 		if old != nil {
 			row.Id = old.Id
+			row.ArtifactId = expx.If(row.ArtifactId > 0, row.ArtifactId, old.ArtifactId)
 			err = svc.DlgdModel.Update(ctx, &row)
 		} else {
 			_, err = svc.DlgdModel.Insert(ctx, &row)
@@ -111,41 +281,92 @@ func runDlgd(ctx context.Context, svc *ServiceContext, task []byte) error {
 		}
 	}
 
+	// 气温触发城市自动添加天气任务
+	dates := []string{}
+	for _, r := range *dlgdRows {
+		dates = append(dates, r.SharpDate, r.PeakDate, r.FlatDate, r.ValleyDate, r.DeepDate)
+	}
+	if added, err := svc.ProvisionTempWeather(ctx, mini.Province, dates...); err != nil {
+		return fmt.Errorf("添加气温触发城市天气任务失败: %v", err)
+	} else if added {
+		svc.StartCron()
+	}
+
 	return nil
 }
 
+// validateDlgd 校验电价顺序、合理范围、政策所述浮动比例及与上月的用电分类、电压等级是否一致
+func (svc *ServiceContext) validateDlgd(ctx context.Context, area string, rows []cronx.DlgdRow, diag *cronx.DlgdHourDiagnostics) []string {
+	policy := ""
+	if diag != nil {
+		policy = diag.Text
+	}
+
+	prev := []cronx.DlgdRow{}
+	if len(rows) > 0 {
+		if olds, err := svc.DlgdModel.FindAllLatestBefore(ctx, area, rows[0].StartTime); err == nil {
+			copierx.MustCopy(&prev, olds)
+		}
+	}
+
+	return cronx.ValidateDlgd(rows, policy, prev, svc.Config.DlgdCheck.RatioTolerance)
+}
+
 // runTwdl 执行台湾电量购电任务
 func runTwdl(ctx context.Context, svc *ServiceContext, task *[]byte) error {
 	var cfg cronx.TwdlConfig
-	var rows []model.Twdl
-	var holidays []model.Holiday
 
 	// 解析配置
 	if err := json.Unmarshal(*task, &cfg); err != nil {
 		return fmt.Errorf("解析台湾电力配置失败: %v", err)
 	}
 
-	// 设置OCR配置
-	cfg.Ocr = cronx.AliOcr{
-		Endpoint:        svc.Config.Ocr.Endpoint,
-		AccessKeyId:     svc.Config.Ocr.AccessKeyId,
-		AccessKeySecret: svc.Config.Ocr.AccessKeySecret,
-	}
+	// 设置OCR、归档及断点配置
+	cfg.Ocr = svc.ocrConfig(model.CategoryTwdl)
+	artifact := cronx.Artifact{}
+	cfg.Archive = svc.archiveStore(&artifact)
+	cfg.Checkpoint = svc.checkpointConfig()
+	cfg.Hooks = svc.stepHooks()
 
 	// 执行任务
 	rsts, days, err := (&cfg).Run(&svc.Config.Mail)
+	artifactId := svc.saveArtifact(ctx, model.CategoryTwdl, string(cronx.TaiwanArea), "", *task, artifact, err)
 	if err != nil {
 		return fmt.Errorf("执行台湾电力任务失败: %v", err)
 	}
 
+	if err := svc.saveTwdl(ctx, rsts, days, artifactId); err != nil {
+		return err
+	}
+
+	cfg.Archive = cronx.ArtifactStore{}
+	cfg.Checkpoint = cronx.CheckpointConfig{}
+	buf, err := json.Marshal(cfg)
+	if err != nil {
+		return fmt.Errorf("序列化台湾电力配置失败: %v", err)
+	}
+
+	*task = buf
+
+	return nil
+}
+
+// saveTwdl 保存台湾电价及离峰日结果，artifactId为原始文件归档记录ID
+func (svc *ServiceContext) saveTwdl(ctx context.Context, rsts *[]cronx.TwdlRow, days *[]cronx.Holiday, artifactId int64) error {
+	var rows []model.Twdl
+	var holidays []model.Holiday
+	var err error
+
 	// 转换结果
 	copierx.MustCopy(&rows, rsts)
 
 	// 保存结果
 	for _, v := range rows {
+		v.ArtifactId = artifactId
 		old, _ := svc.TwdlModel.FindOneByStartTimeCategoryDate(ctx, v.StartTime, v.Category, v.Date)
 		if old != nil {
 			v.Id = old.Id
+			v.ArtifactId = expx.If(v.ArtifactId > 0, v.ArtifactId, old.ArtifactId)
 			err = svc.TwdlModel.Update(ctx, &v)
 		} else {
 			_, err = svc.TwdlModel.Insert(ctx, &v)
@@ -173,13 +394,6 @@ func runTwdl(ctx context.Context, svc *ServiceContext, task *[]byte) error {
 		}
 	}
 
-	buf, err := json.Marshal(cfg)
-	if err != nil {
-		return fmt.Errorf("序列化台湾电力配置失败: %v", err)
-	}
-
-	*task = buf
-
 	return nil
 }
 
@@ -191,6 +405,7 @@ func runCarbon(ctx context.Context, svc *ServiceContext, task []byte) error {
 	if err := json.Unmarshal(task, &cfg); err != nil {
 		return fmt.Errorf("解析碳排放配置失败: %v", err)
 	}
+	cfg.Hooks = svc.stepHooks()
 
 	rsts, err := cfg.Run(&svc.Config.Mail)
 	if err != nil {
@@ -200,7 +415,7 @@ func runCarbon(ctx context.Context, svc *ServiceContext, task []byte) error {
 	copierx.MustCopy(&rows, rsts)
 
 	for _, v := range rows {
-		old, _ := svc.CarbonModel.FindOneByAreaYear(ctx, v.Area, v.Year)
+		old, _ := svc.CarbonModel.FindOneByAreaCategoryYear(ctx, v.Area, v.Category, v.Year)
 		if old != nil {
 			v.Id = old.Id
 			err = svc.CarbonModel.Update(ctx, &v)
@@ -224,6 +439,7 @@ func runTwCarbon(ctx context.Context, svc *ServiceContext, task *[]byte) error {
 	if err := json.Unmarshal(*task, &cfg); err != nil {
 		return fmt.Errorf("解析碳排放配置失败: %v", err)
 	}
+	cfg.Hooks = svc.stepHooks()
 
 	rsts, err := (&cfg).Run(&svc.Config.Mail)
 
@@ -234,7 +450,7 @@ func runTwCarbon(ctx context.Context, svc *ServiceContext, task *[]byte) error {
 	copierx.MustCopy(&rows, rsts)
 
 	for _, v := range rows {
-		old, _ := svc.CarbonModel.FindOneByAreaYear(ctx, v.Area, v.Year)
+		old, _ := svc.CarbonModel.FindOneByAreaCategoryYear(ctx, v.Area, v.Category, v.Year)
 		if old != nil {
 			v.Id = old.Id
 			err = svc.CarbonModel.Update(ctx, &v)
@@ -299,6 +515,8 @@ func runWeather(ctx context.Context, svc *ServiceContext, task []byte) error {
 		return fmt.Errorf("解析天气配置失败: %v", err)
 	}
 
+	svc.fillWeatherStation(ctx, &cfg.WeatherSource, cfg.Province, cfg.City)
+
 	rsts, err := cfg.Run(&svc.Config.Mail)
 	if err != nil {
 		return fmt.Errorf("执行天气任务失败: %v", err)
@@ -320,5 +538,77 @@ func runWeather(ctx context.Context, svc *ServiceContext, task []byte) error {
 		}
 	}
 
+	// 次日气温触发电价预警，天气已保存，预警失败不影响任务结果
+	if err := svc.EvaluatePriceEvents(ctx, cfg.Province, cfg.City); err != nil {
+		logx.Errorf("评估次日电价预警失败: %v", err)
+	}
+
+	return nil
+}
+
+// runObserved 执行实测天气任务
+func runObserved(ctx context.Context, svc *ServiceContext, task []byte) error {
+	var cfg cronx.WeatherObservedConfig
+	var rows []model.WeatherObserved
+
+	if err := json.Unmarshal(task, &cfg); err != nil {
+		return fmt.Errorf("解析实测天气配置失败: %v", err)
+	}
+
+	svc.fillWeatherStation(ctx, &cfg.WeatherSource, cfg.Province, cfg.City)
+
+	rsts, err := cfg.Run(&svc.Config.Mail)
+	if err != nil {
+		return fmt.Errorf("执行实测天气任务失败: %v", err)
+	}
+
+	copierx.MustCopy(&rows, rsts)
+
+	for _, v := range rows {
+		old, _ := svc.ObservedModel.FindOneByDateCity(ctx, v.Date, v.City)
+		if old != nil {
+			v.Id = old.Id
+			err = svc.ObservedModel.Update(ctx, &v)
+		} else {
+			_, err = svc.ObservedModel.Insert(ctx, &v)
+		}
+
+		if err != nil {
+			return fmt.Errorf("保存实测天气结果失败: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// runStation 执行气象站代码任务，人工指定的映射不覆盖
+func runStation(ctx context.Context, svc *ServiceContext) error {
+	rsts, err := cronx.WeatherStationConfig{}.Run(&svc.Config.Mail)
+	if err != nil {
+		return fmt.Errorf("执行气象站代码任务失败: %v", err)
+	}
+
+	for _, v := range *rsts {
+		old, _ := svc.StationModel.FindOneByProvinceCity(ctx, v.Province, v.City)
+		if old != nil {
+			if old.Manual == 1 || old.Code == v.Code {
+				continue
+			}
+
+			old.Code = v.Code
+			err = svc.StationModel.Update(ctx, old)
+		} else {
+			_, err = svc.StationModel.Insert(ctx, &model.WeatherStation{
+				Province: v.Province,
+				City:     v.City,
+				Code:     v.Code,
+			})
+		}
+
+		if err != nil {
+			return fmt.Errorf("保存气象站代码失败: %v", err)
+		}
+	}
+
 	return nil
 }
@@ -2,74 +2,100 @@ package svc
 
 import (
 	"context"
-	"regexp"
-	"strconv"
-	"strings"
+	"fmt"
 	"time"
 
 	"seeccloud.com/edscron/model"
 	"seeccloud.com/edscron/pkg/cronx"
 	"seeccloud.com/edscron/pkg/vars"
+
+	"github.com/zeromicro/go-zero/core/logx"
 )
 
 // GetPrice 获取指定时间点的电价信息
 func (svc *ServiceContext) GetDlgdPrice(t time.Time, one *model.Dlgd) (*cronx.Period, error) {
 
 	ctx := context.Background()
-	dateCondition := one.DeepDate + one.SharpDate + one.ValleyDate + one.PeakDate + one.FlatDate
-
-	holiday := cronx.HolidayNull
-	// holiday, holiday:3, holiday:春节,劳动节,国庆节;weekend
-	subs := regexp.MustCompile("holiday([^;]*)").FindStringSubmatch(dateCondition)
-	if len(subs) == 2 {
-		hol, err := svc.HolidayModel.FindOneByAreaDateCache(ctx, string(cronx.ChinaArea), t.Format(vars.DateFormat))
-		if err == nil {
-			// 调休工作日
-			if hol.Category == string(cronx.HolidayOn) {
-				holiday = cronx.HolidayOn
-			}
+	day := cronx.DayInfo{
+		Time:     t,
+		Holiday:  cronx.HolidayNull,
+		Category: one.Category,
+	}
 
-			if hol.Category == string(cronx.HolidayOff) {
-				// 全年或指定假期
-				if len(subs[1]) == 0 || strings.Contains(subs[1], hol.Detail) {
-					holiday = cronx.HolidayOff
-				}
-
-				// 连续N天以上的假期
-				if num, err := strconv.Atoi(subs[1][len(subs[1])-1:]); err == nil {
-					size, _ := svc.HolidayModel.GetHolidayOffSizeByAreaDate(ctx, string(cronx.ChinaArea), t.Format(vars.DateFormat))
-					if int(size) >= num {
-						holiday = cronx.HolidayOff
-					}
-				}
-			}
+	hol, err := svc.HolidayModel.FindOneByAreaDateCache(ctx, string(cronx.ChinaArea), t.Format(vars.DateFormat))
+	if err == nil {
+		day.Holiday = cronx.HolidayCategory(hol.Category)
+		day.HolidayName = hol.Detail
+		if day.Holiday == cronx.HolidayOff {
+			size, _ := svc.HolidayModel.GetHolidayOffSizeByAreaDate(ctx, string(cronx.ChinaArea), t.Format(vars.DateFormat))
+			day.HolidaySize = int(size)
 		}
 	}
 
-	isWeatherActived := false
-	subs = regexp.MustCompile(`temp:(\d+)(?:,(\d+))?`).FindStringSubmatch(dateCondition)
-	if len(subs) == 3 {
-		capital, err := svc.AreaModel.GetProvincialCapital(ctx, one.Area)
+	// 各时段日期条件独立求值，避免条件串用
+	var activeErr error
+	actived := func(dateCondition string) bool {
+		cond, err := cronx.ParseDateCondition(dateCondition)
 		if err != nil {
-			return nil, err
+			// 无法识别的条件不能静默视为不生效，否则电价错误且无从排查
+			logx.Errorf("代理购电日期条件解析失败(ID=%d): %v", one.Id, err)
+			if activeErr == nil {
+				activeErr = fmt.Errorf("%s>%s>%s日期条件错误: %v", one.Area, one.Category, one.Voltage, err)
+			}
+			return false
 		}
 
-		temp, _ := strconv.ParseFloat(subs[1], 64)
-		hiTempSize, err := svc.WeatherModel.FindHiTempSize(ctx, t.Format(vars.DateFormat), capital, temp)
-		if err != nil {
-			return nil, err
+		tempActived := false
+		if cond.Temp != nil {
+			tempActived, err = svc.isTempActived(ctx, t, one.Area, *cond.Temp)
+			if err != nil && activeErr == nil {
+				activeErr = err
+			}
 		}
 
-		daySize := 1
-		if len(subs[2]) > 0 {
-			daySize, _ = strconv.Atoi(subs[2])
+		return cond.Active(day, tempActived)
+	}
+
+	price := one.GetPrice(t, actived)
+	if activeErr != nil {
+		return nil, activeErr
+	}
+
+	return &price, nil
+}
+
+// isTempActived 判断气温触发条件在指定日期是否满足，未指定城市时参考省会
+func (svc *ServiceContext) isTempActived(ctx context.Context, t time.Time, area string, cond cronx.TempCondition) (bool, error) {
+	if len(cond.Cities) == 0 {
+		capital, err := svc.AreaModel.GetProvincialCapital(ctx, area)
+		if err != nil {
+			return false, err
 		}
+		cond.Cities = []string{capital}
+	}
+
+	size, err := svc.GetTempTriggerSize(ctx, t.Format(vars.DateFormat), cond)
+	if err != nil {
+		return false, err
+	}
 
-		if int(hiTempSize) >= daySize {
-			isWeatherActived = true
+	return int(size) >= cond.Days, nil
+}
+
+// CheckDlgdDates 校验存量电价的日期条件，记录无法解析的记录并返回数量
+func (svc *ServiceContext) CheckDlgdDates(ctx context.Context) (int, error) {
+	all, err := svc.DlgdModel.FindAllWithDates(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	size := 0
+	for _, one := range *all {
+		if err := one.ValidateDates(); err != nil {
+			logx.Errorf("代理购电日期条件无法解析(ID=%d, 起始时间=%s): %v", one.Id, one.StartTime.Format(vars.DateFormat), err)
+			size++
 		}
 	}
 
-	price := one.GetPrice(t, holiday, isWeatherActived)
-	return &price, nil
+	return size, nil
 }
@@ -0,0 +1,60 @@
+package svc
+
+import (
+	"context"
+	"strings"
+
+	"seeccloud.com/edscron/model"
+	"seeccloud.com/edscron/pkg/copierx"
+	"seeccloud.com/edscron/pkg/cronx"
+)
+
+// AddDlgdHourReview 记录时段划分审核，并与区域上一已确认文号的时段划分对比
+func (svc *ServiceContext) AddDlgdHourReview(ctx context.Context, area, docNo, action, reviewer, comment string) (*model.DlgdHourReview, error) {
+	review := model.DlgdHourReview{
+		Area:     area,
+		DocNo:    docNo,
+		Action:   action,
+		Reviewer: reviewer,
+		Comment:  comment,
+	}
+
+	diffs, baseDocNo, err := svc.DiffDlgdHours(ctx, area, docNo)
+	if err != nil {
+		return nil, err
+	}
+	review.BaseDocNo = baseDocNo
+	review.Diff = strings.Join(diffs, "\n")
+
+	if _, err := svc.ReviewModel.Insert(ctx, &review); err != nil {
+		return nil, err
+	}
+
+	return &review, nil
+}
+
+// DiffDlgdHours 对比时段划分与区域上一已确认文号，返回差异及对比文号，无已确认文号时全部视为新增
+func (svc *ServiceContext) DiffDlgdHours(ctx context.Context, area, docNo string) ([]string, string, error) {
+	current, err := svc.DlgdHourModel.QueryAll(ctx, area, docNo)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var base *[]model.DlgdHour
+	baseDocNo, err := svc.DlgdHourModel.FindLastConfirmedDocNo(ctx, area, docNo)
+	if err == nil {
+		if base, err = svc.DlgdHourModel.QueryAll(ctx, area, baseDocNo); err != nil {
+			return nil, "", err
+		}
+	} else if err != model.ErrNotFound {
+		return nil, "", err
+	}
+
+	prev, next := []cronx.DlgdHour{}, []cronx.DlgdHour{}
+	if base != nil {
+		copierx.MustCopy(&prev, base)
+	}
+	copierx.MustCopy(&next, current)
+
+	return cronx.DiffDlgdHours(prev, next), baseDocNo, nil
+}
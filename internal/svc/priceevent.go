@@ -0,0 +1,125 @@
+package svc
+
+import (
+	"context"
+	"html/template"
+	"slices"
+	"strings"
+	"time"
+
+	"seeccloud.com/edscron/model"
+	"seeccloud.com/edscron/pkg/cronx"
+	"seeccloud.com/edscron/pkg/vars"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// EvaluatePriceEvents 依据次日天气预报评估气温触发的尖峰/高峰时段，新增事件按工程邮件预警
+// 仅评估触发城市包含city的规则，避免同省多个天气任务重复计算
+// 单个档案或规则评估失败仅记录日志，不影响其他档案
+func (svc *ServiceContext) EvaluatePriceEvents(ctx context.Context, province, city string) error {
+	options, err := svc.OptionModel.FindAllByProvince(ctx, province)
+	if err != nil {
+		return err
+	}
+
+	tomorrow := time.Now().AddDate(0, 0, 1)
+	date := tomorrow.Format(vars.DateFormat)
+	start := time.Date(tomorrow.Year(), tomorrow.Month(), 1, 0, 0, 0, 0, time.Local).Format(vars.DatetimeFormat)
+
+	capital, err := svc.AreaModel.GetProvincialCapital(ctx, province)
+	if err != nil {
+		return err
+	}
+
+	events := map[string][]model.PriceEvent{}
+	accounts := []string{}
+	for _, option := range *options {
+		infos := strings.Split(option.Category, cronx.CategorySep)
+		if len(infos) < 3 {
+			continue
+		}
+
+		one, err := svc.DlgdModel.FindFirstByAreaStartTimeCategoryVoltage(ctx, infos[0], start, infos[1], infos[2])
+		if err == model.ErrNotFound {
+			one, err = svc.DlgdModel.FindOneByAreaCategoryVoltageAtNearlyStartTime(ctx, infos[0], start, infos[1], infos[2])
+		}
+		if err == model.ErrNotFound || (err == nil && one == nil) {
+			continue
+		}
+		if err != nil {
+			logx.Errorf("查询代理购电失败(%s/%s): %v", option.Account, option.Category, err)
+			continue
+		}
+
+		bands := []struct {
+			period cronx.Period
+			date   string
+			hour   string
+			price  float64
+		}{
+			{cronx.PeriodSharp, one.SharpDate, one.SharpHour, one.Sharp},
+			{cronx.PeriodPeak, one.PeakDate, one.PeakHour, one.Peak},
+		}
+
+		for _, band := range bands {
+			cond, ok := cronx.ParseTempCondition(band.date)
+			if !ok || len(band.hour) == 0 {
+				continue
+			}
+
+			if len(cond.Cities) == 0 {
+				cond.Cities = []string{capital}
+			}
+
+			if !slices.Contains(cond.Cities, city) {
+				continue
+			}
+
+			size, err := svc.GetTempTriggerSize(ctx, date, cond)
+			if err != nil {
+				logx.Errorf("统计气温触发天数失败(%s): %v", cond.String(), err)
+				continue
+			}
+
+			if int(size) < cond.Days {
+				continue
+			}
+
+			// 已预警的事件不重复通知
+			if _, err := svc.EventModel.FindOneByAccountAreaDatePeriod(ctx, option.Account, option.Area, date, band.period.Name); err == nil {
+				continue
+			}
+
+			event := model.PriceEvent{
+				Account:     option.Account,
+				Area:        option.Area,
+				Category:    option.Category,
+				Date:        date,
+				Period:      band.period.Name,
+				Hour:        band.hour,
+				Price:       band.price,
+				Rule:        cond.String(),
+				Provisional: 1,
+			}
+			if _, err := svc.EventModel.Insert(ctx, &event); err != nil {
+				logx.Errorf("保存电价预警失败(%s/%s/%s): %v", option.Account, date, band.period.Name, err)
+				continue
+			}
+			if _, ok := events[option.Account]; !ok {
+				accounts = append(accounts, option.Account)
+			}
+			events[option.Account] = append(events[option.Account], event)
+		}
+	}
+
+	for _, account := range accounts {
+		svc.Config.Mail.SendAccount(account, cronx.PriceEventTemplate{
+			Account: account,
+			Date:    date,
+			Details: template.HTML(model.FormatHtmlPriceEvents(events[account])),
+		})
+	}
+
+	return nil
+}
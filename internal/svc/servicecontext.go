@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/robfig/cron"
@@ -19,12 +20,20 @@ type ServiceContext struct {
 	CarbonModel   model.CarbonModel
 	DlgdModel     model.DlgdModel
 	DlgdHourModel model.DlgdHourModel
+	ReviewModel   model.DlgdHourReviewModel
 	TwdlModel     model.TwdlModel
 	HolidayModel  model.HolidayModel
 	WeatherModel  model.WeatherModel
+	ObservedModel model.WeatherObservedModel
+	StationModel  model.WeatherStationModel
+	EventModel    model.PriceEventModel
+	UsageModel    model.OcrUsageModel
+	ArtifactModel model.ArtifactModel
 	AreaModel     model.AreaModel
 	OptionModel   model.UserOptionModel
 	Cr            *cron.Cron
+	crMu          sync.Mutex // 保护Cr重建，任务执行中(如代理购电新增天气任务)也会重启调度器
+	reDlgdMu      sync.Mutex // 串行执行定时重试及确认时段后的补抓，避免同一代理购电任务同时执行
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -35,9 +44,15 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		CarbonModel:   model.NewCarbonModel(conn, c.CacheRedis),
 		DlgdModel:     model.NewDlgdModel(conn, c.CacheRedis),
 		DlgdHourModel: model.NewDlgdHourModel(conn, c.CacheRedis),
+		ReviewModel:   model.NewDlgdHourReviewModel(conn, c.CacheRedis),
 		TwdlModel:     model.NewTwdlModel(conn, c.CacheRedis),
 		HolidayModel:  model.NewHolidayModel(conn, c.CacheRedis),
 		WeatherModel:  model.NewWeatherModel(conn, c.CacheRedis),
+		ObservedModel: model.NewWeatherObservedModel(conn, c.CacheRedis),
+		StationModel:  model.NewWeatherStationModel(conn, c.CacheRedis),
+		EventModel:    model.NewPriceEventModel(conn, c.CacheRedis),
+		UsageModel:    model.NewOcrUsageModel(conn, c.CacheRedis),
+		ArtifactModel: model.NewArtifactModel(conn, c.CacheRedis),
 		AreaModel:     model.NewAreaModel(conn, c.CacheRedis),
 		OptionModel:   model.NewUserOptionModel(conn, c.CacheRedis),
 		Cr:            cron.New(),
@@ -59,6 +74,10 @@ func (svc *ServiceContext) Todo(c *model.Cron, execTime string) {
 		err = runHoliday(ctx, svc, task)
 	case model.CategoryWeather: // 天气任务
 		err = runWeather(ctx, svc, task)
+	case model.CategoryObserved: // 实测天气任务
+		err = runObserved(ctx, svc, task)
+	case model.CategoryStation: // 气象站代码任务
+		err = runStation(ctx, svc)
 	case model.CategoryCarbon: // 碳排放任务
 		err = runCarbon(ctx, svc, task)
 	case model.CategoryTwCarbon: // 台湾碳排放任务
@@ -77,6 +96,9 @@ func (svc *ServiceContext) Todo(c *model.Cron, execTime string) {
 // StartCron 启动所有定时任务
 // 注意：当任务有增删改时，需要调用此方法重启所有任务
 func (svc *ServiceContext) StartCron() {
+	svc.crMu.Lock()
+	defer svc.crMu.Unlock()
+
 	// 停止现有任务调度
 	svc.Cr.Stop()
 	// 创建新的调度器实例
@@ -119,6 +141,10 @@ func (svc *ServiceContext) StartCron() {
 				execErr = runHoliday(ctx, svc, taskData)
 			case model.CategoryWeather:
 				execErr = runWeather(ctx, svc, taskData)
+			case model.CategoryObserved:
+				execErr = runObserved(ctx, svc, taskData)
+			case model.CategoryStation:
+				execErr = runStation(ctx, svc)
 			case model.CategoryCarbon:
 				execErr = runCarbon(ctx, svc, taskData)
 			case model.CategoryTwCarbon:
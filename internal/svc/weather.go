@@ -0,0 +1,211 @@
+package svc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"seeccloud.com/edscron/model"
+	"seeccloud.com/edscron/pkg/cronx"
+	"seeccloud.com/edscron/pkg/vars"
+	"seeccloud.com/edscron/pkg/x/slicex"
+	"seeccloud.com/edscron/pkg/x/timex"
+)
+
+// GetHiTempSize 获取截至指定日期(含)连续最高气温不低于temp的天数，结果缓存至次日零时(计费时按时段逐次查询)
+// 已过日期优先取实测最高气温，当日及未来日期取预报白天气温；已过日期缺实测时回退预报(如实测任务上线前的历史数据)
+func (svc *ServiceContext) GetHiTempSize(ctx context.Context, date string, city string, temp float64) (int64, error) {
+	return svc.WeatherModel.FindHiTempSize(ctx, date, city, temp, func() (int64, error) {
+		today := timex.MustDate(time.Now().Format(vars.DateFormat))
+		var size int64
+		for day := timex.MustDate(date); ; day = day.AddDate(0, 0, -1) {
+			hiTemp, err := svc.getDayHiTemp(ctx, day, today, city)
+			if err == model.ErrNotFound || (err == nil && hiTemp < temp) {
+				break
+			} else if err != nil {
+				return 0, err
+			}
+
+			size++
+		}
+
+		return size, nil
+	})
+}
+
+// GetTempTriggerSize 获取截至指定日期(含)满足气温条件的连续天数，cond.Cities不能为空
+func (svc *ServiceContext) GetTempTriggerSize(ctx context.Context, date string, cond cronx.TempCondition) (int64, error) {
+	// 任一城市：各城市单独计算连续天数，取最大
+	if cond.Agg == cronx.TempAggAny || len(cond.Cities) == 1 {
+		var size int64
+		for _, city := range cond.Cities {
+			s, err := svc.GetHiTempSize(ctx, date, city, cond.Temp)
+			if err != nil {
+				return 0, err
+			}
+			size = max(size, s)
+		}
+		return size, nil
+	}
+
+	cities := fmt.Sprintf("%s:%s", cond.Agg, strings.Join(cond.Cities, ","))
+	return svc.WeatherModel.FindHiTempSize(ctx, date, cities, cond.Temp, func() (int64, error) {
+		today := timex.MustDate(time.Now().Format(vars.DateFormat))
+		var size int64
+		for day := timex.MustDate(date); ; day = day.AddDate(0, 0, -1) {
+			var highs []float64
+			for _, city := range cond.Cities {
+				hiTemp, err := svc.getDayHiTemp(ctx, day, today, city)
+				if err == nil {
+					highs = append(highs, hiTemp)
+				} else if err != model.ErrNotFound {
+					return 0, err
+				}
+			}
+
+			// 各城市均无数据或聚合气温未达标时中断
+			if len(highs) == 0 || cond.Aggregate(highs) < cond.Temp {
+				break
+			}
+
+			size++
+		}
+
+		return size, nil
+	})
+}
+
+// ProvisionTempWeather 为日期条件中的气温触发城市补充天气预报及实测任务，返回是否新增任务
+func (svc *ServiceContext) ProvisionTempWeather(ctx context.Context, province string, dateConditions ...string) (bool, error) {
+	var cities []string
+	for _, date := range dateConditions {
+		cond, ok := cronx.ParseTempCondition(date)
+		if !ok {
+			continue
+		}
+
+		if len(cond.Cities) == 0 {
+			capital, err := svc.AreaModel.GetProvincialCapital(ctx, province)
+			if err != nil {
+				return false, err
+			}
+			cond.Cities = []string{capital}
+		}
+		cities = append(cities, cond.Cities...)
+	}
+
+	crons := []model.Cron{}
+	for _, city := range slicex.RemoveDuplicates(cities) {
+		address := model.Address{Province: province, City: city}
+		crons = append(crons,
+			model.NewCron(model.CategoryWeather, address),
+			model.NewCron(model.CategoryObserved, address),
+		)
+	}
+
+	if len(crons) == 0 {
+		return false, nil
+	}
+
+	// 已存在的任务不重复添加，无新增时返回nil
+	ret, err := svc.CronModel.BatchInsert(ctx, crons)
+	return ret != nil, err
+}
+
+// getDayHiTemp 获取单日最高气温，day早于today时优先实测
+func (svc *ServiceContext) getDayHiTemp(ctx context.Context, day, today time.Time, city string) (float64, error) {
+	if day.Before(today) {
+		ob, err := svc.ObservedModel.FindOneByDateCity(ctx, day.Format(vars.DateFormat), city)
+		if err == nil {
+			return ob.MaxTemp, nil
+		} else if err != model.ErrNotFound {
+			return 0, err
+		}
+	}
+
+	wea, err := svc.WeatherModel.FindOneByDateCity(ctx, day.Format(vars.DateFormat), city)
+	if err != nil {
+		return 0, err
+	}
+
+	return wea.DayTemp, nil
+}
+
+// ResolveWeatherStation 依省市名称匹配已缓存的气象站代码
+func (svc *ServiceContext) ResolveWeatherStation(ctx context.Context, province, city string) (*model.WeatherStation, error) {
+	rows, err := svc.StationModel.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stations := slicex.MapFunc(*rows, func(r model.WeatherStation) cronx.WeatherStation {
+		return cronx.WeatherStation{Province: r.Province, City: r.City, Code: r.Code}
+	})
+	s, ok := cronx.MatchStation(stations, province, city)
+	if !ok {
+		return nil, model.ErrNotFound
+	}
+
+	return svc.StationModel.FindOneByProvinceCity(ctx, s.Province, s.City)
+}
+
+// fillWeatherStation nmc数据源未指定气象站时，填入缓存代码，未缓存则保持在线匹配
+func (svc *ServiceContext) fillWeatherStation(ctx context.Context, source *cronx.WeatherSource, province, city string) {
+	if len(source.Station) > 0 || (len(source.Provider) > 0 && source.Provider != cronx.ProviderNmc) {
+		return
+	}
+
+	station, err := svc.ResolveWeatherStation(ctx, province, city)
+	if err != nil {
+		logx.Infof("未缓存气象站代码(%s%s), 在线匹配: %v", province, city, err)
+		return
+	}
+
+	source.Station = station.Code
+}
+
+// GetDailyTemps 获取城市start~end(含)的逐日最高/最低气温，source缺省时已过日期优先实测、缺实测取预报
+func (svc *ServiceContext) GetDailyTemps(ctx context.Context, city string, start, end time.Time, source cronx.TempSource) ([]cronx.DailyTemp, error) {
+	date := start.Format(vars.DateFormat)
+	size := int64(end.Sub(start).Hours()/24) + 1
+	temps := map[string]cronx.DailyTemp{}
+
+	if source != cronx.TempObserved {
+		weas, err := svc.WeatherModel.FindAllByDateCity(ctx, date, city, size)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, w := range *weas {
+			temps[w.Date] = cronx.DailyTemp{Date: timex.MustDate(w.Date), High: w.DayTemp, Low: w.NightTemp}
+		}
+	}
+
+	if source != cronx.TempForecast {
+		today := time.Now().Format(vars.DateFormat)
+		obs, err := svc.ObservedModel.FindAllByDateCity(ctx, date, city, size)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, o := range *obs {
+			if source == cronx.TempAuto && o.Date >= today {
+				continue
+			}
+			temps[o.Date] = cronx.DailyTemp{Date: timex.MustDate(o.Date), High: o.MaxTemp, Low: o.MinTemp, Observed: true}
+		}
+	}
+
+	results := make([]cronx.DailyTemp, 0, len(temps))
+	for _, t := range temps {
+		results = append(results, t)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Date.Before(results[j].Date)
+	})
+
+	return results, nil
+}
@@ -0,0 +1 @@
+ALTER TABLE `carbon` DROP COLUMN `source`;
\ No newline at end of file
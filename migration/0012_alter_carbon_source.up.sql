@@ -0,0 +1,2 @@
+ALTER TABLE `carbon`
+  ADD COLUMN `source` varchar(255) COLLATE utf8_bin NOT NULL DEFAULT '' COMMENT '来源文件，如生态环境部公告链接' AFTER `value`;
@@ -0,0 +1,6 @@
+-- 回滚前删除非默认类型(emission)的因子，否则(area, year)唯一索引冲突
+DELETE FROM `carbon` WHERE `category` <> 'emission';
+ALTER TABLE `carbon`
+  DROP INDEX `area_category_year`,
+  DROP COLUMN `category`,
+  ADD UNIQUE KEY `area_year` (`area`,`year`);
\ No newline at end of file
@@ -0,0 +1,4 @@
+ALTER TABLE `carbon`
+  ADD COLUMN `category` varchar(20) COLLATE utf8_bin NOT NULL DEFAULT 'emission' COMMENT '因子类型：emission(二氧化碳排放因子)、footprint(碳足迹因子)、fossil(化石能源排放因子)、market(市场化调整排放因子)' AFTER `area`,
+  DROP INDEX `area_year`,
+  ADD UNIQUE KEY `area_category_year` (`area`,`category`,`year`);
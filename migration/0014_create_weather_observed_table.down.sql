@@ -0,0 +1 @@
+DROP TABLE IF EXISTS `weather_observed`;
\ No newline at end of file
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS `weather_observed` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `date` varchar(50) COLLATE utf8_bin NOT NULL DEFAULT '' COMMENT '日期',
+  `city` varchar(50) COLLATE utf8_bin NOT NULL DEFAULT '' COMMENT '城市',
+  `max_temp` float NOT NULL DEFAULT '0' COMMENT '实测最高气温',
+  `min_temp` float NOT NULL DEFAULT '0' COMMENT '实测最低气温',
+  `hours` int(11) NOT NULL DEFAULT '0' COMMENT '有效观测小时数',
+  `source` varchar(255) COLLATE utf8_bin NOT NULL DEFAULT '' COMMENT '数据来源',
+  `create_time` timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+  `update_time` timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `date_city` (`date`,`city`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COLLATE=utf8_bin COMMENT='实测天气';
\ No newline at end of file
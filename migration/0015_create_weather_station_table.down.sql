@@ -0,0 +1 @@
+DROP TABLE IF EXISTS `weather_station`;
\ No newline at end of file
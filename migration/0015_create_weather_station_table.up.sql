@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS `weather_station` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `province` varchar(50) COLLATE utf8_bin NOT NULL DEFAULT '' COMMENT '省级名称',
+  `city` varchar(50) COLLATE utf8_bin NOT NULL DEFAULT '' COMMENT '市级名称',
+  `code` varchar(50) COLLATE utf8_bin NOT NULL DEFAULT '' COMMENT '气象站代码，如59287',
+  `manual` tinyint(1) NOT NULL DEFAULT '0' COMMENT '人工指定，1：刷新时不覆盖',
+  `create_time` timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+  `update_time` timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `province_city` (`province`,`city`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COLLATE=utf8_bin COMMENT='气象站代码';
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS `price_event`;
\ No newline at end of file
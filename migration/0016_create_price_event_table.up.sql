@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS `price_event` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `account` varchar(50) COLLATE utf8_bin NOT NULL DEFAULT '' COMMENT '工程ID',
+  `area` varchar(50) COLLATE utf8_bin NOT NULL DEFAULT '' COMMENT '区域、设备ID、支路名称或ID等',
+  `category` varchar(255) COLLATE utf8_bin NOT NULL DEFAULT '' COMMENT '用电类别',
+  `date` varchar(50) COLLATE utf8_bin NOT NULL DEFAULT '' COMMENT '触发日期',
+  `period` varchar(50) COLLATE utf8_bin NOT NULL DEFAULT '' COMMENT '触发时段，如sharp、peak',
+  `hour` varchar(255) COLLATE utf8_bin NOT NULL DEFAULT '' COMMENT '时段范围，如1100-1200,1500-1700',
+  `price` float NOT NULL DEFAULT '0' COMMENT '时段电价',
+  `rule` varchar(255) COLLATE utf8_bin NOT NULL DEFAULT '' COMMENT '气温触发条件，如temp:35,max@广州',
+  `provisional` tinyint(1) NOT NULL DEFAULT '1' COMMENT '是否依据预报(待实测确认)',
+  `create_time` timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+  `update_time` timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `account_area_date_period` (`account`,`area`,`date`,`period`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COLLATE=utf8_bin COMMENT='电价预警事件';
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS `dlgd_hour_review`;
\ No newline at end of file
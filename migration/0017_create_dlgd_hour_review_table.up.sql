@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS `dlgd_hour_review` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `area` varchar(50) COLLATE utf8_bin NOT NULL DEFAULT '' COMMENT '区域',
+  `doc_no` varchar(100) COLLATE utf8_bin NOT NULL DEFAULT '' COMMENT '电价政策文号',
+  `action` varchar(50) COLLATE utf8_bin NOT NULL DEFAULT '' COMMENT '审核动作：update、approve、reject',
+  `reviewer` varchar(50) COLLATE utf8_bin NOT NULL DEFAULT '' COMMENT '审核人',
+  `comment` varchar(500) COLLATE utf8_bin NOT NULL DEFAULT '' COMMENT '审核意见',
+  `base_doc_no` varchar(100) COLLATE utf8_bin NOT NULL DEFAULT '' COMMENT '对比的上一已确认政策文号',
+  `diff` text COLLATE utf8_bin NOT NULL COMMENT '与上一已确认时段划分的差异，逐行',
+  `create_time` timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+  `update_time` timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `area_doc_no` (`area`,`doc_no`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COLLATE=utf8_bin COMMENT='代理购电-时段划分审核记录';
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE `dlgd_hour` DROP COLUMN `stated`;
\ No newline at end of file
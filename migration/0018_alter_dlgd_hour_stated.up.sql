@@ -0,0 +1,2 @@
+ALTER TABLE `dlgd_hour`
+  ADD COLUMN `stated` float NOT NULL DEFAULT '0' COMMENT '政策所述每日时长(小时)，用于校验' AFTER `categories`;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS `ocr_usage`;
\ No newline at end of file
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS `ocr_usage` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `provider` varchar(50) COLLATE utf8_bin NOT NULL DEFAULT '' COMMENT '文档转换服务，如ali、pdf24、local',
+  `month` varchar(50) COLLATE utf8_bin NOT NULL DEFAULT '' COMMENT '统计月份，如2025-08',
+  `source` varchar(50) COLLATE utf8_bin NOT NULL DEFAULT '' COMMENT '任务类别，如dlgd、twdl',
+  `conversions` int(11) NOT NULL DEFAULT '0' COMMENT '转换次数',
+  `pages` int(11) NOT NULL DEFAULT '0' COMMENT '转换页数',
+  `cached_conversions` int(11) NOT NULL DEFAULT '0' COMMENT '缓存命中次数',
+  `cached_pages` int(11) NOT NULL DEFAULT '0' COMMENT '缓存命中页数(节省页数)',
+  `create_time` timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+  `update_time` timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `provider_month_source` (`provider`,`month`,`source`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COLLATE=utf8_bin COMMENT='OCR用量统计';
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS `artifact`;
\ No newline at end of file
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS `artifact` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `category` varchar(50) COLLATE utf8_bin NOT NULL DEFAULT '' COMMENT '任务类别，如dlgd、twdl',
+  `area` varchar(50) COLLATE utf8_bin NOT NULL DEFAULT '' COMMENT '区域',
+  `doc_no` varchar(100) COLLATE utf8_bin NOT NULL DEFAULT '' COMMENT '电价政策文号',
+  `url` varchar(500) COLLATE utf8_bin NOT NULL DEFAULT '' COMMENT '来源URL',
+  `fetched_at` timestamp NULL DEFAULT NULL COMMENT '抓取时间',
+  `files` text COLLATE utf8_bin NOT NULL COMMENT '归档文件，json格式：[{"kind":"source","hash":"<SHA256>","ext":".pdf"}]',
+  `task` text COLLATE utf8_bin NOT NULL COMMENT '任务详情，json格式，用于重新解析',
+  `message` varchar(500) COLLATE utf8_bin NOT NULL DEFAULT '' COMMENT '最近一次解析结果，成功为空',
+  `create_time` timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+  `update_time` timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `category_area` (`category`,`area`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COLLATE=utf8_bin COMMENT='电价文档归档';
\ No newline at end of file
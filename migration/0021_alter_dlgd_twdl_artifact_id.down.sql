@@ -0,0 +1,2 @@
+ALTER TABLE `dlgd` DROP COLUMN `artifact_id`;
+ALTER TABLE `twdl` DROP COLUMN `artifact_id`;
\ No newline at end of file
@@ -0,0 +1,4 @@
+ALTER TABLE `dlgd`
+  ADD COLUMN `artifact_id` int(11) NOT NULL DEFAULT '0' COMMENT '电价文档归档ID' AFTER `doc_no`;
+ALTER TABLE `twdl`
+  ADD COLUMN `artifact_id` int(11) NOT NULL DEFAULT '0' COMMENT '电价文档归档ID' AFTER `off_peak_cap`;
\ No newline at end of file
@@ -0,0 +1,27 @@
+package model
+
+import (
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ArtifactModel = (*customArtifactModel)(nil)
+
+type (
+	// ArtifactModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customArtifactModel.
+	ArtifactModel interface {
+		artifactModel
+	}
+
+	customArtifactModel struct {
+		*defaultArtifactModel
+	}
+)
+
+// NewArtifactModel returns a model for the database table.
+func NewArtifactModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ArtifactModel {
+	return &customArtifactModel{
+		defaultArtifactModel: newArtifactModel(conn, c, opts...),
+	}
+}
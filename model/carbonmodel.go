@@ -5,13 +5,14 @@ import (
 	"fmt"
 
 	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
 	"seeccloud.com/edscron/pkg/x/timex"
 )
 
 var (
-	_                            CarbonModel = (*customCarbonModel)(nil)
-	cacheEdsCronCarbonAreaPrefix             = "cache:edsCron:carbon:area:"
+	_                                              CarbonModel = (*customCarbonModel)(nil)
+	cacheEdsCronCarbonAreaCategoryNearlyYearPrefix             = "cache:edsCron:carbon:area:category:nearlyYear:"
 )
 
 type (
@@ -19,8 +20,7 @@ type (
 	// and implement the added methods in customCarbonModel.
 	CarbonModel interface {
 		carbonModel
-		FindOneByArea(ctx context.Context, area string) (*Carbon, error)
-		SaveCacheOnlyToday(ctx context.Context, area string, year int64, id int64) error
+		FindOneByAreaCategoryNearlyYear(ctx context.Context, area string, category string, year int64) (*Carbon, error)
 	}
 
 	customCarbonModel struct {
@@ -35,18 +35,27 @@ func NewCarbonModel(conn sqlx.SqlConn, c cache.CacheConf) CarbonModel {
 	}
 }
 
-func (m *customCarbonModel) FindOneByArea(ctx context.Context, area string) (*Carbon, error) {
-	key := fmt.Sprintf("%s%s", cacheEdsCronCarbonAreaPrefix, area)
+func (m *customCarbonModel) FindOneByAreaCategoryNearlyYear(ctx context.Context, area string, category string, year int64) (*Carbon, error) {
+	// 优先取不晚于指定年份的最新数据，指定年份早于首次发布时取最新数据
+	key := fmt.Sprintf("%s%s:%s:%d", cacheEdsCronCarbonAreaCategoryNearlyYearPrefix, area, category, year)
 	var c Carbon
-	err := m.QueryRowCtx(ctx, &c, key, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
-		query := fmt.Sprintf("select %s from %s where `area` = ? order by `year` desc limit 1", carbonRows, m.table)
-		return conn.QueryRowCtx(ctx, v, query, area)
-	})
-
-	return &c, err
-}
-
-func (m *customCarbonModel) SaveCacheOnlyToday(ctx context.Context, area string, year int64, id int64) error {
-	key := fmt.Sprintf("%s%s:%d", cacheEdsCronCarbonAreaYearPrefix, area, year)
-	return m.SetCacheWithExpireCtx(ctx, key, id, timex.SubTomorrow())
+	err := m.QueryRowIndexCtx(ctx, &c, key, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (any, error) {
+		query := fmt.Sprintf("select %s from %s where `area` = ? and `category` = ? order by `year` > ?, `year` desc limit 1", carbonRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &c, query, area, category, year); err != nil {
+			return nil, err
+		}
+
+		return c.Id, nil
+	}, m.queryPrimary)
+
+	switch err {
+	case nil:
+		// 新年份数据发布后需及时生效，仅缓存当天
+		m.SetCacheWithExpireCtx(ctx, key, c.Id, timex.SubTomorrow())
+		return &c, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
 }
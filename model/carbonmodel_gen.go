@@ -24,15 +24,15 @@ var (
 	carbonRowsExpectAutoSet   = strings.Join(stringx.Remove(carbonFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
 	carbonRowsWithPlaceHolder = strings.Join(stringx.Remove(carbonFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
 
-	cacheEdsCronCarbonIdPrefix       = "cache:edsCron:carbon:id:"
-	cacheEdsCronCarbonAreaYearPrefix = "cache:edsCron:carbon:area:year:"
+	cacheEdsCronCarbonIdPrefix               = "cache:edsCron:carbon:id:"
+	cacheEdsCronCarbonAreaCategoryYearPrefix = "cache:edsCron:carbon:area:category:year:"
 )
 
 type (
 	carbonModel interface {
 		Insert(ctx context.Context, data *Carbon) (sql.Result, error)
 		FindOne(ctx context.Context, id int64) (*Carbon, error)
-		FindOneByAreaYear(ctx context.Context, area string, year int64) (*Carbon, error)
+		FindOneByAreaCategoryYear(ctx context.Context, area string, category string, year int64) (*Carbon, error)
 		Update(ctx context.Context, data *Carbon) error
 		Delete(ctx context.Context, id int64) error
 	}
@@ -44,9 +44,11 @@ type (
 
 	Carbon struct {
 		Id         int64     `db:"id"`
-		Area       string    `db:"area"`  // 区域，如全国、华东、福建
-		Year       int64     `db:"year"`  // 年份
-		Value      float64   `db:"value"` // 碳排因子
+		Area       string    `db:"area"`     // 区域，如全国、华东、福建
+		Category   string    `db:"category"` // 因子类型：emission(二氧化碳排放因子)、footprint(碳足迹因子)、fossil(化石能源排放因子)、market(市场化调整排放因子)
+		Year       int64     `db:"year"`     // 年份
+		Value      float64   `db:"value"`    // 碳排因子
+		Source     string    `db:"source"`   // 来源文件，如生态环境部公告链接
 		CreateTime time.Time `db:"create_time"`
 		UpdateTime time.Time `db:"update_time"`
 	}
@@ -65,12 +67,12 @@ func (m *defaultCarbonModel) Delete(ctx context.Context, id int64) error {
 		return err
 	}
 
-	edsCronCarbonAreaYearKey := fmt.Sprintf("%s%v:%v", cacheEdsCronCarbonAreaYearPrefix, data.Area, data.Year)
+	edsCronCarbonAreaCategoryYearKey := fmt.Sprintf("%s%v:%v:%v", cacheEdsCronCarbonAreaCategoryYearPrefix, data.Area, data.Category, data.Year)
 	edsCronCarbonIdKey := fmt.Sprintf("%s%v", cacheEdsCronCarbonIdPrefix, id)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("delete from %s where `id` = ?", m.table)
 		return conn.ExecCtx(ctx, query, id)
-	}, edsCronCarbonAreaYearKey, edsCronCarbonIdKey)
+	}, edsCronCarbonAreaCategoryYearKey, edsCronCarbonIdKey)
 	return err
 }
 
@@ -91,12 +93,12 @@ func (m *defaultCarbonModel) FindOne(ctx context.Context, id int64) (*Carbon, er
 	}
 }
 
-func (m *defaultCarbonModel) FindOneByAreaYear(ctx context.Context, area string, year int64) (*Carbon, error) {
-	edsCronCarbonAreaYearKey := fmt.Sprintf("%s%v:%v", cacheEdsCronCarbonAreaYearPrefix, area, year)
+func (m *defaultCarbonModel) FindOneByAreaCategoryYear(ctx context.Context, area string, category string, year int64) (*Carbon, error) {
+	edsCronCarbonAreaCategoryYearKey := fmt.Sprintf("%s%v:%v:%v", cacheEdsCronCarbonAreaCategoryYearPrefix, area, category, year)
 	var resp Carbon
-	err := m.QueryRowIndexCtx(ctx, &resp, edsCronCarbonAreaYearKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
-		query := fmt.Sprintf("select %s from %s where `area` = ? and `year` = ? limit 1", carbonRows, m.table)
-		if err := conn.QueryRowCtx(ctx, &resp, query, area, year); err != nil {
+	err := m.QueryRowIndexCtx(ctx, &resp, edsCronCarbonAreaCategoryYearKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `area` = ? and `category` = ? and `year` = ? limit 1", carbonRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, area, category, year); err != nil {
 			return nil, err
 		}
 		return resp.Id, nil
@@ -112,12 +114,12 @@ func (m *defaultCarbonModel) FindOneByAreaYear(ctx context.Context, area string,
 }
 
 func (m *defaultCarbonModel) Insert(ctx context.Context, data *Carbon) (sql.Result, error) {
-	edsCronCarbonAreaYearKey := fmt.Sprintf("%s%v:%v", cacheEdsCronCarbonAreaYearPrefix, data.Area, data.Year)
+	edsCronCarbonAreaCategoryYearKey := fmt.Sprintf("%s%v:%v:%v", cacheEdsCronCarbonAreaCategoryYearPrefix, data.Area, data.Category, data.Year)
 	edsCronCarbonIdKey := fmt.Sprintf("%s%v", cacheEdsCronCarbonIdPrefix, data.Id)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?)", m.table, carbonRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.Area, data.Year, data.Value)
-	}, edsCronCarbonAreaYearKey, edsCronCarbonIdKey)
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?)", m.table, carbonRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.Area, data.Category, data.Year, data.Value, data.Source)
+	}, edsCronCarbonAreaCategoryYearKey, edsCronCarbonIdKey)
 	return ret, err
 }
 
@@ -127,12 +129,12 @@ func (m *defaultCarbonModel) Update(ctx context.Context, newData *Carbon) error
 		return err
 	}
 
-	edsCronCarbonAreaYearKey := fmt.Sprintf("%s%v:%v", cacheEdsCronCarbonAreaYearPrefix, data.Area, data.Year)
+	edsCronCarbonAreaCategoryYearKey := fmt.Sprintf("%s%v:%v:%v", cacheEdsCronCarbonAreaCategoryYearPrefix, data.Area, data.Category, data.Year)
 	edsCronCarbonIdKey := fmt.Sprintf("%s%v", cacheEdsCronCarbonIdPrefix, data.Id)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, carbonRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, newData.Area, newData.Year, newData.Value, newData.Id)
-	}, edsCronCarbonAreaYearKey, edsCronCarbonIdKey)
+		return conn.ExecCtx(ctx, query, newData.Area, newData.Category, newData.Year, newData.Value, newData.Source, newData.Id)
+	}, edsCronCarbonAreaCategoryYearKey, edsCronCarbonIdKey)
 	return err
 }
 
@@ -29,6 +29,8 @@ var (
 	CategoryDlgd     CronCategory = "dlgd"      // 代理购电
 	CategoryHoliday  CronCategory = "holiday"   // 节假日
 	CategoryWeather  CronCategory = "weather"   // 天气
+	CategoryObserved CronCategory = "observed"  // 实测天气
+	CategoryStation  CronCategory = "station"   // 气象站代码
 	CategoryCarbon   CronCategory = "carbon"    // 大陆碳排因子
 	CategoryTwCarbon CronCategory = "tw-carbon" // 台湾碳排因子
 	CategoryTwdl     CronCategory = "twdl"      // 台湾电价
@@ -183,6 +185,24 @@ func NewCron(category CronCategory, address Address) Cron {
 		cron.DeltaTime = "0,0,1,0,0,0"
 		task, _ := json.Marshal(address)
 		cron.Task = string(task)
+	case CategoryObserved:
+		// 每日23:10后执行，每10分钟一次，逐时实况覆盖当日绝大部分时段
+		m = 10 + rand.Intn(10)
+		cron.Scheduler = fmt.Sprintf("%d %d-59/10 23 * * *", s, m)
+		// 执行成功后，明天再执行
+		cron.DeltaTime = "0,0,1,0,0,0"
+		// 不设时间参数，统计日期缺省为执行当日
+		task, _ := json.Marshal(cronx.WeatherObservedConfig{
+			Province: address.Province,
+			City:     address.City,
+		})
+		cron.Task = string(task)
+	case CategoryStation:
+		// 每月1号任意时间执行
+		cron.Scheduler = fmt.Sprintf("%d %d %d 1 * *", s, m, h)
+		// 固定且低频，每月固定执行
+		cron.DeltaTime = "0,0,0,0,0,0"
+		cron.Task = ""
 	case CategoryCarbon:
 		// 每月1/11/21号任意时间执行
 		cron.Scheduler = fmt.Sprintf("%d %d %d 1-21/10 * *", s, m, h)
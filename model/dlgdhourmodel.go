@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
 	"seeccloud.com/edscron/pkg/copierx"
 	"seeccloud.com/edscron/pkg/cronx"
@@ -14,6 +15,7 @@ import (
 
 var (
 	DlgdHourConfirmCode                 int64         = 1
+	DlgdHourRejectCode                  int64         = -1
 	_                                   DlgdHourModel = (*customDlgdHourModel)(nil)
 	cacheEdsCronDlgdHourAreaDocNoPrefix               = "cache:edsCron:dlgdHour:area:docNo:"
 )
@@ -23,10 +25,13 @@ type (
 	// and implement the added methods in customDlgdHourModel.
 	DlgdHourModel interface {
 		dlgdHourModel
-		InsertAll(ctx context.Context, area string, comment string) error
+		InsertAll(ctx context.Context, area string, comment string) ([]cronx.DlgdHour, cronx.DlgdHourDiagnostics, error)
 		MustInertAll(ctx context.Context, hours *[]DlgdHour) error
 		ConfirmAll(ctx context.Context, area string, docNo string) error
+		RejectAll(ctx context.Context, area string, docNo string) error
+		FindLastConfirmedDocNo(ctx context.Context, area string, excludeDocNo string) (string, error)
 		QueryAll(ctx context.Context, area string, docNo string) (*[]DlgdHour, error)
+		CountByAreaDocNo(ctx context.Context, area string, docNo string) (int64, error)
 	}
 
 	customDlgdHourModel struct {
@@ -51,17 +56,51 @@ func (m *customDlgdHourModel) ConfirmAll(ctx context.Context, area string, docNo
 	return err
 }
 
-func (m *customDlgdHourModel) InsertAll(ctx context.Context, area string, comment string) error {
-	hours := cronx.NewDlgdHours(area, comment)
+func (m *customDlgdHourModel) RejectAll(ctx context.Context, area string, docNo string) error {
+	key := fmt.Sprintf("%s%s:%s", cacheEdsCronDlgdHourAreaDocNoPrefix, area, docNo)
+	m.DelCacheCtx(ctx, key)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf(`update %s set confirm = %d where area = ? and doc_no = ?`, m.table, DlgdHourRejectCode)
+		return conn.ExecCtx(ctx, query, area, docNo)
+	})
+	return err
+}
+
+// CountByAreaDocNo 统计区域下政策文号的时段数，不走缓存
+func (m *customDlgdHourModel) CountByAreaDocNo(ctx context.Context, area string, docNo string) (int64, error) {
+	var size int64
+	query := fmt.Sprintf(`select count(*) from %s where area = ? and doc_no = ?`, m.table)
+	err := m.QueryRowNoCacheCtx(ctx, &size, query, area, docNo)
+	return size, err
+}
+
+// FindLastConfirmedDocNo 查询区域最近确认的政策文号(排除指定文号)
+func (m *customDlgdHourModel) FindLastConfirmedDocNo(ctx context.Context, area string, excludeDocNo string) (string, error) {
+	var docNo string
+	query := fmt.Sprintf(`select doc_no from %s where area = ? and doc_no <> ? and confirm = %d order by id desc limit 1`, m.table, DlgdHourConfirmCode)
+	err := m.QueryRowNoCacheCtx(ctx, &docNo, query, area, excludeDocNo)
+	switch err {
+	case nil:
+		return docNo, nil
+	case sqlc.ErrNotFound:
+		return "", ErrNotFound
+	default:
+		return "", err
+	}
+}
+
+// InsertAll 解析并保存时段划分，返回解析结果及诊断
+func (m *customDlgdHourModel) InsertAll(ctx context.Context, area string, comment string) ([]cronx.DlgdHour, cronx.DlgdHourDiagnostics, error) {
+	hours, diag := cronx.ParseDlgdHours(area, comment)
 	for _, hour := range hours {
 		data := DlgdHour{}
 		copierx.MustCopy(&data, hour)
 		if _, err := m.Insert(ctx, &data); err != nil {
-			return err
+			return nil, diag, err
 		}
 	}
 
-	return nil
+	return hours, diag, nil
 }
 
 func (m *customDlgdHourModel) QueryAll(ctx context.Context, area string, docNo string) (*[]DlgdHour, error) {
@@ -40,17 +40,18 @@ type (
 	}
 
 	DlgdHour struct {
-		Id            int64  `db:"id"`
-		Area          string `db:"area"`           // 区域
-		DocNo         string `db:"doc_no"`         // 电价政策文号
-		Confirm       int64  `db:"confirm"`        // 确认有效，默认0：未确认
-		Name          string `db:"name"`           // 时段名称
-		Value         string `db:"value"`          // 时段值
-		Temp          string `db:"temp"`           // 温度条件，如：其他月份中广州日最高气温达到35℃
-		Months        string `db:"months"`         // 月份条件
-		WeekendMonths string `db:"weekend_months"` // 月份-休息日条件
-		Holidays      string `db:"holidays"`       // 节假日条件，如：春节,劳动节,国庆节
-		Categories    string `db:"categories"`     // 用电类别条件，如：容量315千伏安及以上,两部制
+		Id            int64   `db:"id"`
+		Area          string  `db:"area"`           // 区域
+		DocNo         string  `db:"doc_no"`         // 电价政策文号
+		Confirm       int64   `db:"confirm"`        // 确认有效，默认0：未确认
+		Name          string  `db:"name"`           // 时段名称
+		Value         string  `db:"value"`          // 时段值
+		Temp          string  `db:"temp"`           // 温度条件，如：其他月份中广州日最高气温达到35℃
+		Months        string  `db:"months"`         // 月份条件
+		WeekendMonths string  `db:"weekend_months"` // 月份-休息日条件
+		Holidays      string  `db:"holidays"`       // 节假日条件，如：春节,劳动节,国庆节
+		Categories    string  `db:"categories"`     // 用电类别条件，如：容量315千伏安及以上,两部制
+		Stated        float64 `db:"stated"`         // 政策所述每日时长(小时)，用于校验
 	}
 )
 
@@ -90,8 +91,8 @@ func (m *defaultDlgdHourModel) FindOne(ctx context.Context, id int64) (*DlgdHour
 func (m *defaultDlgdHourModel) Insert(ctx context.Context, data *DlgdHour) (sql.Result, error) {
 	edsCronDlgdHourIdKey := fmt.Sprintf("%s%v", cacheEdsCronDlgdHourIdPrefix, data.Id)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, dlgdHourRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.Area, data.DocNo, data.Confirm, data.Name, data.Value, data.Temp, data.Months, data.WeekendMonths, data.Holidays, data.Categories)
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, dlgdHourRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.Area, data.DocNo, data.Confirm, data.Name, data.Value, data.Temp, data.Months, data.WeekendMonths, data.Holidays, data.Categories, data.Stated)
 	}, edsCronDlgdHourIdKey)
 	return ret, err
 }
@@ -100,7 +101,7 @@ func (m *defaultDlgdHourModel) Update(ctx context.Context, data *DlgdHour) error
 	edsCronDlgdHourIdKey := fmt.Sprintf("%s%v", cacheEdsCronDlgdHourIdPrefix, data.Id)
 	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, dlgdHourRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, data.Area, data.DocNo, data.Confirm, data.Name, data.Value, data.Temp, data.Months, data.WeekendMonths, data.Holidays, data.Categories, data.Id)
+		return conn.ExecCtx(ctx, query, data.Area, data.DocNo, data.Confirm, data.Name, data.Value, data.Temp, data.Months, data.WeekendMonths, data.Holidays, data.Categories, data.Stated, data.Id)
 	}, edsCronDlgdHourIdKey)
 	return err
 }
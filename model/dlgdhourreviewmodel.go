@@ -0,0 +1,48 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ DlgdHourReviewModel = (*customDlgdHourReviewModel)(nil)
+
+const (
+	DlgdHourReviewUpdate  = "update"  // 修改时段
+	DlgdHourReviewApprove = "approve" // 确认时段
+	DlgdHourReviewReject  = "reject"  // 驳回时段
+)
+
+type (
+	// DlgdHourReviewModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customDlgdHourReviewModel.
+	DlgdHourReviewModel interface {
+		dlgdHourReviewModel
+		FindAllByAreaDocNo(ctx context.Context, area string, docNo string) (*[]DlgdHourReview, error)
+	}
+
+	customDlgdHourReviewModel struct {
+		*defaultDlgdHourReviewModel
+	}
+)
+
+// NewDlgdHourReviewModel returns a model for the database table.
+func NewDlgdHourReviewModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) DlgdHourReviewModel {
+	return &customDlgdHourReviewModel{
+		defaultDlgdHourReviewModel: newDlgdHourReviewModel(conn, c, opts...),
+	}
+}
+
+func (m *customDlgdHourReviewModel) FindAllByAreaDocNo(ctx context.Context, area string, docNo string) (*[]DlgdHourReview, error) {
+	reviews := make([]DlgdHourReview, 0)
+	query := fmt.Sprintf("select %s from %s where `area` = ? and `doc_no` = ? order by `id` desc", dlgdHourReviewRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &reviews, query, area, docNo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reviews, nil
+}
@@ -3,7 +3,6 @@ package model
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/zeromicro/go-zero/core/stores/cache"
@@ -31,6 +30,8 @@ type (
 		FindCategoriesByAreas(ctx context.Context, areas ...string) (*[]string, error)
 		FindFirstByAreaStartTimeCategoryVoltage(ctx context.Context, area string, startTime string, category string, voltage string) (*Dlgd, error)
 		FindOneByAreaCategoryVoltageAtNearlyStartTime(ctx context.Context, area string, startTime string, category string, voltage string) (*Dlgd, error)
+		FindAllLatestBefore(ctx context.Context, area string, startTime time.Time) (*[]Dlgd, error)
+		FindAllWithDates(ctx context.Context) (*[]Dlgd, error)
 	}
 
 	customDlgdModel struct {
@@ -72,6 +73,17 @@ func (m *customDlgdModel) FindCategoriesByAreas(ctx context.Context, areas ...st
 	return nil, nil
 }
 
+// FindAllWithDates 查询含日期条件的全部电价，用于校验存量数据
+func (m *customDlgdModel) FindAllWithDates(ctx context.Context) (*[]Dlgd, error) {
+	var all []Dlgd
+	query := fmt.Sprintf("select %s from %s where `sharp_date` <> '' or `peak_date` <> '' or `flat_date` <> '' or `valley_date` <> '' or `deep_date` <> ''", dlgdRows, m.table)
+	if err := m.QueryRowsNoCacheCtx(ctx, &all, query); err != nil {
+		return nil, err
+	}
+
+	return &all, nil
+}
+
 func (m *customDlgdModel) FindFirstByAreaStartTimeCategoryVoltage(ctx context.Context, area string, startTime string, category string, voltage string) (*Dlgd, error) {
 	key := fmt.Sprintf("%s%v:%v:%v:%v", cacheEdsCronDlgdAreaStartTimeCategoryVoltagePrefix, area, startTime, category, voltage)
 	var one Dlgd
@@ -130,28 +142,29 @@ func (m *customDlgdModel) FindOneByAreaCategoryVoltageAtNearlyStartTime(ctx cont
 	}
 }
 
-func (d *Dlgd) GetPrice(t time.Time, holiday cronx.HolidayCategory, isWeatherActived bool) cronx.Period {
+// GetPrice 获取指定时间所属时段及电价，actived判断时段日期条件是否生效
+func (d *Dlgd) GetPrice(t time.Time, actived func(dateCondition string) bool) cronx.Period {
 	var period cronx.Period
 	// 深谷、尖段优先级高于谷段和峰段
-	if isDateActived(t, d.DeepDate, holiday, isWeatherActived) && timex.IsHourInRange(t, d.DeepHour) {
+	if actived(d.DeepDate) && timex.IsHourInRange(t, d.DeepHour) {
 		period = cronx.PeriodDeep
 		period.Price = d.Deep
 		return period
 	}
 
-	if isDateActived(t, d.SharpDate, holiday, isWeatherActived) && timex.IsHourInRange(t, d.SharpHour) {
+	if actived(d.SharpDate) && timex.IsHourInRange(t, d.SharpHour) {
 		period = cronx.PeriodSharp
 		period.Price = d.Sharp
 		return period
 	}
 
-	if isDateActived(t, d.ValleyDate, holiday, isWeatherActived) && timex.IsHourInRange(t, d.ValleyHour) {
+	if actived(d.ValleyDate) && timex.IsHourInRange(t, d.ValleyHour) {
 		period = cronx.PeriodValley
 		period.Price = d.Valley
 		return period
 	}
 
-	if isDateActived(t, d.PeakDate, holiday, isWeatherActived) && timex.IsHourInRange(t, d.PeakHour) {
+	if actived(d.PeakDate) && timex.IsHourInRange(t, d.PeakHour) {
 		period = cronx.PeriodPeak
 		period.Price = d.Peak
 		return period
@@ -162,39 +175,24 @@ func (d *Dlgd) GetPrice(t time.Time, holiday cronx.HolidayCategory, isWeatherAct
 	return period
 }
 
-func isDateActived(t time.Time, dateCondition string, holiday cronx.HolidayCategory, isWeatherActived bool) bool {
-	// 空值表示不受限，总是生效
-	if len(dateCondition) == 0 {
-		return true
-	}
-
-	// 检查假期条件
-	if strings.Contains(dateCondition, "holiday") && holiday == cronx.HolidayOff {
-		return true
-	}
-
-	// 检查周末条件(需排除调休工作日)
-	if strings.Contains(dateCondition, "weekend") {
-		isWeekend := t.Weekday() == time.Saturday || t.Weekday() == time.Sunday
-		if isWeekend && holiday != cronx.HolidayOn {
-			return true
+// ValidateDates 校验各时段日期条件格式
+func (d *Dlgd) ValidateDates() error {
+	for _, date := range []string{d.SharpDate, d.PeakDate, d.FlatDate, d.ValleyDate, d.DeepDate} {
+		if _, err := cronx.ParseDateCondition(date); err != nil {
+			return fmt.Errorf("%s>%s>%s: %v", d.Area, d.Category, d.Voltage, err)
 		}
 	}
 
-	// 检查周六条件
-	if strings.Contains(dateCondition, "sat") && t.Weekday() == time.Saturday {
-		return true
-	}
-
-	// 检查周日条件
-	if strings.Contains(dateCondition, "sun") && t.Weekday() == time.Sunday {
-		return true
-	}
+	return nil
+}
 
-	// 检查天气条件(温度), 格式："weather:广州>=35"
-	if strings.Contains(dateCondition, "temp") && isWeatherActived {
-		return true
+// FindAllLatestBefore 查询startTime之前最近一个月的全部电价，用于入库校验
+func (m *customDlgdModel) FindAllLatestBefore(ctx context.Context, area string, startTime time.Time) (*[]Dlgd, error) {
+	query := fmt.Sprintf("select %s from %s where `area` = ? and `start_time` = (select max(`start_time`) from %s where `area` = ? and `start_time` < ?)", dlgdRows, m.table, m.table)
+	var all []Dlgd
+	if err := m.QueryRowsNoCacheCtx(ctx, &all, query, area, area, startTime); err != nil {
+		return nil, err
 	}
 
-	return false
+	return &all, nil
 }
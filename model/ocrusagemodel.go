@@ -0,0 +1,67 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ OcrUsageModel = (*customOcrUsageModel)(nil)
+
+type (
+	// OcrUsageModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customOcrUsageModel.
+	OcrUsageModel interface {
+		ocrUsageModel
+		Increase(ctx context.Context, provider string, month string, source string, pages int64, cached bool) error
+		FindAllByMonthRange(ctx context.Context, provider string, startMonth string, endMonth string) (*[]OcrUsage, error)
+	}
+
+	customOcrUsageModel struct {
+		*defaultOcrUsageModel
+	}
+)
+
+// NewOcrUsageModel returns a model for the database table.
+func NewOcrUsageModel(conn sqlx.SqlConn, c cache.CacheConf) OcrUsageModel {
+	return &customOcrUsageModel{
+		defaultOcrUsageModel: newOcrUsageModel(conn, c),
+	}
+}
+
+// Increase 累加一次转换用量，cached为命中缓存(未实际调用转换服务)
+// 单条语句插入或累加，并发转换时不丢失计数
+func (m *customOcrUsageModel) Increase(ctx context.Context, provider string, month string, source string, pages int64, cached bool) error {
+	conversions, cachedConversions, cachedPages := int64(1), int64(0), int64(0)
+	if cached {
+		conversions, cachedConversions = 0, 1
+		pages, cachedPages = 0, pages
+	}
+
+	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?) on duplicate key update `id` = last_insert_id(`id`), `conversions` = `conversions` + ?, `pages` = `pages` + ?, `cached_conversions` = `cached_conversions` + ?, `cached_pages` = `cached_pages` + ?", m.table, ocrUsageRowsExpectAutoSet)
+	ret, err := m.ExecNoCacheCtx(ctx, query, provider, month, source, conversions, pages, cachedConversions, cachedPages, conversions, pages, cachedConversions, cachedPages)
+	if err != nil {
+		return err
+	}
+
+	// 行缓存按id，累加后失效
+	keys := []string{fmt.Sprintf("%s%v:%v:%v", cacheEdsCronOcrUsageProviderMonthSourcePrefix, provider, month, source)}
+	if id, err := ret.LastInsertId(); err == nil {
+		keys = append(keys, fmt.Sprintf("%s%v", cacheEdsCronOcrUsageIdPrefix, id))
+	}
+	return m.DelCacheCtx(ctx, keys...)
+}
+
+// FindAllByMonthRange 查询月份区间(含)的用量，provider为空时不限转换服务
+func (m *customOcrUsageModel) FindAllByMonthRange(ctx context.Context, provider string, startMonth string, endMonth string) (*[]OcrUsage, error) {
+	var usages []OcrUsage
+	query := fmt.Sprintf("select %s from %s where `month` >= ? and `month` <= ? and (? = '' or `provider` = ?) order by `month`, `provider`, `source`", ocrUsageRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &usages, query, startMonth, endMonth, provider, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	return &usages, nil
+}
@@ -0,0 +1,57 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ PriceEventModel = (*customPriceEventModel)(nil)
+
+type (
+	// PriceEventModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customPriceEventModel.
+	PriceEventModel interface {
+		priceEventModel
+		FindAllByAccountNearlyAreaFromDate(ctx context.Context, account string, area string, date string) (*[]PriceEvent, error)
+	}
+
+	customPriceEventModel struct {
+		*defaultPriceEventModel
+	}
+)
+
+// NewPriceEventModel returns a model for the database table.
+func NewPriceEventModel(conn sqlx.SqlConn, c cache.CacheConf) PriceEventModel {
+	return &customPriceEventModel{
+		defaultPriceEventModel: newPriceEventModel(conn, c),
+	}
+}
+
+// FindAllByAccountNearlyAreaFromDate 查询指定日期(含)之后的预警事件，area模糊匹配
+func (m *customPriceEventModel) FindAllByAccountNearlyAreaFromDate(ctx context.Context, account string, area string, date string) (*[]PriceEvent, error) {
+	var events []PriceEvent
+	query := fmt.Sprintf("select %s from %s where `account` = ? and `area` like ? and `date` >= ? order by `date`, `area`", priceEventRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &events, query, account, "%"+area+"%", date)
+	if err != nil {
+		return nil, err
+	}
+
+	return &events, nil
+}
+
+// FormatHtmlPriceEvents 格式化预警事件为邮件HTML
+func FormatHtmlPriceEvents(events []PriceEvent) string {
+	var builder strings.Builder
+	builder.WriteString("<ul>")
+	for _, e := range events {
+		builder.WriteString(fmt.Sprintf("<li><p><em>%s</em> %s : %s</p>", e.Account, e.Area, e.Category))
+		builder.WriteString(fmt.Sprintf("<p><em>%s</em> : %s (%.4f元/kWh)</p>", e.Period, e.Hour, e.Price))
+		builder.WriteString(fmt.Sprintf("<p><em>触发条件</em> : %s</p></li>", e.Rule))
+	}
+	builder.WriteString("</ul>")
+	return builder.String()
+}
@@ -70,6 +70,7 @@ type (
 		SemiPeakCap         float64   `db:"semi_peak_cap"`          // 半尖峰契约, 每千瓦每月
 		SatSemiPeakCap      float64   `db:"sat_semi_peak_cap"`      // 周六半尖峰契约, 每千瓦每月
 		OffPeakCap          float64   `db:"off_peak_cap"`           // 离峰契约, 每千瓦每月
+		ArtifactId          int64     `db:"artifact_id"`            // 电价文档归档ID
 		CreateTime          time.Time `db:"create_time"`
 		UpdateTime          time.Time `db:"update_time"`
 	}
@@ -138,8 +139,8 @@ func (m *defaultTwdlModel) Insert(ctx context.Context, data *Twdl) (sql.Result,
 	edsCronTwdlIdKey := fmt.Sprintf("%s%v", cacheEdsCronTwdlIdPrefix, data.Id)
 	edsCronTwdlStartTimeCategoryDateKey := fmt.Sprintf("%s%v:%v:%v", cacheEdsCronTwdlStartTimeCategoryDatePrefix, data.StartTime, data.Category, data.Date)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, twdlRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.StartTime, data.Category, data.Date, data.Stage, data.Standard, data.WeekdayPeak, data.WeekdaySemiPeak, data.WeekdayOffPeak, data.SatSemiPeak, data.SatOffPeak, data.SunOffPeak, data.WeekdayPeakHour, data.WeekdaySemiPeakHour, data.WeekdayOffPeakHour, data.SatSemiPeakHour, data.SatOffPeakHour, data.SunOffPeakHour, data.InstalledCustomer, data.InstalledCustomer1P, data.RegularCustomer, data.InstalledCap, data.RegularCap, data.NonSummerCap, data.SemiPeakCap, data.SatSemiPeakCap, data.OffPeakCap)
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, twdlRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.StartTime, data.Category, data.Date, data.Stage, data.Standard, data.WeekdayPeak, data.WeekdaySemiPeak, data.WeekdayOffPeak, data.SatSemiPeak, data.SatOffPeak, data.SunOffPeak, data.WeekdayPeakHour, data.WeekdaySemiPeakHour, data.WeekdayOffPeakHour, data.SatSemiPeakHour, data.SatOffPeakHour, data.SunOffPeakHour, data.InstalledCustomer, data.InstalledCustomer1P, data.RegularCustomer, data.InstalledCap, data.RegularCap, data.NonSummerCap, data.SemiPeakCap, data.SatSemiPeakCap, data.OffPeakCap, data.ArtifactId)
 	}, edsCronTwdlIdKey, edsCronTwdlStartTimeCategoryDateKey)
 	return ret, err
 }
@@ -154,7 +155,7 @@ func (m *defaultTwdlModel) Update(ctx context.Context, newData *Twdl) error {
 	edsCronTwdlStartTimeCategoryDateKey := fmt.Sprintf("%s%v:%v:%v", cacheEdsCronTwdlStartTimeCategoryDatePrefix, data.StartTime, data.Category, data.Date)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, twdlRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, newData.StartTime, newData.Category, newData.Date, newData.Stage, newData.Standard, newData.WeekdayPeak, newData.WeekdaySemiPeak, newData.WeekdayOffPeak, newData.SatSemiPeak, newData.SatOffPeak, newData.SunOffPeak, newData.WeekdayPeakHour, newData.WeekdaySemiPeakHour, newData.WeekdayOffPeakHour, newData.SatSemiPeakHour, newData.SatOffPeakHour, newData.SunOffPeakHour, newData.InstalledCustomer, newData.InstalledCustomer1P, newData.RegularCustomer, newData.InstalledCap, newData.RegularCap, newData.NonSummerCap, newData.SemiPeakCap, newData.SatSemiPeakCap, newData.OffPeakCap, newData.Id)
+		return conn.ExecCtx(ctx, query, newData.StartTime, newData.Category, newData.Date, newData.Stage, newData.Standard, newData.WeekdayPeak, newData.WeekdaySemiPeak, newData.WeekdayOffPeak, newData.SatSemiPeak, newData.SatOffPeak, newData.SunOffPeak, newData.WeekdayPeakHour, newData.WeekdaySemiPeakHour, newData.WeekdayOffPeakHour, newData.SatSemiPeakHour, newData.SatOffPeakHour, newData.SunOffPeakHour, newData.InstalledCustomer, newData.InstalledCustomer1P, newData.RegularCustomer, newData.InstalledCap, newData.RegularCap, newData.NonSummerCap, newData.SemiPeakCap, newData.SatSemiPeakCap, newData.OffPeakCap, newData.ArtifactId, newData.Id)
 	}, edsCronTwdlIdKey, edsCronTwdlStartTimeCategoryDateKey)
 	return err
 }
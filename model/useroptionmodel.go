@@ -21,6 +21,7 @@ type (
 	UserOptionModel interface {
 		userOptionModel
 		FindOneByAccountNearlyArea(ctx context.Context, account string, area string) (*UserOption, error)
+		FindAllByProvince(ctx context.Context, province string) (*[]UserOption, error)
 	}
 
 	customUserOptionModel struct {
@@ -55,3 +56,15 @@ func (m *customUserOptionModel) FindOneByAccountNearlyArea(ctx context.Context,
 		return nil, err
 	}
 }
+
+// FindAllByProvince 查询用电类别属于指定省份的所有用电档案
+func (m *customUserOptionModel) FindAllByProvince(ctx context.Context, province string) (*[]UserOption, error) {
+	var options []UserOption
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE `category` LIKE ?", userOptionRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &options, query, province+">%")
+	if err != nil {
+		return nil, err
+	}
+
+	return &options, nil
+}
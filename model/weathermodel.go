@@ -23,7 +23,7 @@ type (
 	WeatherModel interface {
 		weatherModel
 		FindAllByDateCity(ctx context.Context, date string, city string, size int64) (*[]Weather, error)
-		FindHiTempSize(ctx context.Context, date string, city string, temp float64) (int64, error)
+		FindHiTempSize(ctx context.Context, date string, city string, temp float64, count func() (int64, error)) (int64, error)
 	}
 
 	customWeatherModel struct {
@@ -68,7 +68,9 @@ func (m *customWeatherModel) FindAllByDateCity(ctx context.Context, date string,
 	return &weas, nil
 }
 
-func (m *customWeatherModel) FindHiTempSize(ctx context.Context, date string, city string, temp float64) (int64, error) {
+// FindHiTempSize 获取截至date连续高温天数，缓存至次日零时；未缓存时调用count计算(综合预报及实测)
+// city为城市，多城市聚合时为聚合方式加城市列表
+func (m *customWeatherModel) FindHiTempSize(ctx context.Context, date string, city string, temp float64, count func() (int64, error)) (int64, error) {
 	key := fmt.Sprintf(cacheEdsCronWeatherCityDateHiTempSizePrefix+"%s:%s:%f", city, date, temp)
 	var size int64
 	err := m.GetCacheCtx(ctx, key, &size)
@@ -76,15 +78,9 @@ func (m *customWeatherModel) FindHiTempSize(ctx context.Context, date string, ci
 		return size, nil
 	}
 
-	day := timex.MustTime(date)
-	for {
-		wea, err := m.FindOneByDateCity(ctx, day.Format(vars.DateFormat), city)
-		if err != nil || wea == nil || (*wea).DayTemp < temp {
-			break
-		}
-
-		size++
-		day = day.AddDate(0, 0, -1)
+	size, err = count()
+	if err != nil {
+		return 0, err
 	}
 
 	err = m.SetCacheWithExpireCtx(ctx, key, &size, timex.SubTomorrow())
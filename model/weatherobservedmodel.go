@@ -0,0 +1,47 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"seeccloud.com/edscron/pkg/vars"
+	"seeccloud.com/edscron/pkg/x/timex"
+)
+
+var _ WeatherObservedModel = (*customWeatherObservedModel)(nil)
+
+type (
+	// WeatherObservedModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customWeatherObservedModel.
+	WeatherObservedModel interface {
+		weatherObservedModel
+		FindAllByDateCity(ctx context.Context, date string, city string, size int64) (*[]WeatherObserved, error)
+	}
+
+	customWeatherObservedModel struct {
+		*defaultWeatherObservedModel
+	}
+)
+
+// NewWeatherObservedModel returns a model for the database table.
+func NewWeatherObservedModel(conn sqlx.SqlConn, c cache.CacheConf) WeatherObservedModel {
+	return &customWeatherObservedModel{
+		defaultWeatherObservedModel: newWeatherObservedModel(conn, c),
+	}
+}
+
+func (m *customWeatherObservedModel) FindAllByDateCity(ctx context.Context, date string, city string, size int64) (*[]WeatherObserved, error) {
+	start := timex.MustTime(date)
+	end := start.AddDate(0, 0, int(size-1))
+
+	var obs []WeatherObserved
+	query := fmt.Sprintf("select %s from %s where `city` = ? and `date` between ? and ?", weatherObservedRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &obs, query, city, start.Format(vars.DateFormat), end.Format(vars.DateFormat))
+	if err != nil {
+		return nil, err
+	}
+
+	return &obs, nil
+}
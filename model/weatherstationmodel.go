@@ -0,0 +1,48 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ WeatherStationModel = (*customWeatherStationModel)(nil)
+
+type (
+	// WeatherStationModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customWeatherStationModel.
+	WeatherStationModel interface {
+		weatherStationModel
+		FindAll(ctx context.Context) (*[]WeatherStation, error)
+	}
+
+	customWeatherStationModel struct {
+		*defaultWeatherStationModel
+	}
+)
+
+// NewWeatherStationModel returns a model for the database table.
+func NewWeatherStationModel(conn sqlx.SqlConn, c cache.CacheConf) WeatherStationModel {
+	return &customWeatherStationModel{
+		defaultWeatherStationModel: newWeatherStationModel(conn, c),
+	}
+}
+
+// FindAll 获取全部气象站，人工指定优先
+func (m *customWeatherStationModel) FindAll(ctx context.Context) (*[]WeatherStation, error) {
+	stations := make([]WeatherStation, 0)
+	query := fmt.Sprintf("select %s from %s order by `manual` desc, `id`", weatherStationRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &stations, query)
+
+	switch err {
+	case nil:
+		return &stations, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
@@ -2,6 +2,7 @@ package chromedpx
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"html"
 	"os"
@@ -17,10 +18,10 @@ import (
 	"github.com/chromedp/chromedp"
 )
 
-// DPUrl 定义网页URL和点击操作配置
+// DPUrl 定义网页URL和操作配置
 type DPUrl struct {
 	Url    string   `json:"url" yaml:"url"`       // 目标网页URL
-	Clicks []string `json:"clicks" yaml:"clicks"` // 点击目标列表，可以是innerText、id、class或style
+	Clicks []DPStep `json:"clicks" yaml:"clicks"` // 操作列表，字符串为点击目标，可以是innerText、id、class或style；对象见DPStep
 	// 以*开头的点击项为可选操作(如弹窗确认按钮)
 }
 
@@ -30,6 +31,7 @@ type DPOuter struct {
 	Selector string `json:"selector" yaml:"selector"`   // 结果选择器，空表示新标签页URL
 	Pattern  string `json:"pattern" yaml:"pattern"`     // 结果匹配正则表达式
 	Host     string `json:"host" yaml:"host"`           // 域名补全，用于子域名结果
+	Table    bool   `json:"table" yaml:"table"`         // 表格提取：结果为DPTable的JSON，选择器应包含表格及其标题、表注
 }
 
 // DP 定义完整的浏览器自动化配置
@@ -80,15 +82,17 @@ func (dp *DP) Run(ctx context.Context, content *string) error {
 		}
 	}
 
-	// 执行每个URL的操作流程
+	// 执行每个URL的操作流程，操作中可切换标签页或iframe
+	s := newDPSession(cctx)
+	defer s.close()
 	for i, u := range dp.Urls {
-		if err := dp.processURL(cctx, u, i); err != nil {
+		if err := dp.processURL(s, u, i); err != nil {
 			return fmt.Errorf("处理URL[%s]失败: %w", u.Url, err)
 		}
 	}
 
 	// 提取最终结果
-	*content = html.UnescapeString(useOuter(cctx, dp.Outer))
+	*content = html.UnescapeString(useOuter(s.ctx, dp.Outer))
 	if *content == "" {
 		return fmt.Errorf("未获取到有效结果，配置: %+v", *dp)
 	}
@@ -97,7 +101,10 @@ func (dp *DP) Run(ctx context.Context, content *string) error {
 }
 
 // processURL 处理单个URL的操作流程
-func (dp *DP) processURL(ctx context.Context, u DPUrl, urlIndex int) error {
+func (dp *DP) processURL(s *dpSession, u DPUrl, urlIndex int) error {
+	// 上一URL切换至iframe时，在其所在标签页导航，而非iframe内
+	s.leaveFrame()
+
 	// 导航到目标URL
 	// 重试配置
 	maxRetries := 5               // 最大重试次数
@@ -110,13 +117,13 @@ func (dp *DP) processURL(ctx context.Context, u DPUrl, urlIndex int) error {
 			delay := baseDelay * time.Duration(1<<(i-1)) // 10s, 20s, 40s...
 			select {
 			case <-time.After(delay):
-			case <-ctx.Done():
-				return ctx.Err()
+			case <-s.ctx.Done():
+				return s.ctx.Err()
 			}
 		}
 
 		// 尝试导航
-		err := chromedp.Run(ctx, chromedp.Navigate(u.Url))
+		err := chromedp.Run(s.ctx, chromedp.Navigate(u.Url))
 		if err == nil {
 			break // 成功则退出重试
 		}
@@ -127,10 +134,10 @@ func (dp *DP) processURL(ctx context.Context, u DPUrl, urlIndex int) error {
 		return lastErr
 	}
 
-	// 执行每个点击操作
-	for j, click := range u.Clicks {
-		if err := dp.processClick(ctx, click, urlIndex, j, len(u.Clicks)-1); err != nil {
-			return fmt.Errorf("点击操作[%s]失败: %w", click, err)
+	// 执行每个操作
+	for j, step := range u.Clicks {
+		if err := dp.processStep(s, step, urlIndex, j, len(u.Clicks)-1); err != nil {
+			return fmt.Errorf("操作[%s]失败: %w", step, err)
 		}
 	}
 
@@ -138,56 +145,60 @@ func (dp *DP) processURL(ctx context.Context, u DPUrl, urlIndex int) error {
 }
 
 // processClick 处理单个点击操作
-func (dp *DP) processClick(ctx context.Context, click string, urlIndex, clickIndex, lastClickIndex int) error {
+func (dp *DP) processClick(ctx context.Context, step DPStep, urlIndex, clickIndex, lastClickIndex int) error {
+	node, err := findNode(ctx, step.Target, step.Optional, step.timeout(defaultStepTimeout))
+	if err != nil || node == nil {
+		return err
+	}
+
+	selector := useSelectorOf(node)
+	return dp.executeClick(ctx, selector, urlIndex, clickIndex, lastClickIndex)
+}
+
+// findNode 查找目标节点，可选目标不存在时返回nil
+func findNode(ctx context.Context, sel string, optional bool, timeout time.Duration) (*cdp.Node, error) {
 
 	// 处理可选点击项，如"*确认"：随机出现的弹窗确认按钮
-	optional := false
-	if subs := optionalSelReg.FindStringSubmatch(click); len(subs) == 2 {
-		click = subs[1]
+	if subs := optionalSelReg.FindStringSubmatch(sel); len(subs) == 2 {
+		sel = subs[1]
 		optional = true
 	}
 
 	// 处理正则表达式点击项，如"+代理购电-2025年0?8月"：从"代理购电"节点列表中匹配"2025年0?8月"的节点
-	detailClick := ""
-	if subs := regexpSelReg.FindStringSubmatch(click); len(subs) == 3 {
-		click = subs[1]
-		detailClick = subs[2]
+	detailSel := ""
+	if subs := regexpSelReg.FindStringSubmatch(sel); len(subs) == 3 {
+		sel = subs[1]
+		detailSel = subs[2]
 	}
 
 	// 查找目标节点
 	var nodes []*cdp.Node
-	searchSecond := time.Second * 20
-	if optional {
-		searchSecond = time.Second * 10
-	}
-	searchCtx, cancel := context.WithTimeout(ctx, searchSecond)
+	searchCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	if err := chromedp.Run(searchCtx, chromedp.WaitReady(click), chromedp.Nodes(click, &nodes, chromedp.BySearch)); !optional && err != nil {
-		return fmt.Errorf("查找节点失败: %w", err)
+	if err := chromedp.Run(searchCtx, chromedp.WaitReady(sel), chromedp.Nodes(sel, &nodes, chromedp.BySearch)); !optional && err != nil {
+		return nil, fmt.Errorf("查找节点失败: %w", err)
 	}
 
 	// 处理未找到节点的情况
 	if len(nodes) == 0 {
 		if optional {
-			return nil // 可选操作允许节点不存在
+			return nil, nil // 可选操作允许节点不存在
 		}
-		return fmt.Errorf("未找到匹配节点: %s", click)
+		return nil, fmt.Errorf("未找到匹配节点: %s", sel)
 	}
 
-	// 生成选择器并执行点击
 	node := nodes[0]
-	if detailClick != "" {
+	if detailSel != "" {
 		for _, n := range nodes {
-			if regexp.MustCompile(detailClick).MatchString(n.NodeValue) {
+			if regexp.MustCompile(detailSel).MatchString(n.NodeValue) {
 				node = n
 				break
 			}
 		}
 	}
 
-	selector := useSelectorOf(node)
-	return dp.executeClick(ctx, selector, urlIndex, clickIndex, lastClickIndex)
+	return node, nil
 }
 
 // executeClick 执行实际的点击操作
@@ -262,6 +273,11 @@ func hideWebDriverFlag() chromedp.Action {
 
 // useOuter 提取操作结果
 func useOuter(ctx context.Context, o DPOuter) string {
+	// 表格在当前页面，无需检查新标签页
+	if o.Table {
+		return useTable(ctx, o.Selector)
+	}
+
 	if url := getExtraTabUrl(ctx); url != "" {
 		return url
 	}
@@ -302,6 +318,25 @@ func useOuter(ctx context.Context, o DPOuter) string {
 	return result
 }
 
+// useTable 提取表格，合并单元格展开为网格
+func useTable(ctx context.Context, selector string) string {
+	searchCtx, cancel := context.WithTimeout(ctx, time.Second*20)
+	defer cancel()
+
+	var outer string
+	if err := chromedp.Run(searchCtx, chromedp.OuterHTML(selector, &outer)); err != nil {
+		return ""
+	}
+
+	t, err := NewDPTable(outer)
+	if err != nil {
+		return ""
+	}
+
+	buf, _ := json.Marshal(t)
+	return string(buf)
+}
+
 // getExtraTabUrl 获取新标签页URL
 func getExtraTabUrl(ctx context.Context) string {
 	// 时机一：最后一个click打开标签页
@@ -0,0 +1,428 @@
+package chromedpx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chromedp/cdproto/browser"
+	"github.com/chromedp/cdproto/network"
+	"github.com/chromedp/chromedp"
+	"github.com/chromedp/chromedp/kb"
+)
+
+// DPAction 网页操作类型
+type DPAction string
+
+const (
+	ActionClick    DPAction = "click"    // 点击(默认)：target同字符串点击项
+	ActionInput    DPAction = "input"    // 输入文本：target为输入框，value为文本，以"\n"结尾时回车提交
+	ActionSelect   DPAction = "select"   // 选择下拉选项：target为下拉框，value为选项值或文本
+	ActionWait     DPAction = "wait"     // 等待：target可见，或为"networkidle"时等待网络空闲；target为空时等待value秒
+	ActionScroll   DPAction = "scroll"   // 滚动：target滚动至可见；target为空时滚动至页面底部value次(默认1)，用于加载更多
+	ActionFrame    DPAction = "frame"    // 切换iframe：target为iframe地址正则，为空时返回当前标签页的主页面；同源iframe的节点可直接查找，无需切换
+	ActionTab      DPAction = "tab"      // 切换标签页：target为标签页地址正则，为空时切换至其他任一标签页
+	ActionDownload DPAction = "download" // 点击target并等待下载完成，需配置下载目录
+)
+
+var dpActions = []DPAction{ActionClick, ActionInput, ActionSelect, ActionWait, ActionScroll, ActionFrame, ActionTab, ActionDownload}
+
+// NetworkIdle 等待网络空闲，用于等待XHR加载
+const NetworkIdle = "networkidle"
+
+const (
+	defaultStepTimeout     = 20 * time.Second // 查找节点、切换标签页等默认超时
+	optionalStepTimeout    = 10 * time.Second // 可选操作默认超时
+	defaultDownloadTimeout = 2 * time.Minute  // 下载默认超时
+	networkIdleTime        = 500 * time.Millisecond
+)
+
+// DPStep 网页操作，JSON为字符串时即点击目标(兼容原配置)，如"*确认"、"+代理购电-2025年0?8月"
+//
+//	"clicks": ["*确认", {"action": "input", "target": "#keyword", "value": "代理购电\n"}, {"action": "wait", "target": "networkidle"}]
+type DPStep struct {
+	Action   DPAction `json:"action,omitempty" yaml:"action"`     // 操作类型，默认click
+	Target   string   `json:"target,omitempty" yaml:"target"`     // 目标：innerText、id、class、CSS选择器或XPath，或见各操作类型说明
+	Value    string   `json:"value,omitempty" yaml:"value"`       // 输入文本、选项、等待秒数、滚动次数等
+	Optional bool     `json:"optional,omitempty" yaml:"optional"` // 可选操作，目标不存在时跳过
+	Timeout  int      `json:"timeout,omitempty" yaml:"timeout"`   // 超时(秒)，默认20，可选操作默认10；下载为等待下载完成的超时，默认120
+}
+
+// Clicks 由点击目标创建操作列表
+func Clicks(targets ...string) []DPStep {
+	steps := make([]DPStep, 0, len(targets))
+	for _, target := range targets {
+		steps = append(steps, DPStep{Target: target})
+	}
+	return steps
+}
+
+func (s *DPStep) UnmarshalJSON(buf []byte) error {
+	var target string
+	if err := json.Unmarshal(buf, &target); err == nil {
+		*s = DPStep{Target: target}
+		return nil
+	}
+
+	type step DPStep
+	var v step
+	if err := json.Unmarshal(buf, &v); err != nil {
+		return err
+	}
+
+	// 操作类型拼写错误时解析配置即报错，避免抓取中途才失败
+	if len(v.Action) > 0 && !slices.Contains(dpActions, v.Action) {
+		return fmt.Errorf("不支持的操作类型: %s", v.Action)
+	}
+
+	*s = DPStep(v)
+	return nil
+}
+
+// MarshalJSON 无其他参数的点击操作保存为字符串，与原配置一致
+func (s DPStep) MarshalJSON() ([]byte, error) {
+	if s.action() == ActionClick && len(s.Value) == 0 && !s.Optional && s.Timeout == 0 {
+		return json.Marshal(s.Target)
+	}
+
+	type step DPStep
+	return json.Marshal(step(s))
+}
+
+func (s DPStep) String() string {
+	if s.action() == ActionClick {
+		return s.Target
+	}
+	return fmt.Sprintf("%s:%s", s.Action, s.Target)
+}
+
+func (s DPStep) action() DPAction {
+	if len(s.Action) == 0 {
+		return ActionClick
+	}
+	return s.Action
+}
+
+func (s DPStep) timeout(def time.Duration) time.Duration {
+	if s.Timeout > 0 {
+		return time.Duration(s.Timeout) * time.Second
+	}
+	if s.optional() {
+		return optionalStepTimeout
+	}
+	return def
+}
+
+// optional 可选操作，或以*开头的目标
+func (s DPStep) optional() bool {
+	return s.Optional || optionalSelReg.MatchString(s.Target)
+}
+
+// dpSession 浏览器会话，切换标签页或iframe后ctx为目标上下文
+type dpSession struct {
+	page    context.Context      // 初始标签页
+	tab     context.Context      // 当前标签页
+	ctx     context.Context      // 当前操作的标签页或iframe
+	cancels []context.CancelFunc // 切换时创建的上下文，取消即关闭对应目标，故会话结束时才释放
+}
+
+func newDPSession(page context.Context) *dpSession {
+	return &dpSession{page: page, tab: page, ctx: page}
+}
+
+// leaveFrame 返回当前标签页的主页面
+func (s *dpSession) leaveFrame() {
+	s.ctx = s.tab
+}
+
+// close 释放切换标签页或iframe时创建的上下文
+func (s *dpSession) close() {
+	for i := len(s.cancels) - 1; i >= 0; i-- {
+		s.cancels[i]()
+	}
+	s.cancels = nil
+}
+
+// processStep 执行单个网页操作
+func (dp *DP) processStep(s *dpSession, step DPStep, urlIndex, stepIndex, lastStepIndex int) error {
+	switch step.action() {
+	case ActionClick:
+		return dp.processClick(s.ctx, step, urlIndex, stepIndex, lastStepIndex)
+	case ActionInput:
+		return processInput(s.ctx, step)
+	case ActionSelect:
+		return processSelect(s.ctx, step)
+	case ActionWait:
+		return processWait(s.ctx, step)
+	case ActionScroll:
+		return processScroll(s.ctx, step)
+	case ActionFrame:
+		if len(step.Target) == 0 {
+			s.leaveFrame()
+			return nil
+		}
+		return s.switchTo("iframe", step)
+	case ActionTab:
+		return s.switchTo("page", step)
+	case ActionDownload:
+		return dp.processDownload(s.ctx, step, urlIndex, stepIndex, lastStepIndex)
+	}
+
+	return fmt.Errorf("不支持的操作类型: %s", step.Action)
+}
+
+// processInput 清空输入框并输入文本
+func processInput(ctx context.Context, step DPStep) error {
+	node, err := findNode(ctx, step.Target, step.optional(), step.timeout(defaultStepTimeout))
+	if err != nil || node == nil {
+		return err
+	}
+
+	value, submit := strings.CutSuffix(step.Value, "\n")
+	selector := useSelectorOf(node)
+	actions := []chromedp.Action{
+		chromedp.WaitVisible(selector),
+		chromedp.Clear(selector),
+		chromedp.SendKeys(selector, value),
+	}
+	if submit {
+		actions = append(actions, chromedp.SendKeys(selector, kb.Enter), chromedp.Sleep(time.Second))
+	}
+
+	return chromedp.Run(ctx, actions...)
+}
+
+// processSelect 按选项值或文本选择下拉选项，并触发change事件
+func processSelect(ctx context.Context, step DPStep) error {
+	node, err := findNode(ctx, step.Target, step.optional(), step.timeout(defaultStepTimeout))
+	if err != nil || node == nil {
+		return err
+	}
+
+	xpath, _ := json.Marshal(useSelectorOf(node))
+	value, _ := json.Marshal(step.Value)
+	script := fmt.Sprintf(`(function(xpath, v) {
+	const el = document.evaluate(xpath, document, null, XPathResult.FIRST_ORDERED_NODE_TYPE, null).singleNodeValue;
+	const opt = el && Array.from(el.options || []).find(o => o.value === v || o.text.trim() === v);
+	if (!opt) return false;
+	el.value = opt.value;
+	el.dispatchEvent(new Event('input', { bubbles: true }));
+	el.dispatchEvent(new Event('change', { bubbles: true }));
+	return true;
+})(%s, %s)`, xpath, value)
+
+	ok := false
+	if err := chromedp.Run(ctx, chromedp.Evaluate(script, &ok), chromedp.Sleep(time.Second)); err != nil {
+		return err
+	}
+	if !ok && !step.optional() {
+		return fmt.Errorf("未找到下拉选项: %s", step.Value)
+	}
+	return nil
+}
+
+// processWait 等待节点出现、网络空闲或指定秒数
+func processWait(ctx context.Context, step DPStep) error {
+	switch step.Target {
+	case "":
+		seconds, err := strconv.ParseFloat(step.Value, 64)
+		if err != nil {
+			return fmt.Errorf("无效的等待秒数: %s", step.Value)
+		}
+		return chromedp.Run(ctx, chromedp.Sleep(time.Duration(seconds*float64(time.Second))))
+	case NetworkIdle:
+		err := waitNetworkIdle(ctx, step.timeout(defaultStepTimeout))
+		if step.optional() {
+			return nil
+		}
+		return err
+	}
+
+	_, err := findNode(ctx, step.Target, step.optional(), step.timeout(defaultStepTimeout))
+	return err
+}
+
+// waitNetworkIdle 等待无进行中的请求且持续networkIdleTime
+func waitNetworkIdle(ctx context.Context, timeout time.Duration) error {
+	var mu sync.Mutex
+	inflight := map[network.RequestID]bool{}
+	last := time.Now()
+
+	lctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	chromedp.ListenTarget(lctx, func(ev any) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch e := ev.(type) {
+		case *network.EventRequestWillBeSent:
+			inflight[e.RequestID] = true
+		case *network.EventLoadingFinished:
+			delete(inflight, e.RequestID)
+		case *network.EventLoadingFailed:
+			delete(inflight, e.RequestID)
+		default:
+			return
+		}
+		last = time.Now()
+	})
+
+	if err := chromedp.Run(ctx, network.Enable()); err != nil {
+		return fmt.Errorf("启用网络事件失败: %w", err)
+	}
+
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	deadline := time.After(timeout)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-deadline:
+			return fmt.Errorf("等待网络空闲超时(%v)", timeout)
+		case <-ticker.C:
+			mu.Lock()
+			idle := len(inflight) == 0 && time.Since(last) >= networkIdleTime
+			mu.Unlock()
+			if idle {
+				return nil
+			}
+		}
+	}
+}
+
+// processScroll 滚动至节点可见，或滚动至页面底部以加载更多
+func processScroll(ctx context.Context, step DPStep) error {
+	if len(step.Target) > 0 {
+		node, err := findNode(ctx, step.Target, step.optional(), step.timeout(defaultStepTimeout))
+		if err != nil || node == nil {
+			return err
+		}
+		return chromedp.Run(ctx, chromedp.ScrollIntoView(useSelectorOf(node)), chromedp.Sleep(time.Second))
+	}
+
+	times := 1
+	if len(step.Value) > 0 {
+		n, err := strconv.Atoi(step.Value)
+		if err != nil || n <= 0 {
+			return fmt.Errorf("无效的滚动次数: %s", step.Value)
+		}
+		times = n
+	}
+
+	for range times {
+		if err := chromedp.Run(ctx,
+			chromedp.Evaluate(`window.scrollTo(0, document.body.scrollHeight)`, nil),
+			chromedp.Sleep(time.Second),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// switchTo 切换至地址匹配的标签页(page)或跨域iframe
+func (s *dpSession) switchTo(kind string, step DPStep) error {
+	reg, err := regexp.Compile(step.Target)
+	if err != nil {
+		return fmt.Errorf("无效的地址正则: %w", err)
+	}
+
+	// 切换标签页时与当前标签页比较，切换iframe时与当前iframe比较
+	current := chromedp.FromContext(s.ctx).Target.TargetID
+	if kind == "page" {
+		current = chromedp.FromContext(s.tab).Target.TargetID
+	}
+
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+	deadline := time.After(step.timeout(defaultStepTimeout))
+	for {
+		targets, err := chromedp.Targets(s.page)
+		if err != nil {
+			return fmt.Errorf("获取标签页失败: %w", err)
+		}
+
+		for _, t := range targets {
+			if t.Type == kind && t.TargetID != current && t.URL != "" && reg.MatchString(t.URL) {
+				ctx, cancel := chromedp.NewContext(s.page, chromedp.WithTargetID(t.TargetID))
+				s.cancels = append(s.cancels, cancel)
+				s.ctx = ctx
+				if kind == "page" {
+					s.tab = ctx
+				}
+				return nil
+			}
+		}
+
+		select {
+		case <-s.ctx.Done():
+			return s.ctx.Err()
+		case <-deadline:
+			if step.optional() {
+				return nil
+			}
+			return fmt.Errorf("未找到%s: %s", kind, step.Target)
+		case <-ticker.C:
+		}
+	}
+}
+
+// processDownload 点击目标并等待下载完成
+func (dp *DP) processDownload(ctx context.Context, step DPStep, urlIndex, stepIndex, lastStepIndex int) error {
+	if len(dp.DownloadDir) == 0 {
+		return fmt.Errorf("未配置下载目录")
+	}
+
+	done := make(chan error, 1)
+	lctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	chromedp.ListenBrowser(lctx, func(ev any) {
+		e, ok := ev.(*browser.EventDownloadProgress)
+		if !ok {
+			return
+		}
+
+		var err error
+		switch e.State {
+		case browser.DownloadProgressStateCompleted:
+		case browser.DownloadProgressStateCanceled:
+			err = fmt.Errorf("下载已取消")
+		default:
+			return
+		}
+
+		select {
+		case done <- err:
+		default:
+		}
+	})
+
+	node, err := findNode(ctx, step.Target, step.optional(), step.timeout(defaultStepTimeout))
+	if err != nil || node == nil {
+		return err
+	}
+
+	if err := dp.executeClick(ctx, useSelectorOf(node), urlIndex, stepIndex, lastStepIndex); err != nil {
+		return err
+	}
+
+	timeout := defaultDownloadTimeout
+	if step.Timeout > 0 {
+		timeout = time.Duration(step.Timeout) * time.Second
+	}
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(timeout):
+		return fmt.Errorf("等待下载超时(%v)", timeout)
+	}
+}
@@ -0,0 +1,64 @@
+package chromedpx
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDPStepJSON(t *testing.T) {
+	// 原字符串点击项保持不变
+	legacy := `{"url":"https://95598.cn","clicks":["*确认","city_select","+代理购电-2025年0?8月"]}`
+	var u DPUrl
+	assert.NoError(t, json.Unmarshal([]byte(legacy), &u))
+	assert.Equal(t, Clicks("*确认", "city_select", "+代理购电-2025年0?8月"), u.Clicks)
+
+	buf, err := json.Marshal(u)
+	assert.NoError(t, err)
+	assert.JSONEq(t, legacy, string(buf))
+
+	// 字符串与操作对象混用
+	mixed := `{"url":"https://example.com","clicks":["*确认",{"action":"input","target":"#keyword","value":"代理购电\n"},{"action":"wait","target":"networkidle"},{"action":"scroll","value":"3"},{"action":"download","target":"下载","timeout":60}]}`
+	assert.NoError(t, json.Unmarshal([]byte(mixed), &u))
+	assert.Len(t, u.Clicks, 5)
+	assert.Equal(t, DPStep{Action: ActionInput, Target: "#keyword", Value: "代理购电\n"}, u.Clicks[1])
+	assert.Equal(t, NetworkIdle, u.Clicks[2].Target)
+	assert.Equal(t, 60, u.Clicks[4].Timeout)
+
+	buf, err = json.Marshal(u)
+	assert.NoError(t, err)
+	assert.JSONEq(t, mixed, string(buf))
+}
+
+func TestDPStepInvalidAction(t *testing.T) {
+	var u DPUrl
+	err := json.Unmarshal([]byte(`{"url":"https://example.com","clicks":[{"action":"clik","target":"下载"}]}`), &u)
+	assert.ErrorContains(t, err, "不支持的操作类型: clik")
+
+	// 未指定操作类型时为点击
+	assert.NoError(t, json.Unmarshal([]byte(`{"url":"https://example.com","clicks":[{"target":"下载"}]}`), &u))
+	assert.Equal(t, ActionClick, u.Clicks[0].action())
+}
+
+func TestDPSessionLeaveFrame(t *testing.T) {
+	type key string
+	page := context.Background()
+	tab := context.WithValue(page, key("target"), "tab")
+	frame := context.WithValue(tab, key("target"), "iframe")
+
+	// 切换标签页后进入iframe
+	s := newDPSession(page)
+	s.tab, s.ctx = tab, frame
+
+	// frame目标为空时返回当前标签页，而非初始标签页
+	dp := &DP{}
+	assert.NoError(t, dp.processStep(s, DPStep{Action: ActionFrame}, 0, 0, 0))
+	assert.Equal(t, tab, s.ctx)
+
+	// 下一URL在当前标签页导航
+	s.ctx = frame
+	s.leaveFrame()
+	assert.Equal(t, tab, s.ctx)
+}
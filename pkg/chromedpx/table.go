@@ -0,0 +1,134 @@
+package chromedpx
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html"
+)
+
+// 块级元素，提取文本时换行
+var blockTags = map[string]bool{
+	"p": true, "div": true, "br": true, "li": true, "tr": true, "table": true, "section": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+}
+
+// DPTable 表格提取结果，合并单元格(rowspan/colspan)按所跨各行列重复填充
+type DPTable struct {
+	Before string     `json:"before"` // 表格前的文本，如标题、单位
+	Rows   [][]string `json:"rows"`   // 单元格，各行列数相同
+	After  string     `json:"after"`  // 表格后的文本，如表注，按块级元素分行
+}
+
+// NewDPTable 解析HTML中的首个表格，html本身可为table元素或包含table的容器
+func NewDPTable(outer string) (DPTable, error) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(outer))
+	if err != nil {
+		return DPTable{}, err
+	}
+
+	table := doc.Find("table").First()
+	if table.Length() == 0 {
+		return DPTable{}, errors.New("未找到表格")
+	}
+
+	// 以表格节点为界，分别提取前后文本
+	var before, after strings.Builder
+	sb := &before
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		switch {
+		case n == table.Get(0):
+			sb = &after
+			return
+		case n.Type == html.ElementNode && (n.Data == "script" || n.Data == "style"):
+			return
+		case n.Type == html.TextNode:
+			sb.WriteString(strings.Join(strings.Fields(n.Data), " "))
+		}
+
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+		if n.Type == html.ElementNode && blockTags[n.Data] {
+			sb.WriteString("\n")
+		}
+	}
+	walk(doc.Get(0))
+
+	return DPTable{
+		Before: trimLines(before.String()),
+		Rows:   tableGrid(table),
+		After:  trimLines(after.String()),
+	}, nil
+}
+
+// tableGrid 按rowspan、colspan展开表格，忽略嵌套表格
+func tableGrid(table *goquery.Selection) [][]string {
+	grid := make([][]string, 0)
+	filled := make(map[[2]int]bool)
+	width := 0
+
+	rows := table.Find("tr").FilterFunction(func(_ int, tr *goquery.Selection) bool {
+		return tr.Closest("table").IsSelection(table)
+	})
+	rows.Each(func(r int, tr *goquery.Selection) {
+		col := 0
+		tr.ChildrenFiltered("td,th").Each(func(_ int, td *goquery.Selection) {
+			for filled[[2]int{r, col}] {
+				col++
+			}
+
+			rowspan, colspan := span(td, "rowspan"), span(td, "colspan")
+			text := strings.Join(strings.Fields(td.Text()), " ")
+			for i := 0; i < rowspan; i++ {
+				for j := 0; j < colspan; j++ {
+					for len(grid) <= r+i {
+						grid = append(grid, []string{})
+					}
+					row := grid[r+i]
+					for len(row) <= col+j {
+						row = append(row, "")
+					}
+					row[col+j] = text
+					grid[r+i] = row
+					filled[[2]int{r + i, col + j}] = true
+				}
+			}
+
+			col += colspan
+			width = max(width, col)
+		})
+	})
+
+	for i, row := range grid {
+		for len(row) < width {
+			row = append(row, "")
+		}
+		grid[i] = row
+	}
+
+	return grid
+}
+
+// span 读取rowspan或colspan，缺省或无效时为1
+func span(td *goquery.Selection, attr string) int {
+	v, err := strconv.Atoi(strings.TrimSpace(td.AttrOr(attr, "1")))
+	if err != nil || v < 1 {
+		return 1
+	}
+	return v
+}
+
+// trimLines 去除空行及行首尾空白
+func trimLines(s string) string {
+	lines := make([]string, 0)
+	for _, line := range strings.Split(s, "\n") {
+		if line = strings.TrimSpace(line); len(line) > 0 {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
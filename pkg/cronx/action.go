@@ -2,68 +2,187 @@ package cronx
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"seeccloud.com/edscron/pkg/chromedpx"
 
 	"github.com/rs/xid"
+	"github.com/zeromicro/go-zero/core/logx"
 )
 
-// Action 定义任务处理步骤类型
-type Action func() error
+// 网络及文档转换步骤的超时与重试策略
+const (
+	crawlTimeout   = 6 * time.Minute // 网页抓取，chromedpx单次抓取限时5分钟
+	convertTimeout = 10 * time.Minute
+	netRetry       = 2
+	netBackoff     = 10 * time.Second
+)
+
+// crawlLocalizeStep 网页抓取并下载，url为抓取的文件地址(点击下载时为空)
+func crawlLocalizeStep(dp chromedpx.DP, url *Value[string], file *File) Step {
+	return Step{
+		Name:    "crawl",
+		Out:     []Port{file},
+		Timeout: crawlTimeout,
+		Retry:   1,
+		Backoff: netBackoff,
+		Run: func(ctx context.Context) error {
+			link := ""
+			err := dp.Run(ctx, &link)
+			if err == nil {
+				url.Set(link)
+				return localizeFile(ctx, link, file)
+			}
+
+			if entries, err1 := os.ReadDir(dp.DownloadDir); err1 == nil && len(entries) > 0 {
+				file.Set(filepath.Join(dp.DownloadDir, entries[0].Name()))
+				return nil
+			}
+
+			return err
+		},
+	}
+}
 
-func crawlAndLocalizeAc(ctx context.Context, dp chromedpx.DP, path *string) Action {
-	return func() error {
-		url := ""
-		err := dp.Run(ctx, &url)
-		if err == nil {
-			return localize(url, path)
-		}
+// crawlStep 网页抓取
+func crawlStep(dp chromedpx.DP, out *Value[string]) Step {
+	return Step{
+		Name:    "crawl",
+		Out:     []Port{out},
+		Timeout: crawlTimeout,
+		Retry:   1,
+		Backoff: netBackoff,
+		Run: func(ctx context.Context) error {
+			content := ""
+			if err := dp.Run(ctx, &content); err != nil {
+				return err
+			}
 
-		if entries, err1 := os.ReadDir(dp.DownloadDir); err1 == nil && len(entries) > 0 {
-			*path = filepath.Join(dp.DownloadDir, entries[0].Name())
+			out.Set(content)
 			return nil
-		}
+		},
+	}
+}
 
-		return err
+// localizeStep 文件下载
+func localizeStep(name string, url *Value[string], file *File) Step {
+	return Step{
+		Name:    name,
+		In:      []Port{url},
+		Out:     []Port{file},
+		Retry:   netRetry,
+		Backoff: netBackoff,
+		Run: func(ctx context.Context) error {
+			return localizeFile(ctx, url.Get(), file)
+		},
 	}
 }
 
-// crawlAc 创建网页抓取任务
-func crawlAc(ctx context.Context, dp chromedpx.DP, name *string) Action {
-	return func() error {
-		return dp.Run(ctx, name)
+func localizeFile(ctx context.Context, url string, file *File) error {
+	path := ""
+	if err := localize(ctx, url, &path); err != nil {
+		return err
 	}
+
+	file.Set(path)
+	return nil
 }
 
-// localizeAc 创建文件下载任务
-func localizeAc(url, path *string) Action {
-	return func() error {
-		return localize(*url, path)
+// convertStep PDF转换，转换结果保存为本地文件
+func convertStep(name string, converter DocConverter, in, out *File, format OutputFormat) Step {
+	return Step{
+		Name:    name,
+		In:      []Port{in},
+		Out:     []Port{out},
+		Timeout: convertTimeout,
+		Retry:   1,
+		Backoff: netBackoff,
+		Run: func(ctx context.Context) error {
+			path := ""
+			if err := convertFile(ctx, converter, in, format, &path); err != nil {
+				return err
+			}
+
+			out.Set(path)
+			return nil
+		},
 	}
 }
 
-// ocrPdfAc 创建OCR识别任务
-func ocrPdfAc(config AliOcr, inPath, outUrl *string) Action {
-	return func() error {
-		return aliConvertPDF(config, *inPath, outUrl, formatExcel)
+// convertFile 转换过程文件，转换服务支持缓存时以文件内容标识为缓存键
+func convertFile(ctx context.Context, converter DocConverter, in *File, format OutputFormat, outPath *string) error {
+	kc, ok := converter.(keyConverter)
+	if !ok {
+		return converter.Convert(ctx, in.Path(), format, outPath)
+	}
+
+	key, err := in.Key()
+	if err != nil {
+		return err
 	}
+
+	return kc.ConvertKey(ctx, key, in.Path(), format, outPath)
 }
 
-// pdfConvertAc 创建PDF转换任务
-func pdfConvertAc(inPath, outUrl *string, format OutputFormat) Action {
+// tableStep 表格提取：优先读取PDF文本层，无文本层或valid校验失败时OCR识别
+func tableStep(converter DocConverter, in, out *File, valid func(excel string) error) Step {
+	return Step{
+		Name:    "table",
+		In:      []Port{in},
+		Out:     []Port{out},
+		Timeout: convertTimeout,
+		Retry:   1,
+		Backoff: netBackoff,
+		Run: func(ctx context.Context) error {
+			path := ""
+			err := extractPdfTable(in.Path(), &path)
+			if err == nil {
+				if err = valid(path); err == nil {
+					out.Set(path)
+					return nil
+				}
+				os.Remove(path)
+			}
+
+			if !errors.Is(err, errNoTextLayer) {
+				logx.Infof("PDF文本层表格不可用，改用OCR识别: %v", err)
+			}
 
-	return func() error {
-		return pdf24Convert(*inPath, format, outUrl)
+			if err := convertFile(ctx, converter, in, formatExcel, &path); err != nil {
+				return err
+			}
+
+			out.Set(path)
+			return nil
+		},
 	}
 }
 
-// cropContentAc 获取目录页任务
-func cropPdfAc(inPath, outPath *string, crops *[]string) Action {
-	*outPath = filepath.Join(tempDir, fmt.Sprintf("%s.pdf", xid.New().String()))
-	return func() error {
-		return crop(*inPath, *outPath, *crops)
+// cropPdfStep 按页码裁剪PDF
+func cropPdfStep(in, out *File, pages *Value[[]string]) Step {
+	return Step{
+		Name: "crop",
+		In:   []Port{in, pages},
+		Out:  []Port{out},
+		Run: func(ctx context.Context) error {
+			key, err := in.Key()
+			if err != nil {
+				return err
+			}
+
+			path := filepath.Join(tempDir, fmt.Sprintf("%s.pdf", xid.New().String()))
+			if err := crop(in.Path(), path, pages.Get()); err != nil {
+				return err
+			}
+
+			out.Set(path)
+			out.Derive(key, "crop"+strings.Join(pages.Get(), ","))
+			return nil
+		},
 	}
 }
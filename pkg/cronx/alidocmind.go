@@ -1,6 +1,7 @@
 package cronx
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -14,11 +15,12 @@ import (
 
 /*
 使用示例:
-err := aliConvertPDF(config, inPath, &outPath, FormatExcel)  // 转换为Excel
-err := aliConvertPDF(config, inPath, &outPath, FormatWord)   // 转换为Word
-err := aliConvertPDF(config, inPath, &outPath, FormatImage)  // 转换为图片
+err := aliConvertPDF(ctx, config, inPath, &outPath, FormatExcel)  // 转换为Excel
+err := aliConvertPDF(ctx, config, inPath, &outPath, FormatWord)   // 转换为Word
+err := aliConvertPDF(ctx, config, inPath, &outPath, FormatImage)  // 转换为图片
 
 参数说明:
+- ctx: 取消时停止轮询转换结果
 - config: 阿里云API配置
 - inPath: 本地PDF文件路径
 - outPath: 用于接收结果URL的指针
@@ -53,7 +55,7 @@ type AliOcr struct {
 }
 
 // aliConvertPDF 使用阿里云文档智能服务转换PDF文件
-func aliConvertPDF(cfg AliOcr, inPath string, outUrl *string, format OutputFormat) error {
+func aliConvertPDF(ctx context.Context, cfg AliOcr, inPath string, outUrl *string, format OutputFormat) error {
 	// 初始化客户端
 	config := aliapi.Config{
 		Endpoint:        &cfg.Endpoint,
@@ -122,23 +124,26 @@ func aliConvertPDF(cfg AliOcr, inPath string, outUrl *string, format OutputForma
 		return fmt.Errorf("阿里云服务错误: %s - %s", resp.Code, resp.Message)
 	}
 
-	return getDocResult(client, resp.Data.Id, outUrl)
+	return getDocResult(ctx, client, resp.Data.Id, outUrl)
 }
 
 // 获取转换结果
-func getDocResult(client *alidoc.Client, id string, outPath *string) error {
+func getDocResult(ctx context.Context, client *alidoc.Client, id string, outPath *string) error {
 	const (
 		timeout  = 5 * time.Minute  // 超时时间
 		interval = 10 * time.Second // 轮询间隔
 	)
 
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	timer := time.NewTimer(interval)
 	defer timer.Stop()
 
 	for {
 		select {
-		case <-time.After(timeout):
-			return errors.New("转换超时(5分钟)")
+		case <-ctx.Done():
+			return fmt.Errorf("转换超时: %w", ctx.Err())
 		case <-timer.C:
 			response, err := client.GetDocumentConvertResult(&alidoc.GetDocumentConvertResultRequest{
 				Id: &id,
@@ -0,0 +1,124 @@
+package cronx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 归档文件类型
+const (
+	ArtifactSource        = "source"         // 原始文件：PDF、图片或网页表格
+	ArtifactTable         = "table"          // 表格识别结果：OCR或PDF文本层提取的Excel
+	ArtifactCalendar      = "calendar"       // 电价日历原始文件(台湾)
+	ArtifactCalendarTable = "calendar_table" // 电价日历识别结果(台湾)
+)
+
+// ArtifactFile 归档文件
+type ArtifactFile struct {
+	Kind string `json:"kind"` // 文件类型
+	Hash string `json:"hash"` // 文件SHA256
+	Ext  string `json:"ext"`  // 扩展名，如".pdf"
+}
+
+// Artifact 一次任务运行的归档
+type Artifact struct {
+	Url       string         `json:"url"`        // 来源URL
+	FetchedAt time.Time      `json:"fetched_at"` // 抓取时间
+	Files     []ArtifactFile `json:"files"`      // 归档文件
+}
+
+// File 按类型查找归档文件
+func (a Artifact) File(kind string) (ArtifactFile, bool) {
+	for _, f := range a.Files {
+		if f.Kind == kind {
+			return f, true
+		}
+	}
+	return ArtifactFile{}, false
+}
+
+// ArtifactStore 内容寻址的归档存储，文件保存为"<Dir>/<SHA256前2位>/<SHA256><扩展名>"
+type ArtifactStore struct {
+	Dir       string         `json:"dir,omitempty"` // 归档目录，为空时不归档
+	OnArchive func(Artifact) `json:"-"`             // 归档回调，任务结束时(无论成功与否)调用
+}
+
+// path 归档文件路径
+func (s ArtifactStore) path(f ArtifactFile) string {
+	return filepath.Join(s.Dir, f.Hash[:2], f.Hash+f.Ext)
+}
+
+// archive 归档文件并记录至a，归档失败不影响任务执行
+func (s ArtifactStore) archive(a *Artifact, kind string, name string) {
+	if len(s.Dir) == 0 || len(name) == 0 {
+		return
+	}
+
+	hash, err := fileHash(name)
+	if err != nil {
+		logx.Errorf("归档文件失败: %v", err)
+		return
+	}
+
+	f := ArtifactFile{Kind: kind, Hash: hash, Ext: strings.ToLower(filepath.Ext(name))}
+	dst := s.path(f)
+	if _, err := os.Stat(dst); err != nil {
+		if err := ensureDir(filepath.Dir(dst)); err != nil {
+			logx.Errorf("创建归档目录失败: %v", err)
+			return
+		}
+
+		if err := copyFile(name, dst); err != nil {
+			logx.Errorf("归档文件失败: %v", err)
+			return
+		}
+	}
+
+	a.Files = append(a.Files, f)
+}
+
+// done 任务结束，回调归档结果
+func (s ArtifactStore) done(a Artifact) {
+	if s.OnArchive != nil && len(a.Files) > 0 {
+		s.OnArchive(a)
+	}
+}
+
+// Open 复制归档文件至临时目录，供重新解析(任务结束时会删除过程文件)
+func (s ArtifactStore) Open(a Artifact, kind string, outPath *string) error {
+	f, ok := a.File(kind)
+	if !ok {
+		return fmt.Errorf("无归档文件: %s", kind)
+	}
+
+	if len(s.Dir) == 0 {
+		return errors.New("未配置归档目录")
+	}
+
+	if len(f.Hash) < 2 {
+		return fmt.Errorf("无效的归档文件: %+v", f)
+	}
+
+	return copyToTemp(s.path(f), outPath)
+}
+
+// archiveStep 归档文件并记录至a，文件未生成时跳过
+func archiveStep(name string, s ArtifactStore, a *Value[Artifact], kind string, file *File) Step {
+	return Step{
+		Name: name,
+		When: file.IsSet,
+		Run: func(ctx context.Context) error {
+			artifact := a.Get()
+			s.archive(&artifact, kind, file.Path())
+			a.Set(artifact)
+			return nil
+		},
+	}
+}
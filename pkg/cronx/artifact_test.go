@@ -0,0 +1,51 @@
+package cronx
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArtifactStore(t *testing.T) {
+	dir := t.TempDir()
+	in := filepath.Join(dir, "dlgd.PDF")
+	assert.NoError(t, os.WriteFile(in, []byte("%PDF-1.4 dlgd"), fileMode))
+
+	archived := make([]Artifact, 0)
+	store := ArtifactStore{Dir: filepath.Join(dir, "archive"), OnArchive: func(a Artifact) { archived = append(archived, a) }}
+
+	// 相同内容只保存一份
+	a := Artifact{Url: "https://example.com/dlgd.pdf"}
+	store.archive(&a, ArtifactSource, in)
+	store.archive(&a, ArtifactSource, in)
+	store.done(a)
+
+	assert.Len(t, archived, 1)
+	assert.Len(t, archived[0].Files, 2)
+	f, ok := archived[0].File(ArtifactSource)
+	assert.True(t, ok)
+	assert.Equal(t, ".pdf", f.Ext)
+	assert.FileExists(t, filepath.Join(store.Dir, f.Hash[:2], f.Hash+".pdf"))
+
+	// 重新解析时复制至临时目录
+	assert.NoError(t, ensureDir(tempDir))
+	defer os.Remove(tempDir)
+
+	out := ""
+	assert.NoError(t, store.Open(archived[0], ArtifactSource, &out))
+	defer os.Remove(out)
+	buf, err := os.ReadFile(out)
+	assert.NoError(t, err)
+	assert.Equal(t, "%PDF-1.4 dlgd", string(buf))
+
+	assert.Error(t, store.Open(archived[0], ArtifactTable, &out))
+	assert.Error(t, ArtifactStore{}.Open(archived[0], ArtifactSource, &out))
+
+	// 未配置归档目录或无归档文件时不回调
+	empty := Artifact{}
+	ArtifactStore{OnArchive: store.OnArchive}.archive(&empty, ArtifactSource, in)
+	store.done(empty)
+	assert.Len(t, archived, 1)
+}
@@ -0,0 +1,209 @@
+package cronx
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/jinzhu/now"
+	"seeccloud.com/edscron/pkg/vars"
+)
+
+// 基准回归变量
+const (
+	BaselineDays     = "days"     // 天数
+	BaselineWorkDays = "workDays" // 工作日数
+	BaselineHdd      = "hdd"      // 采暖度日
+	BaselineCdd      = "cdd"      // 制冷度日
+)
+
+const (
+	// ASHRAE Guideline 14 月度模型判定阈值
+	BaselineMaxCvRmse = 0.15
+	BaselineMinR2     = 0.75
+)
+
+// UsagePeriod 计量周期电量及回归变量
+type UsagePeriod struct {
+	Start    time.Time // 开始日期
+	End      time.Time // 结束日期(含)
+	Usage    float64   // 电量(kWh)
+	Days     float64   // 天数
+	WorkDays float64   // 工作日数
+	Hdd      float64   // 采暖度日
+	Cdd      float64   // 制冷度日
+}
+
+// value 回归变量取值
+func (p UsagePeriod) value(name string) float64 {
+	switch name {
+	case BaselineWorkDays:
+		return p.WorkDays
+	case BaselineHdd:
+		return p.Hdd
+	case BaselineCdd:
+		return p.Cdd
+	default:
+		return p.Days
+	}
+}
+
+// BaselineModel IPMVP选项C基准能耗模型：usage = a·days + b·workDays + c·HDD + d·CDD
+//
+// 无截距，days项即日均基础负荷；全为零或与days成比例的变量不参与回归
+type BaselineModel struct {
+	Vars   []string  // 参与回归的变量
+	Coefs  []float64 // 回归系数，与Vars对应
+	R2     float64   // 决定系数
+	CvRmse float64   // 均方根误差变异系数
+	Size   int       // 基准期周期数
+}
+
+// FitBaseline 以最小二乘法拟合基准期电量
+func FitBaseline(periods []UsagePeriod) (*BaselineModel, error) {
+	names := []string{BaselineDays}
+	for _, name := range []string{BaselineWorkDays, BaselineHdd, BaselineCdd} {
+		if isBaselineVar(periods, name) {
+			names = append(names, name)
+		}
+	}
+
+	k := len(names)
+	if len(periods) <= k {
+		return nil, fmt.Errorf("基准期周期数(%d)需多于回归变量数(%d)", len(periods), k)
+	}
+
+	// 正规方程 XᵀX·β = Xᵀy
+	xtx := make([][]float64, k)
+	xty := make([]float64, k)
+	for i := range k {
+		xtx[i] = make([]float64, k)
+		for _, p := range periods {
+			for j := range k {
+				xtx[i][j] += p.value(names[i]) * p.value(names[j])
+			}
+			xty[i] += p.value(names[i]) * p.Usage
+		}
+	}
+
+	coefs, err := solveLinear(xtx, xty)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &BaselineModel{Vars: names, Coefs: coefs, Size: len(periods)}
+	var mean, ssRes, ssTot float64
+	for _, p := range periods {
+		mean += p.Usage
+	}
+	mean /= float64(len(periods))
+	for _, p := range periods {
+		ssRes += math.Pow(p.Usage-m.Predict(p), 2)
+		ssTot += math.Pow(p.Usage-mean, 2)
+	}
+
+	if ssTot > 0 {
+		m.R2 = 1 - ssRes/ssTot
+	}
+	if mean != 0 {
+		m.CvRmse = math.Sqrt(ssRes/float64(len(periods)-k)) / mean
+	}
+
+	return m, nil
+}
+
+// Predict 依回归模型估算周期电量，即调整后基准能耗
+func (m BaselineModel) Predict(p UsagePeriod) float64 {
+	var usage float64
+	for i, name := range m.Vars {
+		usage += m.Coefs[i] * p.value(name)
+	}
+	return usage
+}
+
+// Warnings 模型不满足ASHRAE Guideline 14阈值时的提示
+func (m BaselineModel) Warnings() []string {
+	var warnings []string
+	if m.CvRmse > BaselineMaxCvRmse {
+		warnings = append(warnings, fmt.Sprintf("CV(RMSE)=%.2f%%超过%.0f%%，模型不确定度较高", m.CvRmse*100, BaselineMaxCvRmse*100))
+	}
+	if m.R2 < BaselineMinR2 {
+		warnings = append(warnings, fmt.Sprintf("R²=%.2f低于%.2f，天气及工作日对电量解释不足", m.R2, BaselineMinR2))
+	}
+	return warnings
+}
+
+// WorkDays 统计start~end(含)的工作日数：周一至周五非假日，及调休工作日
+func WorkDays(start, end time.Time, holidays map[string]HolidayCategory) int {
+	var size int
+	for day := now.With(start).BeginningOfDay(); !day.After(end); day = day.AddDate(0, 0, 1) {
+		switch holidays[day.Format(vars.DateFormat)] {
+		case HolidayOn:
+			size++
+		case HolidayOff, HolidayPeakOff:
+		default:
+			if day.Weekday() != time.Saturday && day.Weekday() != time.Sunday {
+				size++
+			}
+		}
+	}
+	return size
+}
+
+// isBaselineVar 变量非全零且与days不成比例时参与回归
+func isBaselineVar(periods []UsagePeriod, name string) bool {
+	var ratio float64
+	proportional := true
+	for i, p := range periods {
+		if p.Days == 0 {
+			continue
+		}
+
+		r := p.value(name) / p.Days
+		if i == 0 {
+			ratio = r
+		} else if math.Abs(r-ratio) > 1e-9 {
+			proportional = false
+		}
+	}
+
+	return !proportional
+}
+
+// solveLinear 高斯列主元消元求解线性方程组
+func solveLinear(a [][]float64, b []float64) ([]float64, error) {
+	n := len(b)
+	for col := range n {
+		pivot := col
+		for row := col + 1; row < n; row++ {
+			if math.Abs(a[row][col]) > math.Abs(a[pivot][col]) {
+				pivot = row
+			}
+		}
+
+		if math.Abs(a[pivot][col]) < 1e-9 {
+			return nil, fmt.Errorf("回归变量线性相关，无法拟合基准模型")
+		}
+
+		a[col], a[pivot] = a[pivot], a[col]
+		b[col], b[pivot] = b[pivot], b[col]
+		for row := col + 1; row < n; row++ {
+			f := a[row][col] / a[col][col]
+			for j := col; j < n; j++ {
+				a[row][j] -= f * a[col][j]
+			}
+			b[row] -= f * b[col]
+		}
+	}
+
+	x := make([]float64, n)
+	for row := n - 1; row >= 0; row-- {
+		sum := b[row]
+		for j := row + 1; j < n; j++ {
+			sum -= a[row][j] * x[j]
+		}
+		x[row] = sum / a[row][row]
+	}
+
+	return x, nil
+}
@@ -0,0 +1,51 @@
+package cronx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFitBaseline(t *testing.T) {
+	cdds := []float64{0, 0, 5, 20, 60, 120, 160, 150, 90, 30, 5, 0}
+	workDays := []float64{22, 17, 21, 21, 19, 21, 23, 21, 22, 17, 20, 23}
+	var periods []UsagePeriod
+	for i := range 12 {
+		start := time.Date(2024, time.Month(i+1), 1, 0, 0, 0, 0, time.Local)
+		end := start.AddDate(0, 1, -1)
+		p := UsagePeriod{Start: start, End: end, Days: float64(end.Day()), WorkDays: workDays[i], Cdd: cdds[i]}
+		p.Usage = 100*p.Days + 50*p.WorkDays + 20*p.Cdd
+		periods = append(periods, p)
+	}
+
+	m, err := FitBaseline(periods)
+	assert.NoError(t, err)
+	// HDD全为零，不参与回归
+	assert.Equal(t, []string{BaselineDays, BaselineWorkDays, BaselineCdd}, m.Vars)
+	assert.InDeltaSlice(t, []float64{100, 50, 20}, m.Coefs, 1e-6)
+	assert.InDelta(t, 1, m.R2, 1e-9)
+	assert.InDelta(t, 0, m.CvRmse, 1e-6)
+	assert.Empty(t, m.Warnings())
+
+	// 报告期更热：调整后基准随CDD增加
+	hot := UsagePeriod{Days: 31, WorkDays: 23, Cdd: 200}
+	assert.InDelta(t, 100*31+50*23+20*200, m.Predict(hot), 1e-6)
+
+	_, err = FitBaseline(periods[:3])
+	assert.Error(t, err)
+}
+
+func TestWorkDays(t *testing.T) {
+	// 2025-10-01 ~ 2025-10-12：国庆8天假，9/28、10/11调休
+	holidays := map[string]HolidayCategory{"2025-10-11": HolidayOn}
+	for d := 1; d <= 8; d++ {
+		holidays[time.Date(2025, 10, d, 0, 0, 0, 0, time.Local).Format("2006-01-02")] = HolidayOff
+	}
+
+	start := time.Date(2025, 10, 1, 0, 0, 0, 0, time.Local)
+	end := time.Date(2025, 10, 12, 0, 0, 0, 0, time.Local)
+	// 10/9、10/10、10/11(调休)
+	assert.Equal(t, 3, WorkDays(start, end, holidays))
+	assert.Equal(t, 8, WorkDays(start, end, nil))
+}
@@ -4,8 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"os"
 	"regexp"
+	"slices"
 	"strconv"
 	"time"
 
@@ -17,25 +17,100 @@ var (
 	// 生态环境部: 首页 > 政策文件 > 部文件 > 公告, %s: ""/_1/_2/_3
 	carbonGovHost = "https://www.mee.gov.cn"
 	carbonGovPat  = "https://www.mee.gov.cn/zcwj/bwj/gg/index%s.shtml"
-	// 用“电力二氧化碳排放因子”，不用“电力碳足迹因子”
-	carbonGovSel = `//a[contains(text(), "%s年电力二氧化碳排放因子")]`
+	// %s: 年份, %s: 公告关键字，如“电力二氧化碳排放因子”
+	carbonGovSel = `//a[contains(text(), "%s年%s")]`
 	// carbonGovSel = `//a[contains(text(), "%s年") and contains(text(), "电力") and contains(text(), "碳") and contains(text(), "因子")]`
-	// 主要用于产品碳足迹核算，不常用
-	// CarbonFootprint = "电力碳足迹因子"
+
+	// 公告关键字，碳足迹因子单独发布
+	carbonGovKeywords = map[CarbonCategory]string{
+		CarbonEmission:  "电力二氧化碳排放因子",
+		CarbonFootprint: "电力碳足迹因子",
+	}
+
+	// 排放因子公告中的全国平均值及变体，表格式：表头依次为平均、不含市场化非化石电量、化石能源，其后为三个数值
+	carbonVariantTableReg = regexp.MustCompile(`不包括市场化交易的非化石能源电量.*?化石能源电力二氧化碳排放因子.*?(0\.\d{4})(0\.\d{4})(0\.\d{4})`)
+	// 行内式，如“全国化石能源电力二氧化碳排放因子0.8325”
+	carbonVariantRegs = map[CarbonCategory]*regexp.Regexp{
+		CarbonMarket: regexp.MustCompile(`不包括市场化交易的非化石能源电量[^0-9]{0,5}(0\.\d{4})`),
+		CarbonFossil: regexp.MustCompile(`化石能源电力二氧化碳排放因子[^0-9]{0,5}(0\.\d{4})`),
+	}
+)
+
+type CarbonCategory string
+
+const (
 	// 主要用于核算电力消费的二氧化碳排放量，帮助企业进行碳管理和合规
-	// CarbonEmission = "电力二氧化碳排放因子"
+	CarbonEmission CarbonCategory = "emission"
+	// 主要用于产品碳足迹核算
+	CarbonFootprint CarbonCategory = "footprint"
+	// 全国化石能源电力二氧化碳排放因子
+	CarbonFossil CarbonCategory = "fossil"
+	// 全国电力平均二氧化碳排放因子（不包括市场化交易的非化石能源电量）
+	CarbonMarket CarbonCategory = "market"
+)
+
+// ParseCarbonCategory 校验因子类型，为空时为emission
+func ParseCarbonCategory(s string) (CarbonCategory, error) {
+	category := CarbonCategory(s)
+	switch category {
+	case "":
+		return CarbonEmission, nil
+	case CarbonEmission, CarbonFootprint, CarbonFossil, CarbonMarket:
+		return category, nil
+	}
+
+	return "", fmt.Errorf("不支持的碳排因子类型: %s", s)
+}
+
+type CarbonLevel string
+
+const (
+	CarbonLevelProvince CarbonLevel = "province" // 省级
+	CarbonLevelRegion   CarbonLevel = "region"   // 区域电网
+	CarbonLevelNational CarbonLevel = "national" // 全国
+)
+
+var (
+	// 全国因子区域名，公告用“全国”，早期手工录入用“中国”
+	CarbonNationalAreas = []string{"全国", "中国"}
+
+	// 区域电网覆盖省份，依生态环境部公告划分（内蒙古计入华北）
+	carbonRegions = map[string][]string{
+		"华北": {"北京", "天津", "河北", "山西", "山东", "内蒙", "内蒙古"},
+		"东北": {"辽宁", "吉林", "黑龙江"},
+		"华东": {"上海", "江苏", "浙江", "安徽", "福建"},
+		"华中": {"河南", "湖北", "湖南", "江西"},
+		"西北": {"陕西", "甘肃", "青海", "宁夏", "新疆"},
+		"西南": {"四川", "重庆", "西藏"},
+		"南方": {"广东", "广西", "云南", "贵州", "海南"},
+	}
 )
 
 // CarbonFactor 表示一个碳排放因子记录
 type CarbonFactor struct {
-	Area  string  `json:"area"`  // 区域名称（如"全国"、"华东"、"福建省"）
-	Year  int64   `json:"year"`  // 年份（如2020）
-	Value float64 `json:"value"` // 净购入电力碳排放因子（单位：kgCO₂/kWh）
+	Area     string         `json:"area"`     // 区域名称（如"全国"、"华东"、"福建省"）
+	Category CarbonCategory `json:"category"` // 因子类型，如emission、footprint
+	Year     int64          `json:"year"`     // 年份（如2020）
+	Value    float64        `json:"value"`    // 净购入电力碳排放因子（单位：kgCO₂/kWh）
+	Source   string         `json:"source"`   // 来源文件，如公告链接
+}
+
+// CarbonRegion 获取省份所属区域电网，无匹配时返回空值
+func CarbonRegion(province string) string {
+	for region, provinces := range carbonRegions {
+		if slices.Contains(provinces, province) {
+			return region
+		}
+	}
+
+	return ""
 }
 
 type CarbonGovConfig struct {
-	Dp   chromedpx.DP `json:"dp"`
-	Year int64        `json:"year"`
+	Dp       chromedpx.DP   `json:"dp"`
+	Year     int64          `json:"year"`
+	Category CarbonCategory `json:"category"` // 因子类型：emission(默认)、footprint
+	Hooks    StepHooks      `json:"-"`        // 步骤钩子，用于统计指标及进度通知
 }
 
 func DefaultCarbonGovTask() string {
@@ -48,49 +123,93 @@ func DefaultCarbonGovTask() string {
 
 func (c CarbonGovConfig) Run(m *MailConfig) (*[]CarbonFactor, error) {
 	factors := []CarbonFactor{}
-	var pdfUrl, pdfPath, pdfText string
-	defer func() {
-		os.Remove(pdfPath)
-	}()
+	p := NewPipeline("carbon")
+	p.StepHooks = c.Hooks
+	defer p.Close()
 
 	// 2021前，不发布数据；今年数据只能明年以后发布
 	if c.Year < 2021 || c.Year >= int64(time.Now().Year()) {
 		c.Year = 0
 	}
 
-	// 1. 捕获公告文件
-	//    1.1 自定义选择器(当数据源变化，匹配失败时用
-	//    1.2 默认选择器
-	if len(c.Dp.Urls) > 0 {
-		if err := c.Dp.Run(context.Background(), &pdfUrl); err != nil {
-			return nil, err
-		}
-	} else {
-		c.mustRun(m, &pdfUrl, &pdfText)
-
-		if len(pdfText) > 0 {
-			adjustCarbons(&factors, pdfText)
-			return &factors, nil
-		}
+	if _, ok := carbonGovKeywords[c.Category]; !ok {
+		c.Category = CarbonEmission
 	}
 
-	// 2. 下载公告文件
-	if err := localize(pdfUrl, &pdfPath); err != nil {
-		return nil, err
-	}
+	pdfUrl := NewValue[string](p, "pdf_url")
+	pageUrl := NewValue[string](p, "page_url")
+	text := NewValue[string](p, "text")
+	pdf := NewFile(p, "pdf")
+	noText := func() bool { return !text.IsSet() }
 
-	// 3. 解析公告文件
-	if err := readPdf(pdfPath, -1, &pdfText); err != nil {
+	// 2. 下载公告文件(附件为文本区块时跳过)
+	localize := localizeStep("localize", pdfUrl, pdf)
+	localize.When = noText
+
+	p.Add(
+		// 1. 捕获公告文件
+		c.crawlStep(m, pdfUrl, pageUrl, text),
+		localize,
+		// 3. 解析公告文件
+		Step{
+			Name: "read",
+			In:   []Port{pdf},
+			Out:  []Port{text},
+			When: noText,
+			Run: func(ctx context.Context) error {
+				content := ""
+				if err := readPdf(pdf.Path(), -1, &content); err != nil {
+					return err
+				}
+
+				text.Set(content)
+				return nil
+			},
+		},
+	)
+
+	if err := p.Run(context.Background()); err != nil {
 		return nil, err
 	}
 
 	// 4. 获取碳排因子表
-	adjustCarbons(&factors, pdfText)
+	source := expx.If(pageUrl.IsSet(), pageUrl.Get(), pdfUrl.Get())
+	adjustCarbons(&factors, text.Get(), source, c.Category)
 
 	return &factors, nil
 }
 
-func adjustCarbons(factors *[]CarbonFactor, value string) {
+// crawlStep 捕获公告文件
+//  1. 自定义选择器(当数据源变化，匹配失败时用
+//  2. 默认选择器，附件为文本区块时直接输出文本及公告页地址
+func (c CarbonGovConfig) crawlStep(m *MailConfig, pdfUrl, pageUrl, text *Value[string]) Step {
+	return Step{
+		Name: "crawl",
+		Run: func(ctx context.Context) error {
+			if len(c.Dp.Urls) > 0 {
+				link := ""
+				if err := c.Dp.Run(ctx, &link); err != nil {
+					return err
+				}
+
+				pdfUrl.Set(link)
+				return nil
+			}
+
+			var link, content, page string
+			c.mustRun(m, &link, &content, &page)
+			if len(content) > 0 {
+				text.Set(content)
+				pageUrl.Set(page)
+			} else if len(link) > 0 {
+				pdfUrl.Set(link)
+			}
+			return nil
+		},
+	}
+}
+
+func adjustCarbons(factors *[]CarbonFactor, value string, source string, category CarbonCategory) {
 	value = regexp.MustCompile(`\s+`).ReplaceAllString(value, "")
 	years := regexp.MustCompile(`(\d{4})年`).FindStringSubmatch(value)
 	if len(years) != 2 {
@@ -112,18 +231,54 @@ func adjustCarbons(factors *[]CarbonFactor, value string) {
 
 		value, _ := strconv.ParseFloat(sub[2], 64)
 		*factors = append(*factors, CarbonFactor{
-			Year:  year,
-			Area:  sub[1],
-			Value: value,
+			Year:     year,
+			Area:     sub[1],
+			Category: category,
+			Value:    value,
+			Source:   source,
 		})
 	}
+
+	// 排放因子公告同时发布全国化石能源、市场化调整变体
+	if category == CarbonEmission {
+		adjustCarbonVariants(factors, value, year, source)
+	}
 }
 
-func (c CarbonGovConfig) mustRun(m *MailConfig, carbonUrl *string, carbonText *string) {
+func adjustCarbonVariants(factors *[]CarbonFactor, value string, year int64, source string) {
+	values := map[CarbonCategory]string{}
+	if sub := carbonVariantTableReg.FindStringSubmatch(value); len(sub) == 4 {
+		values[CarbonEmission], values[CarbonMarket], values[CarbonFossil] = sub[1], sub[2], sub[3]
+	} else {
+		for variant, reg := range carbonVariantRegs {
+			if sub := reg.FindStringSubmatch(value); len(sub) == 2 {
+				values[variant] = sub[1]
+			}
+		}
+	}
+
+	for _, variant := range []CarbonCategory{CarbonEmission, CarbonMarket, CarbonFossil} {
+		// 行内式已匹配的全国平均值优先
+		exist := slices.ContainsFunc(*factors, func(f CarbonFactor) bool {
+			return f.Area == CarbonNationalAreas[0] && f.Category == variant
+		})
+		if v, err := strconv.ParseFloat(values[variant], 64); err == nil && !exist {
+			*factors = append(*factors, CarbonFactor{
+				Year:     year,
+				Area:     CarbonNationalAreas[0],
+				Category: variant,
+				Value:    v,
+				Source:   source,
+			})
+		}
+	}
+}
+
+func (c CarbonGovConfig) mustRun(m *MailConfig, carbonUrl *string, carbonText *string, pageUrl *string) {
 
 	// 未指定有效年份时，从公告首页中捕获最新碳公告
 	pageNum := expx.If(c.Year == 0, 1, 10)
-	sel := fmt.Sprintf(carbonGovSel, expx.If(c.Year == 0, "", fmt.Sprintf("%d", c.Year)))
+	sel := fmt.Sprintf(carbonGovSel, expx.If(c.Year == 0, "", fmt.Sprintf("%d", c.Year)), carbonGovKeywords[c.Category])
 	ctx := context.Background()
 	for i := range pageNum {
 
@@ -145,13 +300,15 @@ func (c CarbonGovConfig) mustRun(m *MailConfig, carbonUrl *string, carbonText *s
 			continue
 		}
 
+		*pageUrl = url
+
 		// 2. 从公告页中捕获“附件”
 		// 2.1 附件是PDF链接
 		dp = chromedpx.DP{
 			Urls: []chromedpx.DPUrl{
 				{
 					Url:    url,
-					Clicks: []string{sel},
+					Clicks: chromedpx.Clicks(sel),
 				},
 			},
 		}
@@ -0,0 +1,64 @@
+package cronx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdjustCarbons(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		category CarbonCategory
+		want     []CarbonFactor
+	}{
+		{
+			name: "排放因子，表格式全国变体",
+			text: `表1 2021年全国电力平均二氧化碳排放因子
+				名称 全国电力平均二氧化碳排放因子 全国电力平均二氧化碳排放因子（不包括市场化交易的非化石能源电量） 全国化石能源电力二氧化碳排放因子
+				(kgCO2/kWh) 0.5568 0.5942 0.8176
+				表2 1 华东 0.5992 表3 1 福建 0.4711`,
+			category: CarbonEmission,
+			want: []CarbonFactor{
+				{Area: "华东", Category: CarbonEmission, Year: 2021, Value: 0.5992},
+				{Area: "福建", Category: CarbonEmission, Year: 2021, Value: 0.4711},
+				{Area: "全国", Category: CarbonEmission, Year: 2021, Value: 0.5568},
+				{Area: "全国", Category: CarbonMarket, Year: 2021, Value: 0.5942},
+				{Area: "全国", Category: CarbonFossil, Year: 2021, Value: 0.8176},
+			},
+		},
+		{
+			name:     "碳足迹因子，无变体",
+			text:     `2023年电力碳足迹因子 1 全国 0.6205 2 华东 0.6381`,
+			category: CarbonFootprint,
+			want: []CarbonFactor{
+				{Area: "全国", Category: CarbonFootprint, Year: 2023, Value: 0.6205},
+				{Area: "华东", Category: CarbonFootprint, Year: 2023, Value: 0.6381},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var factors []CarbonFactor
+			adjustCarbons(&factors, test.text, "", test.category)
+			assert.Equal(t, test.want, factors)
+		})
+	}
+}
+
+func TestParseCarbonCategory(t *testing.T) {
+	category, err := ParseCarbonCategory("")
+	assert.NoError(t, err)
+	assert.Equal(t, CarbonEmission, category)
+
+	for _, c := range []CarbonCategory{CarbonEmission, CarbonFootprint, CarbonFossil, CarbonMarket} {
+		category, err = ParseCarbonCategory(string(c))
+		assert.NoError(t, err)
+		assert.Equal(t, c, category)
+	}
+
+	_, err = ParseCarbonCategory("Emission")
+	assert.ErrorContains(t, err, "不支持的碳排因子类型")
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"os"
 	"regexp"
 	"strconv"
 	"time"
@@ -21,6 +20,7 @@ const (
 type TwCarbonConfig struct {
 	MaxRunYear int          `json:"max_run_year"` // 已执行的年份(公历)
 	Dp         chromedpx.DP `json:"dp"`           // 网页爬虫配置
+	Hooks      StepHooks    `json:"-"`            // 步骤钩子，用于统计指标及进度通知
 }
 
 // DefaultTwCarbonTask 默认的台湾碳排放因子获取任务
@@ -45,18 +45,15 @@ func DefaultTwCarbonTask() string {
 
 // Run 获取台湾的电力排碳系数
 func (c *TwCarbonConfig) Run(m *MailConfig) (*[]CarbonFactor, error) {
-	var url, pdfPath string
 	var year int
-	var value float64
 
 	// c.Dp.IsVisible = true
 
 	// 处理完成后清理临时文件（调试模式下保留）
-	defer func() {
-		if !c.Dp.IsVisible {
-			os.Remove(pdfPath)
-		}
-	}()
+	p := NewPipeline("twcarbon")
+	p.StepHooks = c.Hooks
+	p.Keep = c.Dp.IsVisible
+	defer p.Close()
 
 	// 避免mustAdjustTwYear影响c.Dp
 	adjustDp := c.Dp
@@ -67,54 +64,58 @@ func (c *TwCarbonConfig) Run(m *MailConfig) (*[]CarbonFactor, error) {
 		return nil, nil
 	}
 
-	ctx := context.Background()
-	actions := []Action{
-		crawlAc(ctx, adjustDp, &url),
-		localizeAc(&url, &pdfPath),
-		extractTwCarbonAc(&pdfPath, &value),
-	}
-
-	// 顺序执行各个处理步骤
-	for i, ac := range actions {
-		if err := ac(); err != nil {
-			return nil, fmt.Errorf("执行任务步骤%d失败: %w", i, err)
-		}
-
-		if c.Dp.IsVisible {
-			fmt.Printf("执行任务步骤%d成功\n", i)
-		}
+	url := NewValue[string](p, "url")
+	pdf := NewFile(p, "pdf")
+	value := NewValue[float64](p, "value")
+	p.Add(
+		crawlStep(adjustDp, url),
+		localizeStep("localize", url, pdf),
+		extractTwCarbonStep(pdf, value),
+	)
+
+	if err := p.Run(context.Background()); err != nil {
+		return nil, err
 	}
 
 	m.Send(TwCarbonTemplate{
 		Year:  year,
-		Value: value,
-	}, pdfPath)
+		Value: value.Get(),
+	}, pdf.Path())
 	if c.MaxRunYear < year {
 		c.MaxRunYear = year
 	}
 
 	return &[]CarbonFactor{
 		{
-			Year:  int64(year),
-			Area:  TaiwanAreaName,
-			Value: value,
+			Year:     int64(year),
+			Area:     TaiwanAreaName,
+			Category: CarbonEmission,
+			Value:    value.Get(),
+			Source:   url.Get(),
 		},
 	}, nil
 }
 
-func extractTwCarbonAc(path *string, value *float64) Action {
-	return func() error {
-		var content string
-		if err := readPdf(*path, 1, &content); err != nil {
-			return err
-		}
-
-		subs := regexp.MustCompile(twCarbonFactorPattern).FindStringSubmatch(content)
-		if len(subs) != 2 {
-			return fmt.Errorf("提取碳排因子失败, word: %s", content)
-		}
-
-		*value, _ = strconv.ParseFloat(subs[1], 64)
-		return nil
+// extractTwCarbonStep 提取PDF中的碳排系数
+func extractTwCarbonStep(pdf *File, value *Value[float64]) Step {
+	return Step{
+		Name: "extract",
+		In:   []Port{pdf},
+		Out:  []Port{value},
+		Run: func(ctx context.Context) error {
+			var content string
+			if err := readPdf(pdf.Path(), 1, &content); err != nil {
+				return err
+			}
+
+			subs := regexp.MustCompile(twCarbonFactorPattern).FindStringSubmatch(content)
+			if len(subs) != 2 {
+				return fmt.Errorf("提取碳排因子失败, word: %s", content)
+			}
+
+			v, _ := strconv.ParseFloat(subs[1], 64)
+			value.Set(v)
+			return nil
+		},
 	}
 }
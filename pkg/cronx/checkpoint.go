@@ -0,0 +1,234 @@
+package cronx
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	defaultCheckpointHours = 24
+	checkpointState        = "state.json"
+)
+
+// CheckpointConfig 断点续跑配置：每个步骤成功后保存中间结果，重试时自首个失败步骤继续
+type CheckpointConfig struct {
+	Dir   string `json:"dir,omitempty"`   // 断点目录，为空时不续跑
+	Hours int    `json:"hours,omitempty"` // 断点有效期(小时)，默认24，超期后重新执行全部步骤
+}
+
+// checkpointData 断点数据
+type checkpointData struct {
+	Step      int                        `json:"step"`             // 下一个待执行步骤
+	Prefix    map[string]json.RawMessage `json:"prefix,omitempty"` // 续跑起始步骤之前的步骤的输出，用于判定上游是否变化
+	Vars      map[string]json.RawMessage `json:"vars"`             // 中间变量，如URL、日期
+	Files     map[string]string          `json:"files"`            // 中间文件，值为断点目录内的文件名
+	UpdatedAt time.Time                  `json:"updated_at"`       // 保存时间
+}
+
+// checkpoint 同一任务(key相同，如区域+月份)的断点，绑定各步骤共享的中间变量及文件
+type checkpoint struct {
+	CheckpointConfig
+	key    string
+	from   int                        // 最早续跑步骤
+	watch  []string                   // from之前的步骤输出的中间变量，如URL、文件大小
+	prefix map[string]json.RawMessage // 本次执行的watch变量
+	vars   map[string]any
+	files  map[string]*string
+}
+
+// newCheckpoint 创建任务断点，未配置断点目录时各方法均不生效
+func (c CheckpointConfig) newCheckpoint(key string) *checkpoint {
+	return &checkpoint{
+		CheckpointConfig: c,
+		key:              key,
+		vars:             make(map[string]any),
+		files:            make(map[string]*string),
+	}
+}
+
+// resumable 自from步骤起可续跑，之前的步骤(如判定是否发布新电价)每次重新执行，其输出(如URL、文件大小)与断点不同时放弃断点
+func (c *checkpoint) resumable(from int) *checkpoint {
+	c.from = from
+	return c
+}
+
+// bind 绑定中间变量，v为可JSON序列化的指针
+func (c *checkpoint) bind(name string, v any) *checkpoint {
+	c.vars[name] = v
+	return c
+}
+
+// bindFile 绑定中间文件，保存断点时复制文件，恢复时复制至临时目录
+func (c *checkpoint) bindFile(name string, path *string) *checkpoint {
+	c.files[name] = path
+	return c
+}
+
+func (c *checkpoint) dir() string {
+	sum := sha256.Sum256([]byte(c.key))
+	return filepath.Join(c.Dir, hex.EncodeToString(sum[:8]))
+}
+
+func (c *checkpoint) expire() time.Time {
+	hours := c.Hours
+	if hours <= 0 {
+		hours = defaultCheckpointHours
+	}
+	return time.Now().Add(-time.Duration(hours) * time.Hour)
+}
+
+// snapshot 序列化指定中间变量
+func (c *checkpoint) snapshot(names ...string) (map[string]json.RawMessage, error) {
+	vars := make(map[string]json.RawMessage, len(names))
+	for _, name := range names {
+		raw, err := json.Marshal(c.vars[name])
+		if err != nil {
+			return nil, fmt.Errorf("序列化断点变量%s失败: %v", name, err)
+		}
+		vars[name] = raw
+	}
+	return vars, nil
+}
+
+// resume 读取有效期内的断点并恢复中间变量及文件，返回起始步骤；无断点或恢复失败时从0开始
+// 须在from之前的步骤执行后调用，上游结果变化(如发布新电价)时删除断点
+func (c *checkpoint) resume() int {
+	if len(c.Dir) == 0 {
+		return 0
+	}
+
+	c.purge()
+
+	if len(c.watch) > 0 {
+		prefix, err := c.snapshot(c.watch...)
+		if err != nil {
+			logx.Error(err)
+			return 0
+		}
+		c.prefix = prefix
+	}
+
+	buf, err := os.ReadFile(filepath.Join(c.dir(), checkpointState))
+	if err != nil {
+		return 0
+	}
+
+	var data checkpointData
+	if err := json.Unmarshal(buf, &data); err != nil || data.UpdatedAt.Before(c.expire()) {
+		c.clear()
+		return 0
+	}
+
+	if !maps.EqualFunc(data.Prefix, c.prefix, func(a, b json.RawMessage) bool { return bytes.Equal(a, b) }) {
+		logx.Infof("任务%s上游结果已变化，放弃断点", c.key)
+		c.clear()
+		return 0
+	}
+
+	for name, v := range c.vars {
+		if raw, ok := data.Vars[name]; ok {
+			if err := json.Unmarshal(raw, v); err != nil {
+				logx.Errorf("恢复断点变量%s失败: %v", name, err)
+				return 0
+			}
+		}
+	}
+
+	// 任务结束时会删除过程文件，故复制而非直接引用断点文件
+	for name, path := range c.files {
+		if file, ok := data.Files[name]; ok {
+			if err := copyToTemp(filepath.Join(c.dir(), file), path); err != nil {
+				logx.Errorf("恢复断点文件%s失败: %v", name, err)
+				return 0
+			}
+		}
+	}
+
+	logx.Infof("任务%s自断点步骤%d继续执行", c.key, data.Step)
+	return data.Step
+}
+
+// save 保存断点，step为下一个待执行步骤；保存失败不影响任务执行
+func (c *checkpoint) save(step int) {
+	if len(c.Dir) == 0 || step < c.from {
+		return
+	}
+
+	dir := c.dir()
+	if err := ensureDir(dir); err != nil {
+		logx.Errorf("创建断点目录失败: %v", err)
+		return
+	}
+
+	vars, err := c.snapshot(slices.Collect(maps.Keys(c.vars))...)
+	if err != nil {
+		logx.Errorf("保存断点失败: %v", err)
+		return
+	}
+
+	data := checkpointData{
+		Step:      step,
+		Prefix:    c.prefix,
+		Vars:      vars,
+		Files:     make(map[string]string),
+		UpdatedAt: time.Now(),
+	}
+
+	// 尚未生成的文件不记录，恢复时保留其初始值
+	for name, path := range c.files {
+		if _, err := os.Stat(*path); len(*path) == 0 || err != nil {
+			continue
+		}
+
+		file := name + filepath.Ext(*path)
+		if err := copyFile(*path, filepath.Join(dir, file)); err != nil {
+			logx.Errorf("保存断点文件%s失败: %v", name, err)
+			return
+		}
+		data.Files[name] = file
+	}
+
+	buf, err := json.Marshal(data)
+	if err != nil {
+		logx.Errorf("保存断点失败: %v", err)
+		return
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, checkpointState), buf, fileMode); err != nil {
+		logx.Errorf("保存断点失败: %v", err)
+	}
+}
+
+// clear 删除断点，任务全部步骤成功后调用
+func (c *checkpoint) clear() {
+	if len(c.Dir) > 0 {
+		os.RemoveAll(c.dir())
+	}
+}
+
+// purge 清理超过有效期的断点(如不再重试的历史任务)
+func (c *checkpoint) purge() {
+	entries, err := os.ReadDir(c.Dir)
+	if err != nil {
+		return
+	}
+
+	expire := c.expire()
+	for _, entry := range entries {
+		info, err := os.Stat(filepath.Join(c.Dir, entry.Name(), checkpointState))
+		if err != nil || info.ModTime().After(expire) {
+			continue
+		}
+		os.RemoveAll(filepath.Join(c.Dir, entry.Name()))
+	}
+}
@@ -0,0 +1,137 @@
+package cronx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckpoint(t *testing.T) {
+	assert.NoError(t, ensureDir(tempDir))
+	defer os.Remove(tempDir)
+
+	cfg := CheckpointConfig{Dir: t.TempDir()}
+	ocrCalls := 0
+	run := func(fail bool) (string, error) {
+		p := NewPipeline("dlgd")
+		defer p.Close()
+
+		url := NewValue[string](p, "url")
+		excel := NewFile(p, "excel")
+		result := ""
+		p.Add(
+			Step{Name: "crawl", Run: func(ctx context.Context) error {
+				url.Set("https://example.com/dlgd.pdf")
+				return nil
+			}},
+			Step{Name: "ocr", In: []Port{url}, Out: []Port{excel}, Run: func(ctx context.Context) error {
+				ocrCalls++
+				name := filepath.Join(tempDir, "ocr.xlsx")
+				excel.Set(name)
+				return os.WriteFile(name, []byte("ocr"), fileMode)
+			}},
+			Step{Name: "parse", In: []Port{excel}, Run: func(ctx context.Context) error {
+				if fail {
+					return errors.New("解析失败")
+				}
+				buf, err := os.ReadFile(excel.Path())
+				result = url.Get() + " " + string(buf)
+				return err
+			}},
+		)
+
+		err := p.Checkpoint(cfg, "dlgd-广东-2025年3月", 0).Run(context.Background())
+		return result, err
+	}
+
+	_, err := run(true)
+	assert.Error(t, err)
+
+	// 自解析步骤继续，不再重复OCR识别
+	result, err := run(false)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/dlgd.pdf ocr", result)
+	assert.Equal(t, 1, ocrCalls)
+
+	// 成功后删除断点
+	_, err = run(true)
+	assert.Error(t, err)
+	assert.Equal(t, 2, ocrCalls)
+
+	// 超过有效期的断点不再续跑
+	cp := cfg.newCheckpoint("dlgd-广东-2025年3月")
+	buf, err := json.Marshal(checkpointData{Step: 2, UpdatedAt: time.Now().Add(-defaultCheckpointHours*time.Hour - time.Minute)})
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(filepath.Join(cp.dir(), checkpointState), buf, fileMode))
+	assert.Equal(t, 0, cp.resume())
+	assert.NoDirExists(t, cp.dir())
+
+	// 续跑起始步骤之前不保存断点
+	cp = cfg.newCheckpoint("twdl").resumable(3)
+	cp.save(1)
+	assert.NoDirExists(t, cp.dir())
+	cp.save(3)
+	assert.Equal(t, 3, cp.resume())
+}
+
+func TestCheckpointUpstreamChanged(t *testing.T) {
+	assert.NoError(t, ensureDir(tempDir))
+	defer os.Remove(tempDir)
+
+	cfg := CheckpointConfig{Dir: t.TempDir()}
+	crawls, downloads := 0, 0
+	run := func(upstream string, fail bool) (string, error) {
+		p := NewPipeline("twdl")
+		defer p.Close()
+
+		url := NewValue[string](p, "url")
+		pdf := NewFile(p, "pdf")
+		result := ""
+		p.Add(
+			Step{Name: "extract_content", Out: []Port{url}, Run: func(ctx context.Context) error {
+				crawls++
+				url.Set(upstream)
+				return nil
+			}},
+			Step{Name: "localize", In: []Port{url}, Out: []Port{pdf}, Run: func(ctx context.Context) error {
+				downloads++
+				name := filepath.Join(tempDir, "twdl.pdf")
+				pdf.Set(name)
+				return os.WriteFile(name, []byte(url.Get()), fileMode)
+			}},
+			Step{Name: "parse", In: []Port{pdf}, Run: func(ctx context.Context) error {
+				if fail {
+					return errors.New("解析失败")
+				}
+				buf, err := os.ReadFile(pdf.Path())
+				result = string(buf)
+				return err
+			}},
+		)
+
+		err := p.Checkpoint(cfg, "twdl", 1).Run(context.Background())
+		return result, err
+	}
+
+	_, err := run("https://example.com/2025.pdf", true)
+	assert.Error(t, err)
+
+	// 上游未变化：重新判定后自解析步骤继续，不再重复下载
+	_, err = run("https://example.com/2025.pdf", true)
+	assert.Error(t, err)
+	assert.Equal(t, 2, crawls)
+	assert.Equal(t, 1, downloads)
+
+	// 上游发布新文件：放弃断点并重新下载
+	result, err := run("https://example.com/2026.pdf", false)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/2026.pdf", result)
+	assert.Equal(t, 3, crawls)
+	assert.Equal(t, 2, downloads)
+}
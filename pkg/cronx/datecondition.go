@@ -0,0 +1,217 @@
+package cronx
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"seeccloud.com/edscron/pkg/x/slicex"
+	"seeccloud.com/edscron/pkg/x/stringx"
+)
+
+const (
+	condWeekend  = "weekend"   // 周六、周日(排除调休工作日)
+	condSat      = "sat"       // 周六(兼容旧数据)
+	condSun      = "sun"       // 周日(兼容旧数据)
+	condWeekday  = "weekday:"  // 指定星期，0为周日，如weekday:6,0
+	condMonth    = "month:"    // 生效月份，如month:7,8,9
+	condCategory = "category:" // 用电类别，如category:两部制,大工业
+	condHoliday  = "holiday"   // 节假日，如holiday、holiday:3、holiday:春节,国庆节
+	condTemp     = "temp:"     // 最高气温，格式见TempCondition
+)
+
+// DateCondition 时段日期条件(字段项以";"分隔)
+//   - 限定项：Months、Categories，设置时必须满足
+//   - 触发项：Weekdays、节假日、Temp，任一满足即生效；无触发项时满足限定项即生效
+//   - 空条件表示不受限，总是生效
+type DateCondition struct {
+	Months      []int64        // 生效月份(1-12)
+	Categories  []string       // 用电类别关键字，如两部制、大工业
+	Weekdays    []time.Weekday // 生效星期(调休工作日除外)
+	Holiday     bool           // 全部节假日
+	Holidays    []string       // 指定节假日，如春节、国庆节
+	HolidayDays int            // 连续N天及以上的节假日
+	Temp        *TempCondition // 最高气温触发条件
+}
+
+// DayInfo 日期条件的求值上下文
+type DayInfo struct {
+	Time        time.Time       // 求值时间
+	Holiday     HolidayCategory // 当日假期类别
+	HolidayName string          // 节假日名称，如春节
+	HolidaySize int             // 当日所在连续假期天数
+	Category    string          // 用电类别，如工商业,两部制
+}
+
+// ParseDateCondition 解析日期条件，兼容旧数据格式
+func ParseDateCondition(s string) (DateCondition, error) {
+	var c DateCondition
+	for _, item := range strings.Split(s, fieldItemSep) {
+		item = strings.TrimSpace(item)
+		switch {
+		case item == "":
+		case item == condWeekend:
+			c.addWeekdays(time.Saturday, time.Sunday)
+		case item == condSat:
+			c.addWeekdays(time.Saturday)
+		case item == condSun:
+			c.addWeekdays(time.Sunday)
+		case strings.HasPrefix(item, condWeekday):
+			days, err := parseConditionInts(item, condWeekday, 0, 6)
+			if err != nil {
+				return c, err
+			}
+			for _, d := range days {
+				c.addWeekdays(time.Weekday(d))
+			}
+		case strings.HasPrefix(item, condMonth):
+			months, err := parseConditionInts(item, condMonth, 1, 12)
+			if err != nil {
+				return c, err
+			}
+			c.Months = slicex.RemoveDuplicates(append(c.Months, months...))
+		case strings.HasPrefix(item, condCategory):
+			c.Categories = append(c.Categories, splitConditionValues(item, condCategory)...)
+		case item == condHoliday:
+			c.Holiday = true
+		case strings.HasPrefix(item, condHoliday+":"):
+			value := strings.TrimPrefix(item, condHoliday+":")
+			if days, err := strconv.Atoi(value); err == nil {
+				if days < 1 {
+					return c, fmt.Errorf("日期条件(%s)节假日天数须大于0", item)
+				}
+				c.HolidayDays = days
+				continue
+			}
+			c.Holidays = append(c.Holidays, splitConditionValues(item, condHoliday+":")...)
+		case strings.HasPrefix(item, condTemp):
+			temp, ok := ParseTempCondition(item)
+			if !ok || temp.Temp <= 0 {
+				return c, fmt.Errorf("日期条件(%s)气温格式错误", item)
+			}
+			c.Temp = &temp
+		default:
+			return c, fmt.Errorf("日期条件(%s)无法识别", item)
+		}
+	}
+
+	return c, nil
+}
+
+// String 格式化为日期条件，如"month:7,8;holiday:3;temp:35;weekend"
+func (c DateCondition) String() string {
+	s := ""
+	if len(c.Months) > 0 {
+		s = stringx.Append(s, fieldItemSep, condMonth+stringx.Join(c.Months, fieldSubSep))
+	}
+
+	if len(c.Categories) > 0 {
+		s = stringx.Append(s, fieldItemSep, condCategory+strings.Join(c.Categories, fieldSubSep))
+	}
+
+	if c.Holiday {
+		s = stringx.Append(s, fieldItemSep, condHoliday)
+	}
+
+	if len(c.Holidays) > 0 {
+		s = stringx.Append(s, fieldItemSep, condHoliday+":"+strings.Join(c.Holidays, fieldSubSep))
+	}
+
+	if c.HolidayDays > 0 {
+		s = stringx.Append(s, fieldItemSep, fmt.Sprintf("%s:%d", condHoliday, c.HolidayDays))
+	}
+
+	if c.Temp != nil {
+		s = stringx.Append(s, fieldItemSep, c.Temp.String())
+	}
+
+	// 周六、周日沿用weekend，其他组合按星期序号
+	weekdays := slices.Clone(c.Weekdays)
+	slices.Sort(weekdays)
+	if slices.Equal(weekdays, []time.Weekday{time.Sunday, time.Saturday}) {
+		s = stringx.Append(s, fieldItemSep, condWeekend)
+	} else if len(weekdays) > 0 {
+		s = stringx.Append(s, fieldItemSep, condWeekday+stringx.Join(slicex.MapFunc(weekdays, func(d time.Weekday) int { return int(d) }), fieldSubSep))
+	}
+
+	return s
+}
+
+// IsEmpty 是否为空条件(不受限)
+func (c DateCondition) IsEmpty() bool {
+	return len(c.Months) == 0 && len(c.Categories) == 0 && !c.hasTrigger()
+}
+
+// Active 判断条件在指定日期是否生效，tempActived为Temp条件是否满足(由调用方结合天气数据计算)
+func (c DateCondition) Active(d DayInfo, tempActived bool) bool {
+	if len(c.Months) > 0 && !slices.Contains(c.Months, int64(d.Time.Month())) {
+		return false
+	}
+
+	if len(c.Categories) > 0 && !slices.ContainsFunc(c.Categories, func(s string) bool { return strings.Contains(d.Category, s) }) {
+		return false
+	}
+
+	if !c.hasTrigger() {
+		return true
+	}
+
+	// 节假日
+	if d.Holiday == HolidayOff {
+		if c.Holiday || slices.Contains(c.Holidays, d.HolidayName) || (c.HolidayDays > 0 && d.HolidaySize >= c.HolidayDays) {
+			return true
+		}
+	}
+
+	// 星期(需排除调休工作日)
+	if slices.Contains(c.Weekdays, d.Time.Weekday()) && d.Holiday != HolidayOn {
+		return true
+	}
+
+	// 最高气温
+	return c.Temp != nil && tempActived
+}
+
+// hasTrigger 是否含触发项
+func (c DateCondition) hasTrigger() bool {
+	return len(c.Weekdays) > 0 || c.Holiday || len(c.Holidays) > 0 || c.HolidayDays > 0 || c.Temp != nil
+}
+
+func (c *DateCondition) addWeekdays(days ...time.Weekday) {
+	for _, d := range days {
+		if !slices.Contains(c.Weekdays, d) {
+			c.Weekdays = append(c.Weekdays, d)
+		}
+	}
+}
+
+// parseConditionInts 解析条件项中的整数列表，如"month:7,8,9"
+func parseConditionInts(item, prefix string, min, max int64) ([]int64, error) {
+	values := make([]int64, 0)
+	for _, s := range splitConditionValues(item, prefix) {
+		v, err := strconv.ParseInt(s, 10, 64)
+		if err != nil || v < min || v > max {
+			return nil, fmt.Errorf("日期条件(%s)取值须为%d-%d的整数", item, min, max)
+		}
+		values = append(values, v)
+	}
+
+	if len(values) == 0 {
+		return nil, fmt.Errorf("日期条件(%s)缺少取值", item)
+	}
+
+	return values, nil
+}
+
+// splitConditionValues 拆分条件项取值，如"holiday:春节,国庆节"
+func splitConditionValues(item, prefix string) []string {
+	values := make([]string, 0)
+	for _, s := range strings.Split(strings.TrimPrefix(item, prefix), fieldSubSep) {
+		if s = strings.TrimSpace(s); len(s) > 0 {
+			values = append(values, s)
+		}
+	}
+	return values
+}
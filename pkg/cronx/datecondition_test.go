@@ -0,0 +1,67 @@
+package cronx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDateCondition(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    string
+		wantErr bool
+	}{
+		{name: "空条件", text: "", want: ""},
+		{name: "旧数据", text: "holiday:春节,劳动节;temp:35,3;weekend", want: "holiday:春节,劳动节;temp:35,3;weekend"},
+		{name: "连续假期", text: "holiday:3", want: "holiday:3"},
+		{name: "周六周日合并", text: "sat;sun", want: "weekend"},
+		{name: "限定项", text: "weekday:1,2;category:两部制;month:7,8", want: "month:7,8;category:两部制;weekday:1,2"},
+		{name: "月份越界", text: "month:13", wantErr: true},
+		{name: "无法识别", text: "weather>35", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseDateCondition(test.text)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, got.String())
+		})
+	}
+}
+
+func TestDateConditionActive(t *testing.T) {
+	saturday := time.Date(2025, 7, 5, 12, 0, 0, 0, time.Local)
+	monday := time.Date(2025, 7, 7, 12, 0, 0, 0, time.Local)
+	tests := []struct {
+		name        string
+		text        string
+		day         DayInfo
+		tempActived bool
+		want        bool
+	}{
+		{name: "空条件", text: "", day: DayInfo{Time: monday}, want: true},
+		{name: "周末", text: "weekend", day: DayInfo{Time: saturday}, want: true},
+		{name: "调休工作日", text: "weekend", day: DayInfo{Time: saturday, Holiday: HolidayOn}, want: false},
+		{name: "指定节假日", text: "holiday:春节", day: DayInfo{Time: monday, Holiday: HolidayOff, HolidayName: "国庆节"}, want: false},
+		{name: "连续假期", text: "holiday:3", day: DayInfo{Time: monday, Holiday: HolidayOff, HolidayName: "国庆节", HolidaySize: 7}, want: true},
+		{name: "气温", text: "temp:35", day: DayInfo{Time: monday}, tempActived: true, want: true},
+		{name: "月份不符", text: "month:8;temp:35", day: DayInfo{Time: monday}, tempActived: true, want: false},
+		{name: "类别限定", text: "category:两部制", day: DayInfo{Time: monday, Category: "工商业,单一制"}, want: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cond, err := ParseDateCondition(test.text)
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, cond.Active(test.day, test.tempActived))
+		})
+	}
+}
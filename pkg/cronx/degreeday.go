@@ -0,0 +1,139 @@
+package cronx
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/jinzhu/now"
+	"seeccloud.com/edscron/pkg/vars"
+)
+
+type DegreeInterval string
+
+type TempSource string
+
+const (
+	IntervalDay   DegreeInterval = "day"   // 按日（默认）
+	IntervalWeek  DegreeInterval = "week"  // 按ISO周
+	IntervalMonth DegreeInterval = "month" // 按月
+
+	TempAuto     TempSource = ""         // 已过日期优先实测，缺实测取预报
+	TempForecast TempSource = "forecast" // 仅预报
+	TempObserved TempSource = "observed" // 仅实测
+
+	DefaultHeatBase = 18.0 // 采暖度日基准温度(℃)
+	DefaultCoolBase = 26.0 // 制冷度日基准温度(℃)
+)
+
+// DailyTemp 单日最高/最低气温
+type DailyTemp struct {
+	Date     time.Time // 日期
+	High     float64   // 最高气温，预报取白天气温
+	Low      float64   // 最低气温，预报取夜间气温
+	Observed bool      // 是否为实测
+}
+
+// Mean 日平均气温，取最高/最低气温均值
+func (t DailyTemp) Mean() float64 {
+	return (t.High + t.Low) / 2
+}
+
+// DegreeDay 统计周期内的度日
+type DegreeDay struct {
+	Period       string    // 周期标识，如2025-08-01、2025-W31、2025-08
+	Start        time.Time // 周期起始日期(已按查询范围截取)
+	End          time.Time // 周期结束日期(含)
+	Hdd          float64   // 采暖度日
+	Cdd          float64   // 制冷度日
+	Days         int       // 有气温数据的天数
+	ExpectedDays int       // 周期应有天数
+	ObservedDays int       // 实测天数
+	MeanTemp     float64   // 有数据日的平均气温
+	Missing      []string  // 缺数日期
+}
+
+// Coverage 数据覆盖率
+func (d DegreeDay) Coverage() float64 {
+	if d.ExpectedDays == 0 {
+		return 0
+	}
+	return float64(d.Days) / float64(d.ExpectedDays)
+}
+
+// DegreeDays 按周期统计start~end(含)的采暖/制冷度日，缺数日不计入度日并记入Missing
+func DegreeDays(temps []DailyTemp, start, end time.Time, interval DegreeInterval, heatBase, coolBase float64) ([]DegreeDay, error) {
+	if len(interval) == 0 {
+		interval = IntervalDay
+	}
+
+	if interval != IntervalDay && interval != IntervalWeek && interval != IntervalMonth {
+		return nil, fmt.Errorf("未知的统计周期: %s", interval)
+	}
+
+	start = now.With(start).BeginningOfDay()
+	end = now.With(end).BeginningOfDay()
+	if end.Before(start) {
+		return nil, fmt.Errorf("结束日期早于开始日期")
+	}
+
+	byDate := make(map[string]DailyTemp, len(temps))
+	for _, t := range temps {
+		byDate[t.Date.Format(vars.DateFormat)] = t
+	}
+
+	var results []DegreeDay
+	var cur *DegreeDay
+	var tempSum float64
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		period := degreePeriod(day, interval)
+		if cur == nil || cur.Period != period {
+			if cur != nil {
+				results = append(results, closeDegreeDay(*cur, tempSum))
+			}
+			cur = &DegreeDay{Period: period, Start: day}
+			tempSum = 0
+		}
+
+		cur.End = day
+		cur.ExpectedDays++
+		t, ok := byDate[day.Format(vars.DateFormat)]
+		if !ok {
+			cur.Missing = append(cur.Missing, day.Format(vars.DateFormat))
+			continue
+		}
+
+		mean := t.Mean()
+		cur.Days++
+		cur.Hdd += math.Max(0, heatBase-mean)
+		cur.Cdd += math.Max(0, mean-coolBase)
+		tempSum += mean
+		if t.Observed {
+			cur.ObservedDays++
+		}
+	}
+
+	return append(results, closeDegreeDay(*cur, tempSum)), nil
+}
+
+// degreePeriod 日期所属周期标识
+func degreePeriod(day time.Time, interval DegreeInterval) string {
+	switch interval {
+	case IntervalWeek:
+		year, week := day.ISOWeek()
+		return fmt.Sprintf("%d-W%02d", year, week)
+	case IntervalMonth:
+		return day.Format(vars.MonthFormat)
+	default:
+		return day.Format(vars.DateFormat)
+	}
+}
+
+func closeDegreeDay(d DegreeDay, tempSum float64) DegreeDay {
+	d.Hdd = math.Round(d.Hdd*100) / 100
+	d.Cdd = math.Round(d.Cdd*100) / 100
+	if d.Days > 0 {
+		d.MeanTemp = math.Round(tempSum/float64(d.Days)*100) / 100
+	}
+	return d
+}
@@ -0,0 +1,66 @@
+package cronx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDegreeDays(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2025, 7, d, 0, 0, 0, 0, time.Local)
+	}
+
+	// 2025-07-27(周日) ~ 2025-08-02，缺07-30
+	temps := []DailyTemp{
+		{Date: day(27), High: 34, Low: 26, Observed: true}, // 均温30，CDD=4
+		{Date: day(28), High: 32, Low: 24, Observed: true}, // 均温28，CDD=2
+		{Date: day(29), High: 26, Low: 20},                 // 均温23，无度日
+		{Date: day(31), High: 20, Low: 10},                 // 均温15，HDD=3
+		{Date: day(32), High: 36, Low: 28},                 // 08-01均温32，CDD=6
+		{Date: day(33), High: 30, Low: 22},                 // 08-02均温26，无度日
+	}
+
+	t.Run("按日", func(t *testing.T) {
+		got, err := DegreeDays(temps, day(27), day(28), IntervalDay, DefaultHeatBase, DefaultCoolBase)
+		assert.NoError(t, err)
+		assert.Len(t, got, 2)
+		assert.Equal(t, "2025-07-27", got[0].Period)
+		assert.Equal(t, 4.0, got[0].Cdd)
+		assert.Equal(t, 1, got[0].ObservedDays)
+		assert.Equal(t, 2.0, got[1].Cdd)
+	})
+
+	t.Run("按周", func(t *testing.T) {
+		got, err := DegreeDays(temps, day(27), day(33), IntervalWeek, DefaultHeatBase, DefaultCoolBase)
+		assert.NoError(t, err)
+		assert.Len(t, got, 2)
+		assert.Equal(t, DegreeDay{Period: "2025-W30", Start: day(27), End: day(27), Cdd: 4, Days: 1, ExpectedDays: 1, ObservedDays: 1, MeanTemp: 30}, got[0])
+		assert.Equal(t, "2025-W31", got[1].Period)
+		assert.Equal(t, 3.0, got[1].Hdd)
+		assert.Equal(t, 8.0, got[1].Cdd)
+		assert.Equal(t, []string{"2025-07-30"}, got[1].Missing)
+		assert.InDelta(t, 5.0/6, got[1].Coverage(), 1e-9)
+	})
+
+	t.Run("按月", func(t *testing.T) {
+		got, err := DegreeDays(temps, day(27), day(33), IntervalMonth, DefaultHeatBase, DefaultCoolBase)
+		assert.NoError(t, err)
+		assert.Len(t, got, 2)
+		assert.Equal(t, "2025-07", got[0].Period)
+		assert.Equal(t, 5, got[0].ExpectedDays)
+		assert.Equal(t, 4, got[0].Days)
+		assert.Equal(t, 6.0, got[0].Cdd)
+		assert.Equal(t, 3.0, got[0].Hdd)
+		assert.Equal(t, "2025-08", got[1].Period)
+		assert.Equal(t, 6.0, got[1].Cdd)
+	})
+
+	t.Run("参数错误", func(t *testing.T) {
+		_, err := DegreeDays(temps, day(27), day(26), IntervalDay, DefaultHeatBase, DefaultCoolBase)
+		assert.Error(t, err)
+		_, err = DegreeDays(temps, day(27), day(28), "year", DefaultHeatBase, DefaultCoolBase)
+		assert.Error(t, err)
+	})
+}
@@ -2,18 +2,26 @@ package cronx
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"time"
 
 	"github.com/pdfcpu/pdfcpu/pkg/api"
-	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/rs/xid"
+	"seeccloud.com/edscron/pkg/chromedpx"
+	"seeccloud.com/edscron/pkg/x/slicex"
 	"seeccloud.com/edscron/pkg/x/timex"
 )
 
+// minTableConfidence PDF文本层表格的最低时段解析置信度，低于时改用OCR识别
+const minTableConfidence = 0.8
+
 // DlgdRow 代理购电电价条目
 type DlgdRow struct {
 	Area       string    `json:"area"`                              // 区域名称
@@ -43,117 +51,327 @@ type DlgdRow struct {
 	DocNo      string    `json:"doc_no"`                            // 电价政策文档编号
 }
 
-// Run 执行代理购电任务，返回电价列表
+// Run 执行代理购电任务，返回电价列表、时段划分及其解析诊断
 // 参数m用于任务失败时通知系统管理员
-func (d DlgdConfig) Run(m *MailConfig) (*[]DlgdRow, *[]DlgdHour, error) {
-	var url, pdf, excel string
-	var actionOffset int
+func (d DlgdConfig) Run(m *MailConfig) (*[]DlgdRow, *[]DlgdHour, *DlgdHourDiagnostics, error) {
+	return d.run(m, nil)
+}
+
+// Reprocess 以当前解析程序重新解析归档的原始文件，无需重新抓取
+func (d DlgdConfig) Reprocess(m *MailConfig, a Artifact) (*[]DlgdRow, *[]DlgdHour, *DlgdHourDiagnostics, error) {
+	return d.run(m, &a)
+}
+
+// dlgdParsed 电价表解析结果
+type dlgdParsed struct {
+	Rows  []DlgdRow           `json:"rows"`
+	Hours []DlgdHour          `json:"hours"`
+	Diag  DlgdHourDiagnostics `json:"diag"`
+}
 
+// run 执行任务，archived不为空时跳过抓取及归档，解析归档的原始文件
+func (d DlgdConfig) run(m *MailConfig, archived *Artifact) (*[]DlgdRow, *[]DlgdHour, *DlgdHourDiagnostics, error) {
 	// 【调试模式】：正式版本注释掉以下行
-	testMode := false
-	// d.Dp.IsVisible = testMode
-	// excel = "temp/d4a5161083iort2c3q60.xlsx"
-	// actionOffset = 6
+	// d.Dp.IsVisible = true
 
+	p := NewPipeline("dlgd")
+	p.StepHooks = d.Hooks
+	// 调试模式下保留过程文件
+	p.Keep = d.Dp.IsVisible
+
+	url := NewValue[string](p, "url")
+	pdf := NewFile(p, "pdf")
+	excel := NewFile(p, "excel")
+	parsed := NewValue[dlgdParsed](p, "parsed")
+	artifact := NewValue[Artifact](p, "artifact")
+	artifact.Set(Artifact{FetchedAt: time.Now()})
+
+	archive := d.Archive
+	if archived != nil {
+		archive = ArtifactStore{}
+	}
+
+	// 先删除过程文件(含下载目录内的文件)，再删除下载目录
 	defer func() {
-		// 调试模式下保留过程文件，否则清理临时文件
-		if !d.Dp.IsVisible {
-			os.Remove(pdf)
-			os.Remove(excel)
+		p.Close()
+		if !p.Keep {
 			os.Remove(d.Dp.DownloadDir)
 		}
+
+		a := artifact.Get()
+		a.Url = url.Get()
+		archive.done(a)
 	}()
 
-	rows := make([]DlgdRow, 0)
-	hours := make([]DlgdHour, 0)
-	ctx := context.Background()
+	converter, err := d.Ocr.NewConverter()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
 	// 定义任务执行流程
-	actions := []Action{
-		crawlAndLocalizeAc(ctx, d.Dp, &pdf),                     // 0-网页抓取并下载
-		cropAc(&pdf, d.TitlePat),                                // 1-裁剪处理
-		thresholdAc(&pdf, d.Threshold),                          // 2-图片阈值处理
-		image2PdfAc(&pdf),                                       // 3-图片转PDF
-		ocrPdfAc(d.Ocr, &pdf, &url),                             // 4-OCR识别
-		localizeAc(&url, &excel),                                // 5-下载OCR结果
-		unexcelizeAc(&excel, d.Area, d.TitlePat, &rows, &hours), // 6-解析Excel数据
-	}
-
-	// 顺序执行各个处理步骤
-	for i, ac := range actions[actionOffset:] {
-		if err := ac(); err != nil {
-			return nil, nil, fmt.Errorf("执行任务步骤%d失败: %w", i, err)
-		}
+	p.Add(
+		crawlLocalizeStep(d.Dp, url, pdf),                                     // 网页抓取并下载
+		archiveStep("archive_source", archive, artifact, ArtifactSource, pdf), // 归档原始文件
+		cropStep(pdf, d.TitlePat),                                             // 裁剪处理
+		thresholdStep(pdf, d.Threshold),                                       // 图片阈值处理
+		image2PdfStep(pdf),                                                    // 图片转PDF
+		tableStep(converter, pdf, excel, d.validTable),                        // 提取表格(PDF文本层或OCR识别)
+		archiveStep("archive_table", archive, artifact, ArtifactTable, excel), // 归档表格识别结果
+		unexcelizeStep(excel, d.Area, d.TitlePat, parsed),                     // 解析Excel数据
+	)
 
-		if testMode {
-			logx.Infof("执行%sdlgd任务步骤%d/%d成功\n", d.Area, i+1, len(actions)-actionOffset)
+	// 电价表以网页表格发布时，直接解析表格，无需下载及OCR识别
+	if d.Dp.Outer.Table {
+		p.Steps = nil
+		p.Add(
+			crawlTableStep(d.Dp, url, pdf),                                        // 网页表格抓取
+			archiveStep("archive_source", archive, artifact, ArtifactSource, pdf), // 归档网页表格
+			untableStep(pdf, d.Area, d.TitlePat, parsed),                          // 解析网页表格
+		)
+	}
+
+	if archived != nil {
+		// 重新解析：跳过抓取，解析归档的原始文件
+		path := ""
+		if err := d.Archive.Open(*archived, ArtifactSource, &path); err != nil {
+			return nil, nil, nil, err
 		}
+		pdf.Set(path)
+		p.Without("crawl")
+	} else {
+		// 断点：重试时自首个失败步骤继续，无需重新抓取及OCR识别
+		p.Checkpoint(d.Checkpoint, fmt.Sprintf("dlgd-%s-%s", d.Area, d.Month), 0)
+	}
+
+	if err := p.Run(context.Background()); err != nil {
+		return nil, nil, nil, err
 	}
 
-	rows = specialiseDlgd(rows)
+	result := parsed.Get()
+	rows := specialiseDlgd(result.Rows)
 	rowss, err := emptyValueErr(m, d, &rows)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
-	return rowss, &hours, nil
+	return rowss, &result.Hours, &result.Diag, nil
 
 }
 
-// cropAc 创建文件裁剪任务
-func cropAc(name *string, pattern string) Action {
-	return func() error {
-		ext := strings.ToLower(path.Ext(*name))
-		if ext != ".pdf" {
-			return nil
-		}
+// validTable 校验表格可解析出完整的电价及时段，用于判断PDF文本层提取结果是否可用
+func (d DlgdConfig) validTable(excel string) error {
+	rows := make([]DlgdRow, 0)
+	hours := make([]DlgdHour, 0)
+	diag := DlgdHourDiagnostics{}
+	if err := unexcelize(excel, d.Area, d.TitlePat, &rows, &hours, &diag); err != nil {
+		return err
+	}
 
-		pageCount, err := api.PageCountFile(*name)
-		if err != nil {
-			return fmt.Errorf("获取PDF页面数量失败: %w", err)
+	if len(rows) == 0 {
+		return errors.New("未解析出电价")
+	}
+
+	for _, row := range rows {
+		if len(row.Category) == 0 || len(row.Voltage) == 0 || row.Sharp+row.Peak+row.Flat+row.Valley+row.Deep == 0 {
+			return fmt.Errorf("电价条目不完整: %s %s", row.Category, row.Voltage)
 		}
+	}
 
-		re := regexp.MustCompile(pattern)
-		re2 := regexp.MustCompile(fmt.Sprintf(`%s|%s`, voltageName, voltageSZName))
-		for i := 1; i <= pageCount; i++ {
-			content := ""
-			err = readPdf(*name, i, &content)
+	if len(diag.Unmatched) > 0 && diag.Confidence < minTableConfidence {
+		return fmt.Errorf("时段解析置信度过低: %.2f", diag.Confidence)
+	}
+
+	return nil
+}
+
+// cropStep 裁剪PDF中电价表所在页面，图片不处理
+func cropStep(file *File, pattern string) Step {
+	return Step{
+		Name: "crop",
+		In:   []Port{file},
+		Run: func(ctx context.Context) error {
+			name := file.Path()
+			ext := strings.ToLower(path.Ext(name))
+			if ext != ".pdf" {
+				return nil
+			}
+
+			pageCount, err := api.PageCountFile(name)
 			if err != nil {
-				return fmt.Errorf("读取PDF页面%d内容失败: %w", i, err)
+				return fmt.Errorf("获取PDF页面数量失败: %w", err)
 			}
 
-			if re.MatchString(content) && re2.MatchString(content) {
-				err = crop(*name, *name, []string{fmt.Sprintf("%d", i)})
+			re := regexp.MustCompile(pattern)
+			re2 := regexp.MustCompile(fmt.Sprintf(`%s|%s`, voltageName, voltageSZName))
+			for i := 1; i <= pageCount; i++ {
+				content := ""
+				err = readPdf(name, i, &content)
 				if err != nil {
-					return fmt.Errorf("裁剪PDF页面%d失败: %w", i, err)
+					return fmt.Errorf("读取PDF页面%d内容失败: %w", i, err)
 				}
 
-				break
+				if re.MatchString(content) && re2.MatchString(content) {
+					key, err := file.Key()
+					if err != nil {
+						return err
+					}
+
+					err = crop(name, name, []string{fmt.Sprintf("%d", i)})
+					if err != nil {
+						return fmt.Errorf("裁剪PDF页面%d失败: %w", i, err)
+					}
+
+					file.Derive(key, fmt.Sprintf("crop%d", i))
+					break
+				}
 			}
-		}
 
-		return nil
+			return nil
+		},
 	}
 }
 
-// thresholdAc 创建图片阈值处理任务
-func thresholdAc(name *string, th int) Action {
-	return func() error {
-		return thresholding(*name, th)
+// thresholdStep 图片阈值处理
+func thresholdStep(file *File, th int) Step {
+	return Step{
+		Name: "threshold",
+		In:   []Port{file},
+		Run: func(ctx context.Context) error {
+			if th <= 0 || strings.EqualFold(path.Ext(file.Path()), ".pdf") {
+				return nil
+			}
+
+			key, err := file.Key()
+			if err != nil {
+				return err
+			}
+
+			if err := thresholding(file.Path(), th); err != nil {
+				return err
+			}
+
+			file.Derive(key, fmt.Sprintf("th%d", th))
+			return nil
+		},
+	}
+}
+
+// image2PdfStep 图片转PDF
+func image2PdfStep(file *File) Step {
+	return Step{
+		Name: "image2pdf",
+		In:   []Port{file},
+		Run: func(ctx context.Context) error {
+			name := file.Path()
+			if strings.EqualFold(path.Ext(name), ".pdf") {
+				return nil
+			}
+
+			key, err := file.Key()
+			if err != nil {
+				return err
+			}
+
+			if err := image2Pdf(name, &name); err != nil {
+				return err
+			}
+
+			file.Set(name)
+			file.Derive(key, "pdf")
+			return nil
+		},
+	}
+}
+
+// unexcelizeStep Excel解析
+func unexcelizeStep(excel *File, area string, titlePat string, parsed *Value[dlgdParsed]) Step {
+	return Step{
+		Name: "unexcelize",
+		In:   []Port{excel},
+		Out:  []Port{parsed},
+		Run: func(ctx context.Context) error {
+			result := dlgdParsed{Rows: make([]DlgdRow, 0), Hours: make([]DlgdHour, 0)}
+			if err := unexcelize(excel.Path(), area, titlePat, &result.Rows, &result.Hours, &result.Diag); err != nil {
+				return err
+			}
+
+			parsed.Set(result)
+			return nil
+		},
 	}
 }
 
-// image2PdfAc 创建图片转PDF任务
-func image2PdfAc(name *string) Action {
-	return func() error {
-		return image2Pdf(*name, name)
+// crawlTableStep 网页表格抓取，表格(chromedpx.DPTable的JSON)保存为临时文件以便归档
+func crawlTableStep(dp chromedpx.DP, url *Value[string], file *File) Step {
+	return Step{
+		Name:    "crawl",
+		Out:     []Port{file},
+		Timeout: crawlTimeout,
+		Retry:   1,
+		Backoff: netBackoff,
+		Run: func(ctx context.Context) error {
+			content := ""
+			if err := dp.Run(ctx, &content); err != nil {
+				return err
+			}
+
+			if err := ensureDir(tempDir); err != nil {
+				return fmt.Errorf("创建临时目录失败: %w", err)
+			}
+
+			name := filepath.Join(tempDir, xid.New().String()+".json")
+			if err := os.WriteFile(name, []byte(content), fileMode); err != nil {
+				return err
+			}
+
+			url.Set(dp.Urls[len(dp.Urls)-1].Url)
+			file.Set(name)
+			return nil
+		},
+	}
+}
+
+// untableStep 网页表格解析，file为chromedpx.DPTable的JSON文件
+func untableStep(file *File, area string, titlePat string, parsed *Value[dlgdParsed]) Step {
+	return Step{
+		Name: "untable",
+		In:   []Port{file},
+		Out:  []Port{parsed},
+		Run: func(ctx context.Context) error {
+			buf, err := os.ReadFile(file.Path())
+			if err != nil {
+				return fmt.Errorf("读取网页表格失败: %w", err)
+			}
+
+			var t chromedpx.DPTable
+			if err := json.Unmarshal(buf, &t); err != nil {
+				return fmt.Errorf("解析网页表格失败: %w", err)
+			}
+
+			result := dlgdParsed{Rows: make([]DlgdRow, 0), Hours: make([]DlgdHour, 0)}
+			if err := untabulate(dlgdGrid(t), area, titlePat, &result.Rows, &result.Hours, &result.Diag); err != nil {
+				return err
+			}
+
+			parsed.Set(result)
+			return nil
+		},
 	}
 }
 
-// unexcelizeAc 创建Excel解析任务
-func unexcelizeAc(excel *string, area string, titlePat string, rows *[]DlgdRow, hours *[]DlgdHour) Action {
-	return func() error {
-		return unexcelize(*excel, area, titlePat, rows, hours)
+// dlgdGrid 网页表格转为与Excel一致的网格：表格前文本(含标题)为首行，表注逐行置于首列
+func dlgdGrid(t chromedpx.DPTable) [][]string {
+	blank := regexp.MustCompile(`\s+`)
+	grid := [][]string{{blank.ReplaceAllString(t.Before, "")}}
+	for _, row := range t.Rows {
+		grid = append(grid, slicex.MapFunc(row, func(cell string) string { return blank.ReplaceAllString(cell, "") }))
 	}
+
+	for _, line := range strings.Split(t.After, "\n") {
+		grid = append(grid, []string{blank.ReplaceAllString(line, "")})
+	}
+
+	return grid
 }
 
 // AutoFill 为电价列表填充字段
@@ -65,7 +65,7 @@ var (
 	// 西藏电网：工商业电价固定
 	xizang = "西藏"
 
-	// 两省电价和最高气温挂钩
+	// 两省电价和最高气温挂钩，缺省参考省会(用于初始化天气任务)，规则中可另行指定城市
 	CapitalWeather = map[string]any{
 		"广东": nil,
 		"四川": nil,
@@ -89,14 +89,20 @@ func GetDefaultCity(cty string) string {
 	return ""
 }
 
+// monthDigitReg 月份中的年、月数字，如"2025-01"、"2025年1月"均为[2025 1]
+var monthDigitReg = regexp.MustCompile(`[1-9]\d*`)
+
 // DlgdConfig 代理购电任务配置
 type DlgdConfig struct {
-	Area      string       `json:"area"`      // 区域名称
-	Month     string       `json:"month"`     // 执行月份，格式"2025年3月"
-	Dp        chromedpx.DP `json:"dp"`        // 网页爬虫配置
-	TitlePat  string       `json:"titlePat"`  // 电价表标题正则表达式
-	Threshold int          `json:"threshold"` // 图片底纹阈值(230~245)
-	Ocr       AliOcr       `json:"ocr"`       // 阿里云OCR配置
+	Area       string           `json:"area"`       // 区域名称
+	Month      string           `json:"month"`      // 执行月份，格式"2025年3月"
+	Dp         chromedpx.DP     `json:"dp"`         // 网页爬虫配置
+	TitlePat   string           `json:"titlePat"`   // 电价表标题正则表达式
+	Threshold  int              `json:"threshold"`  // 图片底纹阈值(230~245)
+	Ocr        OcrConfig        `json:"ocr"`        // 文档转换服务配置
+	Archive    ArtifactStore    `json:"archive"`    // 原始文件及识别结果归档
+	Checkpoint CheckpointConfig `json:"checkpoint"` // 断点续跑配置
+	Hooks      StepHooks        `json:"-"`          // 步骤钩子，用于统计指标及进度通知
 }
 
 type MiniDlgdConfig struct {
@@ -104,9 +110,39 @@ type MiniDlgdConfig struct {
 	City     string `json:"city"`     // 城
 	Area     string `json:"area"`     // 区
 	Month    string `json:"month"`    // 月，格式"2006年1月"
+
+	// 跳过指定月份的入库校验，如电压等级调整已人工确认，格式同Month；仅该月生效，次月恢复校验
+	UncheckedMonth string `json:"uncheckedMonth,omitempty"`
+
+	// 网页爬虫配置，用于接入新数据源或站点改版：urls非空时替换默认操作流程，outer.pattern非空时替换默认结果提取；下载目录不可配置
+	Dp *chromedpx.DP `json:"dp,omitempty"`
+}
+
+// Unchecked 当月是否跳过入库校验
+func (m MiniDlgdConfig) Unchecked() bool {
+	unchecked := monthDigitReg.FindAllString(m.UncheckedMonth, -1)
+	return len(unchecked) == 2 && slices.Equal(unchecked, monthDigitReg.FindAllString(m.Month, -1))
+}
+
+func NewDlgdConfig(mini MiniDlgdConfig, ocr OcrConfig) DlgdConfig {
+	cfg := defaultDlgdConfig(mini, ocr)
+	if mini.Dp == nil {
+		return cfg
+	}
+
+	cfg.Dp.IsVisible = mini.Dp.IsVisible
+	if len(mini.Dp.Urls) > 0 {
+		cfg.Dp.Urls = mini.Dp.Urls
+	}
+	if len(mini.Dp.Outer.Pattern) > 0 {
+		cfg.Dp.Outer = mini.Dp.Outer
+	}
+
+	return cfg
 }
 
-func NewDlgdConfig(mini MiniDlgdConfig, ocr AliOcr) DlgdConfig {
+// defaultDlgdConfig 按省市生成默认爬虫配置
+func defaultDlgdConfig(mini MiniDlgdConfig, ocr OcrConfig) DlgdConfig {
 	var cfg DlgdConfig
 	cfg.Ocr = ocr
 	cfg.Area = mini.Province
@@ -131,7 +167,7 @@ func NewDlgdConfig(mini MiniDlgdConfig, ocr AliOcr) DlgdConfig {
 
 	// "2025-01" → "2025年01月|2025年1月"
 	monthPat := mini.Month
-	subs := regexp.MustCompile(`[1-9]\d*`).FindAllString(mini.Month, -1)
+	subs := monthDigitReg.FindAllString(mini.Month, -1)
 	if len(subs) == 2 {
 		monthPat = fmt.Sprintf("%s年%02s月|%s年%s月", subs[0], subs[1], subs[0], subs[1])
 		cfg.Month = fmt.Sprintf("%s年%s月", subs[0], subs[1])
@@ -148,7 +184,7 @@ func NewDlgdConfig(mini MiniDlgdConfig, ocr AliOcr) DlgdConfig {
 		cfg.Dp.Urls = []chromedpx.DPUrl{
 			{
 				Url:    url,
-				Clicks: []string{"信息公开", "电价及收费标准", "+代理购电-" + monthPat},
+				Clicks: chromedpx.Clicks("信息公开", "电价及收费标准", "+代理购电-"+monthPat),
 			},
 		}
 		if mini.Province == yunnan {
@@ -171,7 +207,7 @@ func NewDlgdConfig(mini MiniDlgdConfig, ocr AliOcr) DlgdConfig {
 		cfg.Dp.Urls = []chromedpx.DPUrl{
 			{
 				Url:    neimengDlgdUrl,
-				Clicks: []string{"主动公开信息", "供电企业电价和收费标准", "+代理购电-" + monthPat},
+				Clicks: chromedpx.Clicks("主动公开信息", "供电企业电价和收费标准", "+代理购电-"+monthPat),
 			},
 		}
 		cfg.Dp.Outer = chromedpx.DPOuter{
@@ -186,17 +222,17 @@ func NewDlgdConfig(mini MiniDlgdConfig, ocr AliOcr) DlgdConfig {
 	cfg.Dp.Urls = []chromedpx.DPUrl{
 		{
 			Url:    guojiaCitySelectUrl,
-			Clicks: []string{"*确认", "city_select", mini.Province}, // 如站点升级公告弹出框
+			Clicks: chromedpx.Clicks("*确认", "city_select", mini.Province), // 如站点升级公告弹出框
 		},
 		{
 			Url:    guojiaDlgdUrl,
-			Clicks: []string{"*确认", mini.City, mini.Area, "代理购电", "+代理购电-" + monthPat},
+			Clicks: chromedpx.Clicks("*确认", mini.City, mini.Area, "代理购电", "+代理购电-"+monthPat),
 		},
 	}
 
 	if mini.Province == xizang {
 		// 西藏电网
-		cfg.Dp.Urls[1].Clicks = []string{"*确认", mini.City, mini.Area, "工商业用电", "工商业用电价格表"}
+		cfg.Dp.Urls[1].Clicks = chromedpx.Clicks("*确认", mini.City, mini.Area, "工商业用电", "工商业用电价格表")
 	}
 
 	cfg.Dp.Outer = chromedpx.DPOuter{
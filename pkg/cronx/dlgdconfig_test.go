@@ -0,0 +1,21 @@
+package cronx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"seeccloud.com/edscron/pkg/chromedpx"
+)
+
+func TestNewDlgdConfigDp(t *testing.T) {
+	mini := MiniDlgdConfig{Province: "河北省", City: "石家庄市", Month: "2025年8月"}
+	def := NewDlgdConfig(mini, OcrConfig{})
+
+	// 仅替换操作流程，保留默认结果提取及下载目录
+	urls := []chromedpx.DPUrl{{Url: "https://example.com", Clicks: chromedpx.Clicks("代理购电")}}
+	mini.Dp = &chromedpx.DP{Urls: urls, DownloadDir: "/tmp"}
+	cfg := NewDlgdConfig(mini, OcrConfig{})
+	assert.Equal(t, urls, cfg.Dp.Urls)
+	assert.Equal(t, def.Dp.Outer, cfg.Dp.Outer)
+	assert.NotEqual(t, "/tmp", cfg.Dp.DownloadDir)
+}
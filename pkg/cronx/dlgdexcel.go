@@ -51,7 +51,7 @@ func (row *DlgdRow) format(unit float64) {
 }
 
 // unexcelize 从Excel文件解析电价数据
-func unexcelize(name string, area string, titlePat string, dlgdRows *[]DlgdRow, dlgdHours *[]DlgdHour) error {
+func unexcelize(name string, area string, titlePat string, dlgdRows *[]DlgdRow, dlgdHours *[]DlgdHour, diag *DlgdHourDiagnostics) error {
 	// 打开Excel文件
 	f, err := excelize.OpenFile(name)
 	if err != nil {
@@ -65,31 +65,41 @@ func unexcelize(name string, area string, titlePat string, dlgdRows *[]DlgdRow,
 		return err
 	}
 
-	// 计算最大行列数
-	rowNum := len(rows)
+	// 计算最大列数，按单元格读取以填充合并单元格
 	colNum := 0
 	if maxs, ok := slicex.MaxFunc(rows, func(row []string) int { return len(row) }); ok {
 		colNum = len(maxs)
 	}
+	grid := slicex.NewFunc(len(rows), func(i int) []string {
+		return slicex.NewFunc(colNum, func(j int) string { return mustCell(f, defSheet, i+1, j+1) })
+	})
+
+	return untabulate(grid, area, titlePat, dlgdRows, dlgdHours, diag)
+}
+
+// untabulate 从表格解析电价数据，合并单元格已按所跨行列填充，表格后的备注用于解析时段
+func untabulate(grid [][]string, area string, titlePat string, dlgdRows *[]DlgdRow, dlgdHours *[]DlgdHour, diag *DlgdHourDiagnostics) error {
+	colNum := 0
+	if maxs, ok := slicex.MaxFunc(grid, func(row []string) int { return len(row) }); ok {
+		colNum = len(maxs)
+	}
 
 	// 提取关键信息：匹配的电价表标题后的电压等级行、列和单位
-	volRow, volCol, unit := extInfo(f, titlePat, rowNum, colNum)
+	volRow, volCol, unit := extInfo(grid, titlePat)
 	volReg := regexp.MustCompile(voltagePattern)
 	volSZReg := regexp.MustCompile(voltageSZPattern)
 
 	// 获取标题行
-	titles := slicex.NewFunc(colNum, func(i int) string { return mustCell(f, defSheet, volRow, i+1) })
+	titles := slicex.NewFunc(colNum, func(i int) string { return cellAt(grid, volRow, i) })
 
 	// 遍历数据行
 	comment := ""
-	for row := volRow + 1; row <= rowNum; row++ {
-		cell := mustCell(f, defSheet, row, volCol)
+	for row := volRow + 1; row < len(grid); row++ {
+		cell := cellAt(grid, row, volCol)
 		// 匹配电压等级行
 		if volReg.MatchString(cell) || volSZReg.MatchString(cell) {
 			// 获取当前行所有单元格值
-			values := slicex.NewFunc(colNum, func(i int) string {
-				return mustCell(f, defSheet, row, i+1)
-			})
+			values := slicex.NewFunc(colNum, func(i int) string { return cellAt(grid, row, i) })
 
 			// 解析数据到结构体
 			dr := DlgdRow{Area: area}
@@ -101,10 +111,12 @@ func unexcelize(name string, area string, titlePat string, dlgdRows *[]DlgdRow,
 		}
 
 		// 收集备注信息
-		comment += fmt.Sprintln(mustCell(f, defSheet, row, 1))
+		comment += fmt.Sprintln(cellAt(grid, row, 0))
 	}
 
-	*dlgdHours = append(*dlgdHours, NewDlgdHours(area, comment)...)
+	hours, d := ParseDlgdHours(area, comment)
+	*dlgdHours = append(*dlgdHours, hours...)
+	*diag = d
 
 	return nil
 }
@@ -165,28 +177,28 @@ func (row *DlgdRow) splitSZCategory() {
 	}
 }
 
-// extInfo 提取Excel中的关键信息
+// extInfo 提取表格中的关键信息
 //
 // 返回:
-//   - row: 电压等级所在行号
-//   - col: 电压等级所在列号
+//   - row: 电压等级所在行号(0-based)，未找到时为-1
+//   - col: 电压等级所在列号(0-based)，未找到时为-1
 //   - unit: 电价单位
-func extInfo(f *excelize.File, titlePat string, rowNum int, colNum int) (row int, col int, unit string) {
+func extInfo(grid [][]string, titlePat string) (row int, col int, unit string) {
 	titleReg := regexp.MustCompile(titlePat)
 	afterTitle := false
+	row, col = -1, -1
 	unit = yuanUnit // 默认单位
-	for i := 1; i <= rowNum; i++ {
+	for i, cells := range grid {
 
 		// 先匹配电价表标题，因同一份文件中可能包含多个区域电价表
 		if !afterTitle {
-			if titleReg.MatchString(mustRange(f, defSheet, i, 1, i, colNum, true)) {
+			if titleReg.MatchString(joinMerged(cells)) {
 				afterTitle = true
 			}
 			continue
 		}
 
-		for j := 1; j <= colNum; j++ {
-			cell := mustCell(f, defSheet, i, j)
+		for j, cell := range cells {
 			// 定位电压等级列
 			if cell == voltageName || cell == voltageSZName {
 				row = i
@@ -202,6 +214,25 @@ func extInfo(f *excelize.File, titlePat string, rowNum int, colNum int) (row int
 	return
 }
 
+// cellAt 安全获取表格单元格，无效坐标返回空字符串
+func cellAt(grid [][]string, row int, col int) string {
+	if row < 0 || row >= len(grid) || col < 0 || col >= len(grid[row]) {
+		return ""
+	}
+	return grid[row][col]
+}
+
+// joinMerged 拼接行内单元格，忽略合并单元格的重复值，同mustRange
+func joinMerged(cells []string) string {
+	var sb strings.Builder
+	for _, cell := range cells {
+		if sb.Len() == 0 || !strings.HasSuffix(sb.String(), cell) {
+			sb.WriteString(cell)
+		}
+	}
+	return sb.String()
+}
+
 // mustCell 安全获取单元格值
 //
 // 参数:
@@ -6,6 +6,7 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"seeccloud.com/edscron/pkg/x/slicex"
 	"seeccloud.com/edscron/pkg/x/stringx"
@@ -27,6 +28,8 @@ var (
 	periodReg      = regexp.MustCompile(fmt.Sprintf(`(%s)(?:为|\d+小时|\(|:|每日)*(%s)`, periodNamePat, periodValuePat))
 	periodRevReg   = regexp.MustCompile(fmt.Sprintf(`(%s)(?:为)?(%s)`, periodValuePat, periodNamePat))
 	periodOtherReg = regexp.MustCompile(`(其[他它余](?:时段)?)(?:为)?(平段|平时)`)
+	// 峰时段6小时、高峰时段(共8小时)
+	periodStatedReg = regexp.MustCompile(fmt.Sprintf(`(%s)(?:为|:|\()*共?(\d+(?:\.\d+)?)个?小时`, periodNamePat))
 
 	conditionPat = fmt.Sprintf(`%s|%s|%s|%s|%s`, tempPat, weekendPat, monthPat, holidayPat, categoryPat)
 	conditionReg = regexp.MustCompile(conditionPat)
@@ -83,15 +86,16 @@ var (
 )
 
 type DlgdHour struct {
-	Area          string `json:"area"`          // 区域
-	DocNo         string `json:"docNo"`         // 电价政策文号
-	Name          string `json:"name"`          // 时段名称
-	Value         string `json:"value"`         // 时段值
-	Temp          string `json:"temp"`          // 温度条件
-	Months        string `json:"months"`        // 月份条件
-	WeekendMonths string `json:"weekendMonths"` // 月份-休息日条件
-	Holidays      string `json:"holidays"`      // 节假日条件
-	Categories    string `json:"categories"`    // 用电类别条件
+	Area          string  `json:"area"`          // 区域
+	DocNo         string  `json:"docNo"`         // 电价政策文号
+	Name          string  `json:"name"`          // 时段名称
+	Value         string  `json:"value"`         // 时段值
+	Temp          string  `json:"temp"`          // 温度条件
+	Months        string  `json:"months"`        // 月份条件
+	WeekendMonths string  `json:"weekendMonths"` // 月份-休息日条件
+	Holidays      string  `json:"holidays"`      // 节假日条件
+	Categories    string  `json:"categories"`    // 用电类别条件
+	Stated        float64 `json:"stated"`        // 政策所述每日时长(小时)，用于校验
 
 	// 影子条件，不对外暴露
 	_months        []int64  `json:"-"` // 月份条件
@@ -155,33 +159,29 @@ func mergeHours(hours []DlgdHour, category string, month int64) ([10]string, boo
 		// 2.1 月份条件存在且满足时，其他条件忽略
 		inMonth := slices.Contains(h._months, month)
 
-		date := ""
+		var cond DateCondition
 		// 2.2 节假日, "holiday:春节,劳动节,国庆节"、"holiday:3"
 		if !inMonth && len(h.Holidays) > 0 {
 			if day, ok := matchDayNum(h._holidays[0]); ok {
-				date = stringx.Append(date, fieldItemSep, fmt.Sprintf("holiday:%d", day))
+				cond.HolidayDays = day
 			} else {
-				date = stringx.Append(date, fieldItemSep, fmt.Sprintf("holiday:%s", strings.Join(h._holidays, fieldSubSep)))
+				cond.Holidays = h._holidays
 			}
 		}
 
-		// 2.3 最高气温, "temp:35"、"temp:35,3"（35℃以上连续3天，第三天触发）
+		// 2.3 最高气温, "temp:35"、"temp:35,3"（35℃以上连续3天，第三天触发）、"temp:35,3,avg@广州|深圳"（指定城市及聚合方式）
 		if !inMonth && len(h.Temp) > 0 {
-			subs := tempSubReg.FindStringSubmatch(h.Temp)
-			if len(subs) > 1 {
-				temp := subs[1]
-				date = stringx.Append(date, fieldItemSep, fmt.Sprintf("temp:%s", temp))
-				if day, ok := matchDayNum(h.Temp); ok {
-					date = stringx.Append(date, fieldSubSep, fmt.Sprintf("%d", day))
-				}
+			if temp, ok := newTempCondition(h.Temp); ok {
+				cond.Temp = &temp
 			}
 		}
 
 		// 2.4 月份休息日，"weekend"
 		if !inMonth && slices.Contains(h._weekendMonths, month) {
-			date = stringx.Append(date, fieldItemSep, "weekend")
+			cond.Weekdays = []time.Weekday{time.Saturday, time.Sunday}
 		}
 
+		date := cond.String()
 		if len(h.Months) != 0 && !inMonth && len(date) == 0 {
 			continue
 		}
@@ -208,7 +208,14 @@ func mergeHours(hours []DlgdHour, category string, month int64) ([10]string, boo
 	return values, true
 }
 
+// NewDlgdHours 解析电价表备注中的时段划分
 func NewDlgdHours(area, text string) []DlgdHour {
+	hours, _ := ParseDlgdHours(area, text)
+	return hours
+}
+
+// ParseDlgdHours 解析电价表备注中的时段划分，同时返回各规则匹配的原文区间、未匹配片段及置信度
+func ParseDlgdHours(area, text string) ([]DlgdHour, DlgdHourDiagnostics) {
 
 	dlgdHours := make([]DlgdHour, 0)
 	docNos := ""
@@ -224,7 +231,6 @@ func NewDlgdHours(area, text string) []DlgdHour {
 	text = regexp.MustCompile(`；`).ReplaceAllString(text, ";")
 	text = regexp.MustCompile(`[-—–−－]+`).ReplaceAllString(text, "-")
 	text = regexp.MustCompile(`\(含\)|\(含尖峰\)|\(含深谷\)`).ReplaceAllString(text, "")
-	text = regexp.MustCompile(`\(?共?\d+小时\)?`).ReplaceAllString(text, "")
 	text = regexp.MustCompile(`\(?次[日年]\)?`).ReplaceAllString(text, "")
 	text = regexp.MustCompile(`[春夏秋冬]季`).ReplaceAllString(text, "")
 	// "由高峰时段调整为尖峰时段"→"为尖峰时段"
@@ -245,18 +251,33 @@ func NewDlgdHours(area, text string) []DlgdHour {
 
 	// 2. 按电价表备注点遍历：
 	// 备注: 1.xxx 2.xxx 3.xxx
-	coments := regexp.MustCompile(`(?m)^\d+`).Split(text, -1)
-	for _, comment := range coments {
+	// 诊断偏移以预处理后的文本为准
+	trace := newHourTrace(text)
+	for _, c := range splitIndex(regexp.MustCompile(`(?m)^\d+`), text) {
+		comment := text[c[0]:c[1]]
 		hours := make([]DlgdHour, 0)
 
 		// 2.1 按句拆分遍历："。"、"(1)"、"(2)"等
-		parts := regexp.MustCompile(`。|\(\d+\)`).Split(comment, -1)
-		for _, part := range parts {
+		for _, p := range splitIndex(regexp.MustCompile(`。|\(\d+\)`), comment) {
+			part := comment[p[0]:p[1]]
+			// 政策所述时长按语句提取，如大风季、小风季时长不同
+			stated := matchStated(part)
+			part, pos := removeAll(regexp.MustCompile(`\(?共?\d+小时\)?`), part, c[0]+p[0])
+			trace.begin(pos)
+			start := len(hours)
 
 			// 特殊一：专属条件
-			hours = append(hours, matchBetween(&part)...)
-			hours = append(hours, matchAfter(&part)...)
-			hours = append(hours, matchMulti(&part)...)
+			between, spans := matchBetween(&part)
+			hours = append(hours, between...)
+			trace.consume("periodBetweenReg", spans...)
+
+			after, spans := matchAfter(&part)
+			hours = append(hours, after...)
+			trace.consume("periodAfterReg", spans...)
+
+			multi, spans := matchMulti(&part)
+			hours = append(hours, multi...)
+			trace.consume("multiValuesReg", spans...)
 
 			condIndexs := conditionReg.FindAllStringIndex(part, -1)
 
@@ -269,6 +290,9 @@ func NewDlgdHours(area, text string) []DlgdHour {
 					Value:      part[values[0][0]:values[0][1]],
 					conditions: matchPreContitions(part, condIndexs, len(part), len(part)),
 				})
+				trace.consume("periodValueReg", []int{min(name[0], values[0][0]), max(name[1], values[0][1])})
+				trace.end(part)
+				setStated(hours[start:], stated)
 				continue
 			}
 
@@ -283,6 +307,7 @@ func NewDlgdHours(area, text string) []DlgdHour {
 					Value:      part[subs[4]:subs[5]],
 					conditions: matchPreContitions(part, condIndexs, subs[2], subs[4]),
 				})
+				trace.consume("periodReg", subs[:2])
 			}
 
 			subss = periodRevReg.FindAllStringSubmatchIndex(part, -1)
@@ -296,6 +321,7 @@ func NewDlgdHours(area, text string) []DlgdHour {
 					Value:      part[subs[2]:subs[3]],
 					conditions: matchPreContitions(part, condIndexs, subs[4], subs[2]),
 				})
+				trace.consume("periodRevReg", subs[:2])
 			}
 
 			subss = periodOtherReg.FindAllStringSubmatchIndex(part, -1)
@@ -309,8 +335,11 @@ func NewDlgdHours(area, text string) []DlgdHour {
 					Value:      part[subs[2]:subs[3]],
 					conditions: matchPreContitions(part, condIndexs, subs[4], subs[2]),
 				})
+				trace.consume("periodOtherReg", subs[:2])
 			}
 
+			trace.end(part)
+			setStated(hours[start:], stated)
 		}
 
 		if len(hours) > 0 {
@@ -327,7 +356,44 @@ func NewDlgdHours(area, text string) []DlgdHour {
 		docNos = periodDocNoReg.FindString(text)
 	}
 
-	return normalize(dlgdHours, area, strings.TrimPrefix(docNos, ","))
+	return normalize(dlgdHours, area, strings.TrimPrefix(docNos, ",")), trace.diagnostics(len(dlgdHours) > 0)
+}
+
+// periodDesc 标准化时段名称，如"高峰时段"→"峰段"
+func periodDesc(name string) string {
+	switch {
+	case strings.HasPrefix(name, "尖"):
+		return PeriodSharp.Desc
+	case strings.HasPrefix(name, "高"), strings.HasPrefix(name, "峰"):
+		return PeriodPeak.Desc
+	case strings.HasPrefix(name, "平"):
+		return PeriodFlat.Desc
+	case strings.HasPrefix(name, "低"), strings.HasPrefix(name, "谷"):
+		return PeriodValley.Desc
+	case strings.HasPrefix(name, "深"):
+		return PeriodDeep.Desc
+	}
+	return name
+}
+
+// matchStated 提取政策所述各时段每日时长，如"峰时段6小时"
+func matchStated(text string) map[string]float64 {
+	stated := map[string]float64{}
+	for _, subs := range periodStatedReg.FindAllStringSubmatch(text, -1) {
+		name := periodDesc(subs[1])
+		if _, ok := stated[name]; ok {
+			continue
+		}
+		stated[name], _ = strconv.ParseFloat(subs[2], 64)
+	}
+	return stated
+}
+
+// setStated 设置语句内各时段的政策所述时长
+func setStated(hours []DlgdHour, stated map[string]float64) {
+	for i := range hours {
+		hours[i].Stated = stated[periodDesc(hours[i].Name)]
+	}
 }
 
 func normalize(hours []DlgdHour, area string, docNo string) []DlgdHour {
@@ -337,18 +403,7 @@ func normalize(hours []DlgdHour, area string, docNo string) []DlgdHour {
 		hour.Area = area
 
 		// 1. 标准化时段名称
-		switch {
-		case strings.HasPrefix(hour.Name, "尖"):
-			hour.Name = PeriodSharp.Desc
-		case strings.HasPrefix(hour.Name, "高"), strings.HasPrefix(hour.Name, "峰"):
-			hour.Name = PeriodPeak.Desc
-		case strings.HasPrefix(hour.Name, "平"):
-			hour.Name = PeriodFlat.Desc
-		case strings.HasPrefix(hour.Name, "低"), strings.HasPrefix(hour.Name, "谷"):
-			hour.Name = PeriodValley.Desc
-		case strings.HasPrefix(hour.Name, "深"):
-			hour.Name = PeriodDeep.Desc
-		}
+		hour.Name = periodDesc(hour.Name)
 
 		// 2. 标准化时段值
 		hour.Value = baseTimeReg.ReplaceAllStringFunc(hour.Value, func(s string) string {
@@ -398,10 +453,11 @@ func normalize(hours []DlgdHour, area string, docNo string) []DlgdHour {
 	return hours
 }
 
-func matchBetween(part *string) []DlgdHour {
+func matchBetween(part *string) ([]DlgdHour, [][]int) {
 	periods := []DlgdHour{}
-	btws := periodBetweenReg.FindAllStringSubmatch(*part, -1)
-	for _, btw := range btws {
+	spans := [][]int{}
+	for _, idx := range periodBetweenReg.FindAllStringSubmatchIndex(*part, -1) {
+		btw := submatches(*part, idx)
 		if len(btw) != 3 {
 			continue
 		}
@@ -425,15 +481,17 @@ func matchBetween(part *string) []DlgdHour {
 			})
 		}
 
-		*part = strings.ReplaceAll(*part, btw[0], strings.Repeat("#", len(btw[0])))
+		spans = append(spans, idx[:2])
 	}
-	return periods
+	*part = maskSpans(*part, spans)
+	return periods, spans
 }
 
-func matchAfter(part *string) []DlgdHour {
+func matchAfter(part *string) ([]DlgdHour, [][]int) {
 	periods := []DlgdHour{}
-	afts := periodAfterReg.FindAllStringSubmatch(*part, -1)
-	for _, aft := range afts {
+	spans := [][]int{}
+	for _, idx := range periodAfterReg.FindAllStringSubmatchIndex(*part, -1) {
+		aft := submatches(*part, idx)
 		if len(aft) != 3 {
 			continue
 		}
@@ -451,15 +509,17 @@ func matchAfter(part *string) []DlgdHour {
 			})
 		}
 
-		*part = strings.ReplaceAll(*part, aft[0], strings.Repeat("#", len(aft[0])))
+		spans = append(spans, idx[:2])
 	}
-	return periods
+	*part = maskSpans(*part, spans)
+	return periods, spans
 }
 
-func matchMulti(part *string) []DlgdHour {
+func matchMulti(part *string) ([]DlgdHour, [][]int) {
 	periods := []DlgdHour{}
-	mults := multiValuesReg.FindAllString(*part, -1)
-	for _, mult := range mults {
+	spans := [][]int{}
+	for _, idx := range multiValuesReg.FindAllStringIndex(*part, -1) {
+		mult := (*part)[idx[0]:idx[1]]
 		name := periodNameReg.FindString(mult)
 		conds := conditionReg.FindAllString(mult, -1)
 		values := periodValueReg.FindAllString(mult, -1)
@@ -475,10 +535,11 @@ func matchMulti(part *string) []DlgdHour {
 			})
 		}
 
-		*part = strings.ReplaceAll(*part, mult, strings.Repeat("#", len(mult)))
+		spans = append(spans, idx)
 	}
+	*part = maskSpans(*part, spans)
 
-	return periods
+	return periods, spans
 }
 
 // matchPreContitions 匹配前置条件
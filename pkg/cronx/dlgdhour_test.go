@@ -231,12 +231,12 @@ func TestNewDlgdHours(t *testing.T) {
 		},
 
 		"内蒙古_1": {
-			{Area: "", DocNo: "内发改价费字[2023]1630号", Name: "峰段", Value: "06:00-08:00,18:00-22:00", Temp: "", Months: "1,2,3,4,5,9,10,11,12", WeekendMonths: "", Holidays: "", Categories: ""},
-			{Area: "", DocNo: "内发改价费字[2023]1630号", Name: "平段", Value: "04:00-06:00,08:00-11:00,16:00-18:00,22:00-24:00", Temp: "", Months: "1,2,3,4,5,9,10,11,12", WeekendMonths: "", Holidays: "", Categories: ""},
-			{Area: "", DocNo: "内发改价费字[2023]1630号", Name: "谷段", Value: "00:00-04:00,11:00-16:00", Temp: "", Months: "1,2,3,4,5,9,10,11,12", WeekendMonths: "", Holidays: "", Categories: ""},
-			{Area: "", DocNo: "内发改价费字[2023]1630号", Name: "峰段", Value: "06:00-08:00,18:00-22:00", Temp: "", Months: "6,7,8", WeekendMonths: "", Holidays: "", Categories: ""},
-			{Area: "", DocNo: "内发改价费字[2023]1630号", Name: "平段", Value: "00:00-06:00,08:00-11:00,16:00-18:00,22:00-24:00", Temp: "", Months: "6,7,8", WeekendMonths: "", Holidays: "", Categories: ""},
-			{Area: "", DocNo: "内发改价费字[2023]1630号", Name: "谷段", Value: "11:00-16:00", Temp: "", Months: "6,7,8", WeekendMonths: "", Holidays: "", Categories: ""},
+			{Area: "", DocNo: "内发改价费字[2023]1630号", Name: "峰段", Value: "06:00-08:00,18:00-22:00", Temp: "", Months: "1,2,3,4,5,9,10,11,12", WeekendMonths: "", Holidays: "", Categories: "", Stated: 6},
+			{Area: "", DocNo: "内发改价费字[2023]1630号", Name: "平段", Value: "04:00-06:00,08:00-11:00,16:00-18:00,22:00-24:00", Temp: "", Months: "1,2,3,4,5,9,10,11,12", WeekendMonths: "", Holidays: "", Categories: "", Stated: 9},
+			{Area: "", DocNo: "内发改价费字[2023]1630号", Name: "谷段", Value: "00:00-04:00,11:00-16:00", Temp: "", Months: "1,2,3,4,5,9,10,11,12", WeekendMonths: "", Holidays: "", Categories: "", Stated: 9},
+			{Area: "", DocNo: "内发改价费字[2023]1630号", Name: "峰段", Value: "06:00-08:00,18:00-22:00", Temp: "", Months: "6,7,8", WeekendMonths: "", Holidays: "", Categories: "", Stated: 6},
+			{Area: "", DocNo: "内发改价费字[2023]1630号", Name: "平段", Value: "00:00-06:00,08:00-11:00,16:00-18:00,22:00-24:00", Temp: "", Months: "6,7,8", WeekendMonths: "", Holidays: "", Categories: "", Stated: 13},
+			{Area: "", DocNo: "内发改价费字[2023]1630号", Name: "谷段", Value: "11:00-16:00", Temp: "", Months: "6,7,8", WeekendMonths: "", Holidays: "", Categories: "", Stated: 5},
 			{Area: "", DocNo: "内发改价费字[2023]1630号", Name: "尖段", Value: "19:00-21:00", Temp: "", Months: "6,7,8", WeekendMonths: "", Holidays: "", Categories: ""},
 			{Area: "", DocNo: "内发改价费字[2023]1630号", Name: "深谷", Value: "13:00-15:00", Temp: "", Months: "6,7,8", WeekendMonths: "", Holidays: "", Categories: ""},
 		},
@@ -348,9 +348,9 @@ func TestNewDlgdHours(t *testing.T) {
 		},
 
 		"新疆": {
-			{Area: "", DocNo: "新发改规[2023]11号", Name: "峰段", Value: "08:00-11:00,19:00-24:00", Temp: "", Months: "", WeekendMonths: "", Holidays: "", Categories: ""},
-			{Area: "", DocNo: "新发改规[2023]11号", Name: "平段", Value: "11:00-13:00,17:00-19:00,00:00-04:00", Temp: "", Months: "", WeekendMonths: "", Holidays: "", Categories: ""},
-			{Area: "", DocNo: "新发改规[2023]11号", Name: "谷段", Value: "04:00-08:00,13:00-17:00", Temp: "", Months: "", WeekendMonths: "", Holidays: "", Categories: ""},
+			{Area: "", DocNo: "新发改规[2023]11号", Name: "峰段", Value: "08:00-11:00,19:00-24:00", Temp: "", Months: "", WeekendMonths: "", Holidays: "", Categories: "", Stated: 8},
+			{Area: "", DocNo: "新发改规[2023]11号", Name: "平段", Value: "11:00-13:00,17:00-19:00,00:00-04:00", Temp: "", Months: "", WeekendMonths: "", Holidays: "", Categories: "", Stated: 8},
+			{Area: "", DocNo: "新发改规[2023]11号", Name: "谷段", Value: "04:00-08:00,13:00-17:00", Temp: "", Months: "", WeekendMonths: "", Holidays: "", Categories: "", Stated: 8},
 			{Area: "", DocNo: "新发改规[2023]11号", Name: "尖段", Value: "21:00-23:00", Temp: "", Months: "7", WeekendMonths: "", Holidays: "", Categories: ""},
 			{Area: "", DocNo: "新发改规[2023]11号", Name: "尖段", Value: "19:00-21:00", Temp: "", Months: "1,11,12", WeekendMonths: "", Holidays: "", Categories: ""},
 			{Area: "", DocNo: "新发改规[2023]11号", Name: "深谷", Value: "14:00-16:00", Temp: "", Months: "5,6,7,8", WeekendMonths: "", Holidays: "", Categories: ""},
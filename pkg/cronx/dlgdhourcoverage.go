@@ -0,0 +1,207 @@
+package cronx
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"seeccloud.com/edscron/pkg/x/slicex"
+	"seeccloud.com/edscron/pkg/x/stringx"
+)
+
+// 允许嵌套的时段：尖段含于峰段或平段(如北京夏季16:00-17:00)、深谷含于谷段
+var nestedPeriods = map[string][]string{
+	PeriodSharp.Desc: {PeriodPeak.Desc, PeriodFlat.Desc},
+	PeriodDeep.Desc:  {PeriodValley.Desc},
+}
+
+var hourRangeReg = regexp.MustCompile(`(\d{2}):(\d{2})-(\d{2}):(\d{2})`)
+
+// ValidateCoverage 按时段划分隐含的各月份、用电类别组合展开普通工作日时段(30/15分钟粒度)，
+// 返回空档、重叠及与政策所述时长不符等问题，相同问题合并月份
+func ValidateCoverage(hours []DlgdHour) []string {
+	issues := make([]string, 0)
+	issueMonths := map[string][]int64{}
+
+	for _, category := range coverageCategories(hours) {
+		for _, month := range months {
+			values, _ := mergeHours(hours, category, month)
+			for _, issue := range checkCoverage(values, statedHours(hours, category, month)) {
+				key := issue
+				if category != "" {
+					key = fmt.Sprintf("[%s]%s", category, issue)
+				}
+				if _, ok := issueMonths[key]; !ok {
+					issues = append(issues, key)
+				}
+				issueMonths[key] = append(issueMonths[key], month)
+			}
+		}
+	}
+
+	for i, key := range issues {
+		if len(issueMonths[key]) < len(months) {
+			issues[i] = fmt.Sprintf("%s月%s", stringx.Join(issueMonths[key], fieldSubSep), key)
+		}
+	}
+
+	return issues
+}
+
+// checkCoverage 检查单个月份的时段划分，values同mergeHours结果，仅含无日期条件的时段
+func checkCoverage(values [10]string, stated map[string]float64) []string {
+	names := []string{PeriodSharp.Desc, PeriodPeak.Desc, PeriodFlat.Desc, PeriodValley.Desc, PeriodDeep.Desc}
+	bands := map[string]string{}
+	for i, name := range names {
+		if len(values[i*2]) == 0 && len(values[i*2+1]) > 0 {
+			bands[name] = values[i*2+1]
+		}
+	}
+
+	// 平段为"其他时段"时视为兜底，不参与空档和重叠检查
+	remainder := bands[PeriodFlat.Desc] == "00:00-24:00"
+	step := 30
+	for _, v := range bands {
+		for _, subs := range hourRangeReg.FindAllStringSubmatch(v, -1) {
+			if subs[2] != "00" && subs[2] != "30" || subs[4] != "00" && subs[4] != "30" {
+				step = 15
+			}
+		}
+	}
+
+	size := 24 * 60 / step
+	slots := make([][]string, size)
+	for _, name := range names {
+		if name == PeriodFlat.Desc && remainder {
+			continue
+		}
+		for _, i := range expandSlots(bands[name], step) {
+			slots[i] = append(slots[i], name)
+		}
+	}
+
+	issues := make([]string, 0)
+	var gaps []int
+	overlaps := map[string][]int{}
+	for i, covered := range slots {
+		switch {
+		case len(covered) == 0 && !remainder:
+			gaps = append(gaps, i)
+		case len(covered) == 2 && (slices.Contains(nestedPeriods[covered[0]], covered[1]) || slices.Contains(nestedPeriods[covered[1]], covered[0])):
+		case len(covered) > 1:
+			key := strings.Join(covered, "与")
+			overlaps[key] = append(overlaps[key], i)
+		}
+	}
+
+	if len(gaps) > 0 {
+		issues = append(issues, fmt.Sprintf("未覆盖时段(按平段计价): %s", formatSlots(gaps, step)))
+	}
+
+	keys := make([]string, 0, len(overlaps))
+	for key := range overlaps {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		issues = append(issues, fmt.Sprintf("%s重叠: %s", key, formatSlots(overlaps[key], step)))
+	}
+
+	for _, name := range names {
+		// 含日期条件的时段不在普通工作日展开，跳过
+		want, band := stated[name], bands[name]
+		if want == 0 || len(band) == 0 {
+			continue
+		}
+
+		got := float64(len(expandSlots(band, step))*step) / 60
+		if name == PeriodFlat.Desc && remainder {
+			got = float64(len(slicex.FilterFunc(slots, func(s []string) bool { return len(s) == 0 }))*step) / 60
+		}
+
+		if math.Abs(got-want) > 1e-6 {
+			issues = append(issues, fmt.Sprintf("%s时长%g小时，与政策所述%g小时不符", name, got, want))
+		}
+	}
+
+	return issues
+}
+
+// expandSlots 展开时段值为时间槽序号，支持跨零点，如"22:00-06:00"
+func expandSlots(value string, step int) []int {
+	size := 24 * 60 / step
+	idxs := make([]int, 0)
+	for _, subs := range hourRangeReg.FindAllStringSubmatch(value, -1) {
+		nums := make([]int, 4)
+		for i := range nums {
+			nums[i], _ = strconv.Atoi(subs[i+1])
+		}
+
+		start := (nums[0]*60 + nums[1]) / step
+		end := (nums[2]*60 + nums[3]) / step
+		if end <= start {
+			end += size
+		}
+
+		for i := start; i < end; i++ {
+			if idx := i % size; !slices.Contains(idxs, idx) {
+				idxs = append(idxs, idx)
+			}
+		}
+	}
+
+	return idxs
+}
+
+// formatSlots 合并连续时间槽为时段值，如"08:00-09:00,12:00-12:30"
+func formatSlots(idxs []int, step int) string {
+	slices.Sort(idxs)
+	ranges := make([]string, 0)
+	for i := 0; i < len(idxs); {
+		j := i
+		for j+1 < len(idxs) && idxs[j+1] == idxs[j]+1 {
+			j++
+		}
+
+		start, end := idxs[i]*step, (idxs[j]+1)*step
+		ranges = append(ranges, fmt.Sprintf("%02d:%02d-%02d:%02d", start/60, start%60, end/60, end%60))
+		i = j + 1
+	}
+
+	return strings.Join(ranges, fieldSubSep)
+}
+
+// coverageCategories 时段划分隐含的用电类别组合
+func coverageCategories(hours []DlgdHour) []string {
+	for _, h := range hours {
+		h.adjust()
+		if slices.ContainsFunc(h._categories, func(c string) bool { return c == dlgdOne || c == dlgdTwo || c == dlgdLarge }) {
+			return []string{dlgdOne, dlgdTwo, dlgdLarge}
+		}
+	}
+
+	return []string{""}
+}
+
+// statedHours 适用于指定月份及用电类别的政策所述时长，按时段名称取最后一条(同mergeHours覆盖顺序)
+func statedHours(hours []DlgdHour, category string, month int64) map[string]float64 {
+	stated := map[string]float64{}
+	for _, h := range hours {
+		h.adjust()
+		if h.Stated == 0 || len(h._months) > 0 && !slices.Contains(h._months, month) {
+			continue
+		}
+
+		if len(h._categories) > 0 && category != "" && !slices.ContainsFunc(h._categories, func(c string) bool { return strings.Contains(category, c) }) {
+			continue
+		}
+
+		stated[h.Name] = h.Stated
+	}
+
+	return stated
+}
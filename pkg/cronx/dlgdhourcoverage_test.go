@@ -0,0 +1,76 @@
+package cronx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCoverage(t *testing.T) {
+	tests := []struct {
+		name  string
+		hours []DlgdHour
+		want  []string
+	}{
+		{
+			name: "完整覆盖",
+			hours: []DlgdHour{
+				{Name: "尖段", Value: "19:00-21:00", Months: "7,8"},
+				{Name: "峰段", Value: "08:00-12:00,17:00-23:00", Stated: 10},
+				{Name: "平段", Value: "12:00-17:00,23:00-24:00"},
+				{Name: "谷段", Value: "00:00-08:00", Stated: 8},
+			},
+			want: []string{},
+		},
+		{
+			name: "平段兜底",
+			hours: []DlgdHour{
+				{Name: "峰段", Value: "08:00-12:00", Stated: 4},
+				{Name: "平段", Value: "00:00-24:00", Stated: 12},
+				{Name: "谷段", Value: "23:00-07:00", Stated: 8},
+			},
+			want: []string{},
+		},
+		{
+			name: "空档",
+			hours: []DlgdHour{
+				{Name: "峰段", Value: "08:00-12:00"},
+				{Name: "平段", Value: "12:00-22:00"},
+				{Name: "谷段", Value: "00:00-07:30"},
+			},
+			want: []string{"未覆盖时段(按平段计价): 07:30-08:00,22:00-24:00"},
+		},
+		{
+			name: "重叠",
+			hours: []DlgdHour{
+				{Name: "峰段", Value: "08:00-12:00"},
+				{Name: "平段", Value: "11:00-24:00"},
+				{Name: "谷段", Value: "00:00-08:15"},
+			},
+			want: []string{"峰段与平段重叠: 11:00-12:00", "峰段与谷段重叠: 08:00-08:15"},
+		},
+		{
+			name: "时长不符",
+			hours: []DlgdHour{
+				{Name: "峰段", Value: "08:00-12:00", Stated: 6},
+				{Name: "平段", Value: "00:00-24:00"},
+			},
+			want: []string{"峰段时长4小时，与政策所述6小时不符"},
+		},
+		{
+			name: "合并月份",
+			hours: []DlgdHour{
+				{Name: "峰段", Value: "08:00-22:00"},
+				{Name: "深谷", Value: "11:00-14:00", Months: "4,5"},
+				{Name: "谷段", Value: "22:00-08:00"},
+			},
+			want: []string{"4,5月峰段与深谷重叠: 11:00-14:00"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, ValidateCoverage(test.hours))
+		})
+	}
+}
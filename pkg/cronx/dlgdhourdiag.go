@@ -0,0 +1,178 @@
+package cronx
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	timeValueReg = regexp.MustCompile(baseTime)
+	// 未匹配片段首尾的分隔符
+	fragmentCutset = ",;:()# \n"
+)
+
+// DlgdHourSpan 时段解析规则匹配的原文区间
+type DlgdHourSpan struct {
+	Rule  string `json:"rule"`  // 匹配规则，如periodReg、periodBetweenReg
+	Start int    `json:"start"` // 起始位置(字符偏移，含)
+	End   int    `json:"end"`   // 结束位置(字符偏移，不含)
+	Text  string `json:"text"`  // 匹配原文
+}
+
+// DlgdHourDiagnostics 时段解析诊断
+type DlgdHourDiagnostics struct {
+	Text       string         `json:"text"`       // 预处理后的原文，偏移以此为准
+	Spans      []DlgdHourSpan `json:"spans"`      // 各规则匹配的原文区间
+	Unmatched  []string       `json:"unmatched"`  // 含时段名称或时间但未被任何规则匹配的片段
+	Confidence float64        `json:"confidence"` // 置信度(0-1)：已匹配时间值占原文全部时间值的比例，未解析出时段时为0
+}
+
+// hourTrace 记录时段解析过程，逐句调用begin、consume、end
+type hourTrace struct {
+	text      string
+	spans     []DlgdHourSpan
+	unmatched []string
+	matched   int // 已匹配的时间值数量
+	missed    int // 未匹配的时间值数量
+
+	pos  []int  // 当前语句各字节在原文中的偏移
+	mask []bool // 当前语句已匹配的字节
+}
+
+func newHourTrace(text string) *hourTrace {
+	return &hourTrace{
+		text:      text,
+		spans:     make([]DlgdHourSpan, 0),
+		unmatched: make([]string, 0),
+	}
+}
+
+// begin 开始解析语句，pos为语句各字节在原文中的偏移
+func (t *hourTrace) begin(pos []int) {
+	t.pos = pos
+	t.mask = make([]bool, len(pos))
+}
+
+// consume 记录规则在当前语句中匹配的区间，idxs为[start, end]
+func (t *hourTrace) consume(rule string, idxs ...[]int) {
+	for _, idx := range idxs {
+		if len(idx) < 2 || idx[0] >= idx[1] {
+			continue
+		}
+
+		for i := idx[0]; i < idx[1]; i++ {
+			t.mask[i] = true
+		}
+
+		start, end := t.pos[idx[0]], t.pos[idx[1]-1]+1
+		t.spans = append(t.spans, DlgdHourSpan{
+			Rule:  rule,
+			Start: utf8.RuneCountInString(t.text[:start]),
+			End:   utf8.RuneCountInString(t.text[:end]),
+			Text:  t.text[start:end],
+		})
+	}
+}
+
+// end 结束解析语句，统计时间值并收集未匹配片段
+func (t *hourTrace) end(part string) {
+	for i := 0; i < len(part); {
+		j := i
+		for j < len(part) && t.mask[j] == t.mask[i] {
+			j++
+		}
+
+		text := t.text[t.pos[i] : t.pos[j-1]+1]
+		times := len(timeValueReg.FindAllString(text, -1))
+		if t.mask[i] {
+			t.matched += times
+		} else if fragment := strings.Trim(part[i:j], fragmentCutset); times > 0 || hasPeriodName(fragment) {
+			t.missed += times
+			t.unmatched = append(t.unmatched, strings.Trim(text, fragmentCutset))
+		}
+
+		i = j
+	}
+}
+
+// diagnostics 汇总诊断结果，parsed为是否解析出时段
+func (t *hourTrace) diagnostics(parsed bool) DlgdHourDiagnostics {
+	sort.SliceStable(t.spans, func(i, j int) bool { return t.spans[i].Start < t.spans[j].Start })
+
+	confidence := 0.0
+	if parsed && t.matched+t.missed > 0 {
+		confidence = math.Round(float64(t.matched)/float64(t.matched+t.missed)*100) / 100
+	}
+
+	return DlgdHourDiagnostics{
+		Text:       t.text,
+		Spans:      t.spans,
+		Unmatched:  t.unmatched,
+		Confidence: confidence,
+	}
+}
+
+// hasPeriodName 是否含时段名称，忽略"尖峰电价"、"峰段上浮70%"等电价表述
+func hasPeriodName(s string) bool {
+	if strings.ContainsAny(s, "价浮") {
+		return false
+	}
+
+	for _, name := range periodNameReg.FindAllString(s, -1) {
+		if strings.HasSuffix(name, "段") {
+			return true
+		}
+	}
+	return false
+}
+
+// splitIndex 同reg.Split(s, -1)，返回各片段的起止位置
+func splitIndex(reg *regexp.Regexp, s string) [][]int {
+	idxs := make([][]int, 0)
+	last := 0
+	for _, sep := range reg.FindAllStringIndex(s, -1) {
+		idxs = append(idxs, []int{last, sep[0]})
+		last = sep[1]
+	}
+	return append(idxs, []int{last, len(s)})
+}
+
+// removeAll 删除匹配内容，返回结果及其各字节在原文中的偏移，base为s在原文中的起始位置
+func removeAll(reg *regexp.Regexp, s string, base int) (string, []int) {
+	var b strings.Builder
+	pos := make([]int, 0, len(s))
+	last := 0
+	for _, idx := range append(reg.FindAllStringIndex(s, -1), []int{len(s), len(s)}) {
+		b.WriteString(s[last:idx[0]])
+		for i := last; i < idx[0]; i++ {
+			pos = append(pos, base+i)
+		}
+		last = idx[1]
+	}
+	return b.String(), pos
+}
+
+// maskSpans 以"#"覆盖已匹配区间，保持长度不变以免影响后续匹配的偏移
+func maskSpans(s string, spans [][]int) string {
+	b := []byte(s)
+	for _, idx := range spans {
+		for i := idx[0]; i < idx[1]; i++ {
+			b[i] = '#'
+		}
+	}
+	return string(b)
+}
+
+// submatches 按FindStringSubmatchIndex结果提取子匹配
+func submatches(s string, idx []int) []string {
+	subs := make([]string, len(idx)/2)
+	for i := range subs {
+		if idx[2*i] >= 0 {
+			subs[i] = s[idx[2*i]:idx[2*i+1]]
+		}
+	}
+	return subs
+}
@@ -0,0 +1,66 @@
+package cronx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDlgdHoursDiagnostics(t *testing.T) {
+	tests := []struct {
+		name       string
+		text       string
+		hours      int
+		rules      []string
+		unmatched  []string
+		confidence float64
+	}{
+		{
+			name:       "全部匹配",
+			text:       "高峰时段为10:00-12:00,谷段为0:00-8:00,其他时段为平段。",
+			hours:      3,
+			rules:      []string{"periodReg", "periodReg", "periodOtherReg"},
+			unmatched:  []string{},
+			confidence: 1,
+		},
+		{
+			name:       "未匹配时间",
+			text:       "高峰时段为10:00-12:00,谷段为0:00-8:00。午间12:00-14:00另行规定。",
+			hours:      2,
+			rules:      []string{"periodReg", "periodReg"},
+			unmatched:  []string{"午间12:00-14:00另行规定"},
+			confidence: 0.67,
+		},
+		{
+			name:       "忽略电价表述",
+			text:       "高峰时段为10:00-12:00(共2小时)。尖峰电价在高峰电价基础上上浮20%。",
+			hours:      1,
+			rules:      []string{"periodValueReg"},
+			unmatched:  []string{},
+			confidence: 1,
+		},
+		{
+			name:       "无法解析",
+			text:       "峰谷时段按每日24小时分为高峰,平段,低谷三段。",
+			hours:      0,
+			rules:      []string{},
+			unmatched:  []string{"峰谷时段按每日24小时分为高峰,平段,低谷三段"},
+			confidence: 0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hours, diag := ParseDlgdHours("", test.text)
+			assert.Len(t, hours, test.hours)
+			rules := make([]string, 0)
+			for _, span := range diag.Spans {
+				rules = append(rules, span.Rule)
+				assert.Equal(t, span.Text, string([]rune(diag.Text)[span.Start:span.End]))
+			}
+			assert.Equal(t, test.rules, rules)
+			assert.Equal(t, test.unmatched, diag.Unmatched)
+			assert.Equal(t, test.confidence, diag.Confidence)
+		})
+	}
+}
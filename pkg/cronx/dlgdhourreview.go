@@ -0,0 +1,102 @@
+package cronx
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"seeccloud.com/edscron/pkg/x/stringx"
+)
+
+// 08:00-12:00,14:00-17:30
+var hourValueReg = regexp.MustCompile(`^\d{2}:\d{2}-\d{2}:\d{2}(?:,\d{2}:\d{2}-\d{2}:\d{2})*$`)
+
+// Validate 校验人工修改的时段划分
+func (h DlgdHour) Validate() error {
+	if !slices.Contains(dlgdPeriodDescs, h.Name) {
+		return fmt.Errorf("时段名称(%s)须为%s之一", h.Name, strings.Join(dlgdPeriodDescs, "、"))
+	}
+
+	if !hourValueReg.MatchString(h.Value) {
+		return fmt.Errorf("%s时段值(%s)格式错误，例：08:00-12:00,14:00-17:30", h.Name, h.Value)
+	}
+
+	for _, months := range []string{h.Months, h.WeekendMonths} {
+		if len(months) > 0 && len(stringx.MustInts(months, 1, 12)) == 0 {
+			return fmt.Errorf("%s月份条件(%s)格式错误", h.Name, months)
+		}
+	}
+
+	if len(h.Temp) > 0 {
+		if _, ok := newTempCondition(h.Temp); !ok {
+			return fmt.Errorf("%s温度条件(%s)格式错误", h.Name, h.Temp)
+		}
+	}
+
+	return nil
+}
+
+// DiffDlgdHours 比较两版时段划分，按时段名称+月份+用电类别匹配，返回差异描述
+func DiffDlgdHours(prev, next []DlgdHour) []string {
+	diffs := make([]string, 0)
+	olds := make(map[string]DlgdHour, len(prev))
+	for _, h := range prev {
+		olds[h.diffKey()] = h
+	}
+
+	seen := make(map[string]bool, len(next))
+	for _, h := range next {
+		key := h.diffKey()
+		seen[key] = true
+		o, ok := olds[key]
+		if !ok {
+			diffs = append(diffs, fmt.Sprintf("新增 %s: %s", key, h.diffValue()))
+			continue
+		}
+
+		if o.diffValue() != h.diffValue() {
+			diffs = append(diffs, fmt.Sprintf("修改 %s: %s → %s", key, o.diffValue(), h.diffValue()))
+		}
+	}
+
+	for _, h := range prev {
+		if key := h.diffKey(); !seen[key] {
+			diffs = append(diffs, fmt.Sprintf("删除 %s: %s", key, h.diffValue()))
+		}
+	}
+
+	return diffs
+}
+
+// diffKey 时段标识，如"尖段[7,8,9月][两部制]"
+func (h DlgdHour) diffKey() string {
+	key := h.Name
+	if len(h.Months) > 0 {
+		key += fmt.Sprintf("[%s月]", h.Months)
+	}
+
+	if len(h.Categories) > 0 {
+		key += fmt.Sprintf("[%s]", h.Categories)
+	}
+
+	return key
+}
+
+// diffValue 时段取值及附加条件
+func (h DlgdHour) diffValue() string {
+	values := []string{h.Value}
+	if len(h.Temp) > 0 {
+		values = append(values, "气温:"+h.Temp)
+	}
+
+	if len(h.WeekendMonths) > 0 {
+		values = append(values, "休息日:"+h.WeekendMonths+"月")
+	}
+
+	if len(h.Holidays) > 0 {
+		values = append(values, "节假日:"+h.Holidays)
+	}
+
+	return strings.Join(values, " ")
+}
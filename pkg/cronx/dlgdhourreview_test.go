@@ -0,0 +1,35 @@
+package cronx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffDlgdHours(t *testing.T) {
+	prev := []DlgdHour{
+		{Name: "尖段", Value: "1100-1200", Months: "7,8,9"},
+		{Name: "峰段", Value: "1000-1200,1400-1900"},
+		{Name: "深谷", Value: "1200-1400", Holidays: "春节"},
+	}
+	next := []DlgdHour{
+		{Name: "尖段", Value: "1100-1300", Months: "7,8,9"},
+		{Name: "峰段", Value: "1000-1200,1400-1900"},
+		{Name: "谷段", Value: "0000-0800"},
+	}
+
+	want := []string{
+		"修改 尖段[7,8,9月]: 1100-1200 → 1100-1300",
+		"新增 谷段: 0000-0800",
+		"删除 深谷: 1200-1400 节假日:春节",
+	}
+	assert.Equal(t, want, DiffDlgdHours(prev, next))
+	assert.Empty(t, DiffDlgdHours(prev, prev))
+}
+
+func TestDlgdHourValidate(t *testing.T) {
+	assert.NoError(t, DlgdHour{Name: "尖段", Value: "11:00-12:00,16:00-17:00", Months: "7,8", Temp: "temp:35"}.Validate())
+	assert.Error(t, DlgdHour{Name: "尖峰", Value: "11:00-12:00"}.Validate())
+	assert.Error(t, DlgdHour{Name: "峰段", Value: "11-12"}.Validate())
+	assert.Error(t, DlgdHour{Name: "峰段", Value: "11:00-12:00", Temp: "高温"}.Validate())
+}
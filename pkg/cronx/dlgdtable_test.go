@@ -0,0 +1,56 @@
+package cronx
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"seeccloud.com/edscron/pkg/chromedpx"
+)
+
+func TestUntableStep(t *testing.T) {
+	html := `<div class="content">
+<h3>某省电网2025年3月代理购电工商业用户电价表</h3>
+<p>单位：元/千瓦时</p>
+<table>
+  <tr><th rowspan="2">用电分类</th><th rowspan="2">电压等级</th><th colspan="4">分时电度用电价格</th></tr>
+  <tr><th>尖峰</th><th>高峰</th><th>平段</th><th>低谷</th></tr>
+  <tr><td rowspan="2">单一制</td><td>不满1千伏</td><td>0.9123</td><td>0.7602</td><td>0.4561</td><td>0.2281</td></tr>
+  <tr><td>1-10千伏</td><td>0.8912</td><td>0.7427</td><td>0.4456</td><td>0.2228</td></tr>
+  <tr><td rowspan="2">两部制</td><td>不满1千伏</td><td>0.8721</td><td>0.7268</td><td>0.4361</td><td>0.2180</td></tr>
+  <tr><td>1-10千伏</td><td>0.8540</td><td>0.7117</td><td>0.4270</td><td>0.2135</td></tr>
+</table>
+<p>注：1.高峰时段为10:00-12:00,谷段为0:00-8:00,其他时段为平段。</p>
+<p>2.尖峰电价在高峰电价基础上上浮20%。</p>
+</div>`
+
+	table, err := chromedpx.NewDPTable(html)
+	assert.NoError(t, err)
+	assert.Equal(t, "某省电网2025年3月代理购电工商业用户电价表\n单位：元/千瓦时", table.Before)
+	assert.Equal(t, []string{"用电分类", "电压等级", "分时电度用电价格", "分时电度用电价格", "分时电度用电价格", "分时电度用电价格"}, table.Rows[0])
+	assert.Equal(t, []string{"用电分类", "电压等级", "尖峰", "高峰", "平段", "低谷"}, table.Rows[1])
+	assert.Equal(t, []string{"单一制", "1-10千伏", "0.8912", "0.7427", "0.4456", "0.2228"}, table.Rows[3])
+
+	name := filepath.Join(t.TempDir(), "table.json")
+	buf, _ := json.Marshal(table)
+	assert.NoError(t, os.WriteFile(name, buf, fileMode))
+	p := NewPipeline("dlgd")
+	p.Keep = true
+	file := NewFile(p, "table")
+	file.Set(name)
+	parsed := NewValue[dlgdParsed](p, "parsed")
+	assert.NoError(t, p.Add(untableStep(file, "某省", "代理购电工商业用户电价表", parsed)).Run(context.Background()))
+
+	rows, hours, diag := parsed.Get().Rows, parsed.Get().Hours, parsed.Get().Diag
+
+	assert.Len(t, rows, 4)
+	assert.Equal(t, "两部制", rows[2].Category)
+	assert.Equal(t, "不满1千伏", rows[2].Voltage)
+	assert.Equal(t, 0.8721, rows[2].Sharp)
+	assert.Equal(t, 0.2135, rows[3].Valley)
+	assert.Len(t, hours, 3)
+	assert.Empty(t, diag.Unmatched)
+}
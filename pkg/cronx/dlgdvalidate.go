@@ -0,0 +1,232 @@
+package cronx
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	// 尖峰电价在高峰电价基础上上浮20%、峰段上浮70%、低谷时段电价下浮60%
+	dlgdFloatReg = regexp.MustCompile(fmt.Sprintf(`(%s)(?:电价)?(?:在|以|按)?(?:(%s)(?:电价)?的?基础上)?(上浮|下浮|上调|下调)(\d+(?:\.\d+)?)[%%％]`, periodNamePat, periodNamePat))
+	// 峰平谷比价为1.7:1:0.38、尖峰平谷电价比1.8:1.5:1:0.5
+	dlgdRatioReg     = regexp.MustCompile(`((?:尖|峰|平|谷|深)+)(?:时段)?(?:电价)?比(?:价|例)?(?:为|:)?(\d+(?:\.\d+)?(?::\d+(?:\.\d+)?)+)`)
+	dlgdRatioNameReg = regexp.MustCompile(`深谷?|尖|峰|平|谷`)
+	// 政策原文按句拆分，浮动比例仅适用于同句所述的用电分类
+	dlgdSentenceReg = regexp.MustCompile(`[。；;\n]`)
+	dlgdCategorySep = regexp.MustCompile(`[,，、/\s]+`)
+)
+
+// dlgdRange 电价合理范围，单位换算错误(如分/千瓦时按元/千瓦时)时超出范围
+type dlgdRange struct {
+	Name  string
+	Unit  string
+	Min   float64
+	Max   float64
+	Value func(DlgdRow) float64
+}
+
+var dlgdRanges = []dlgdRange{
+	{PeriodSharp.Desc, yuanUnit, 0.05, 3, func(r DlgdRow) float64 { return r.Sharp }},
+	{PeriodPeak.Desc, yuanUnit, 0.05, 3, func(r DlgdRow) float64 { return r.Peak }},
+	{PeriodFlat.Desc, yuanUnit, 0.05, 3, func(r DlgdRow) float64 { return r.Flat }},
+	{PeriodValley.Desc, yuanUnit, 0.05, 3, func(r DlgdRow) float64 { return r.Valley }},
+	{PeriodDeep.Desc, yuanUnit, 0.05, 3, func(r DlgdRow) float64 { return r.Deep }},
+	{"政府性基金及附加", yuanUnit, 0, 0.2, func(r DlgdRow) float64 { return r.Fund }},
+	{"需量电价", "元/千瓦·月", 5, 100, func(r DlgdRow) float64 { return r.Demand }},
+	{"容量电价", "元/千伏安·月", 5, 100, func(r DlgdRow) float64 { return r.Capacity }},
+}
+
+// dlgdRatio 政策所述分时电价浮动比例：Name电价 = Base电价 × Value
+type dlgdRatio struct {
+	Name  string
+	Base  string
+	Value float64
+}
+
+// dlgdRatioScope 政策原文中一句所述的浮动比例
+type dlgdRatioScope struct {
+	Text   string
+	Ratios []dlgdRatio
+}
+
+// ValidateDlgd 入库前校验电价，返回问题列表：
+//  1. 分时电价大小顺序：尖段≥峰段≥平段≥谷段≥深谷(未执行的时段为0，不参与比较)
+//  2. 各电价的合理范围
+//  3. 与政策原文(policy，如电价表备注)所述浮动比例的偏差不超过tolerance(如0.1)
+//     浮动比例通常针对购电价格而非含输配电价等的电度电价，仅校验同句指明用电分类(如"工商业用户峰段上浮70%")的电价
+//  4. 用电分类、电压等级与上月(prev)一致，prev为空时不校验
+func ValidateDlgd(rows []DlgdRow, policy string, prev []DlgdRow, tolerance float64) []string {
+	issues := make([]string, 0)
+	scopes := make([]dlgdRatioScope, 0)
+	for _, text := range dlgdSentenceReg.Split(policy, -1) {
+		if ratios := matchRatios(text); len(ratios) > 0 {
+			scopes = append(scopes, dlgdRatioScope{Text: text, Ratios: ratios})
+		}
+	}
+
+	for _, r := range rows {
+		label := r.label()
+		prices := r.prices()
+
+		var last string
+		for _, name := range dlgdPeriodDescs {
+			if prices[name] == 0 {
+				continue
+			}
+			if len(last) > 0 && prices[name] > prices[last] {
+				issues = append(issues, fmt.Sprintf("[%s]%s电价%v高于%s电价%v", label, name, prices[name], last, prices[last]))
+			}
+			last = name
+		}
+
+		for _, rg := range dlgdRanges {
+			if v := rg.Value(r); v != 0 && (v < rg.Min || v > rg.Max) {
+				issues = append(issues, fmt.Sprintf("[%s]%s%v超出合理范围%v-%v%s", label, rg.Name, v, rg.Min, rg.Max, rg.Unit))
+			}
+		}
+
+		for _, ratio := range r.ratios(scopes) {
+			if prices[ratio.Name] == 0 || prices[ratio.Base] == 0 {
+				continue
+			}
+			actual := prices[ratio.Name] / prices[ratio.Base]
+			if math.Abs(actual-ratio.Value) > ratio.Value*tolerance {
+				issues = append(issues, fmt.Sprintf("[%s]%s/%s电价比%.2f与政策所述%.2f不符", label, ratio.Name, ratio.Base, actual, ratio.Value))
+			}
+		}
+	}
+
+	if len(prev) == 0 {
+		return issues
+	}
+
+	labels := make(map[string]bool)
+	for _, r := range rows {
+		labels[r.label()] = true
+	}
+
+	prevLabels := make(map[string]bool)
+	for _, r := range prev {
+		label := r.label()
+		if !prevLabels[label] && !labels[label] {
+			issues = append(issues, fmt.Sprintf("[%s]上月有、本月缺失", label))
+		}
+		prevLabels[label] = true
+	}
+
+	for _, r := range rows {
+		label := r.label()
+		if !prevLabels[label] {
+			issues = append(issues, fmt.Sprintf("[%s]本月新增、上月无", label))
+			prevLabels[label] = true
+		}
+	}
+
+	return issues
+}
+
+// label 电价标识：用电分类/电压等级(/阶梯)
+func (r DlgdRow) label() string {
+	parts := []string{r.Category, r.Voltage}
+	if len(r.Stage) > 0 {
+		parts = append(parts, r.Stage)
+	}
+	return strings.Join(parts, "/")
+}
+
+// prices 各时段电价，键同dlgdPeriodDescs
+func (r DlgdRow) prices() map[string]float64 {
+	return map[string]float64{
+		PeriodSharp.Desc:  r.Sharp,
+		PeriodPeak.Desc:   r.Peak,
+		PeriodFlat.Desc:   r.Flat,
+		PeriodValley.Desc: r.Valley,
+		PeriodDeep.Desc:   r.Deep,
+	}
+}
+
+// ratios 适用的浮动比例：所在语句指明了本条电价的用电分类，同一时段取首句
+func (r DlgdRow) ratios(scopes []dlgdRatioScope) []dlgdRatio {
+	names := make([]string, 0)
+	for _, name := range dlgdCategorySep.Split(r.Category, -1) {
+		if len([]rune(name)) >= 2 {
+			names = append(names, name)
+		}
+	}
+
+	ratios := make([]dlgdRatio, 0)
+	exists := map[string]bool{}
+	for _, scope := range scopes {
+		if !slices.ContainsFunc(names, func(name string) bool { return strings.Contains(scope.Text, name) }) {
+			continue
+		}
+
+		for _, ratio := range scope.Ratios {
+			if !exists[ratio.Name] {
+				exists[ratio.Name] = true
+				ratios = append(ratios, ratio)
+			}
+		}
+	}
+
+	return ratios
+}
+
+// matchRatios 提取政策所述分时电价浮动比例，未指明基准时尖段以峰段、其他时段以平段为基准
+func matchRatios(text string) []dlgdRatio {
+	ratios := make([]dlgdRatio, 0)
+	exists := map[string]bool{}
+	add := func(r dlgdRatio) {
+		if exists[r.Name] || r.Name == r.Base || r.Value <= 0 {
+			return
+		}
+		exists[r.Name] = true
+		ratios = append(ratios, r)
+	}
+
+	for _, subs := range dlgdFloatReg.FindAllStringSubmatch(text, -1) {
+		name := periodDesc(subs[1])
+		base := periodDesc(subs[2])
+		if len(subs[2]) == 0 {
+			base = PeriodFlat.Desc
+			if name == PeriodSharp.Desc {
+				base = PeriodPeak.Desc
+			}
+		}
+
+		pct, _ := strconv.ParseFloat(subs[4], 64)
+		if subs[3] == "下浮" || subs[3] == "下调" {
+			pct = -pct
+		}
+		add(dlgdRatio{Name: name, Base: base, Value: 1 + pct/100})
+	}
+
+	for _, subs := range dlgdRatioReg.FindAllStringSubmatch(text, -1) {
+		names := dlgdRatioNameReg.FindAllString(subs[1], -1)
+		values := strings.Split(subs[2], ":")
+		if len(names) != len(values) {
+			continue
+		}
+
+		flat := 0.0
+		for i, name := range names {
+			if periodDesc(name) == PeriodFlat.Desc {
+				flat, _ = strconv.ParseFloat(values[i], 64)
+			}
+		}
+		if flat == 0 {
+			continue
+		}
+
+		for i, name := range names {
+			v, _ := strconv.ParseFloat(values[i], 64)
+			add(dlgdRatio{Name: periodDesc(name), Base: PeriodFlat.Desc, Value: v / flat})
+		}
+	}
+
+	return ratios
+}
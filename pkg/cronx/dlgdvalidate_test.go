@@ -0,0 +1,59 @@
+package cronx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchRatios(t *testing.T) {
+	ratios := matchRatios("1.高峰时段为10:00-12:00。\n2.尖峰电价在高峰电价基础上上浮20%,峰段上浮70%,低谷时段电价下浮50％。")
+	assert.Equal(t, []dlgdRatio{
+		{Name: PeriodSharp.Desc, Base: PeriodPeak.Desc, Value: 1.2},
+		{Name: PeriodPeak.Desc, Base: PeriodFlat.Desc, Value: 1.7},
+		{Name: PeriodValley.Desc, Base: PeriodFlat.Desc, Value: 0.5},
+	}, ratios)
+
+	ratios = matchRatios("峰平谷比价为1.7:1:0.38")
+	assert.Len(t, ratios, 2)
+	assert.Equal(t, dlgdRatio{Name: PeriodValley.Desc, Base: PeriodFlat.Desc, Value: 0.38}, ratios[1])
+}
+
+func TestValidateDlgd(t *testing.T) {
+	policy := "单一制、两部制用户尖峰电价在高峰电价基础上上浮20%,峰段上浮70%,低谷下浮50%。"
+	rows := []DlgdRow{
+		{Category: "单一制", Voltage: "不满1千伏", Sharp: 0.9180, Peak: 0.7650, Flat: 0.4500, Valley: 0.2250},
+		{Category: "两部制", Voltage: "1-10千伏", Sharp: 0.8160, Peak: 0.6800, Flat: 0.4000, Valley: 0.2000, Demand: 38.4},
+	}
+	prev := []DlgdRow{
+		{Category: "单一制", Voltage: "不满1千伏"},
+		{Category: "两部制", Voltage: "1-10千伏"},
+	}
+	assert.Empty(t, ValidateDlgd(rows, policy, prev, 0.1))
+
+	// OCR识别错误：峰谷倒置、单位换算错误、电压等级缺失
+	bad := []DlgdRow{
+		{Category: "单一制", Voltage: "不满1千伏", Sharp: 0.9180, Peak: 0.2250, Flat: 0.4500, Valley: 0.7650},
+		{Category: "两部制", Voltage: "1-10千伏", Sharp: 8.160, Peak: 6.800, Flat: 4.000, Valley: 2.000, Demand: 38.4},
+	}
+	issues := ValidateDlgd(bad, policy, append(prev, DlgdRow{Category: "两部制", Voltage: "35千伏及以上"}), 0.1)
+	assert.Contains(t, issues, "[单一制/不满1千伏]平段电价0.45高于峰段电价0.225")
+	assert.Contains(t, issues, "[单一制/不满1千伏]谷段电价0.765高于平段电价0.45")
+	assert.Contains(t, issues, "[单一制/不满1千伏]峰段/平段电价比0.50与政策所述1.70不符")
+	assert.Contains(t, issues, "[两部制/1-10千伏]尖段8.16超出合理范围0.05-3元/千瓦时")
+	assert.Contains(t, issues, "[两部制/35千伏及以上]上月有、本月缺失")
+
+	// 浮动比例仅校验同句所述的用电分类
+	issues = ValidateDlgd(bad[:1], "1.两部制用户峰段上浮70%。\n2.单一制用户执行统一电价。", nil, 0.1)
+	assert.NotContains(t, issues, "[单一制/不满1千伏]峰段/平段电价比0.50与政策所述1.70不符")
+	assert.Contains(t, issues, "[单一制/不满1千伏]平段电价0.45高于峰段电价0.225")
+
+	// 无上月电价时不校验用电分类、电压等级
+	assert.Empty(t, ValidateDlgd(rows, "", nil, 0.1))
+}
+
+func TestMiniDlgdConfigUnchecked(t *testing.T) {
+	assert.True(t, MiniDlgdConfig{Month: "2025年8月", UncheckedMonth: "2025-08"}.Unchecked())
+	assert.False(t, MiniDlgdConfig{Month: "2025年9月", UncheckedMonth: "2025年8月"}.Unchecked())
+	assert.False(t, MiniDlgdConfig{Month: "2025年8月"}.Unchecked())
+}
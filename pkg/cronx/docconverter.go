@@ -0,0 +1,257 @@
+package cronx
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/rs/xid"
+	"seeccloud.com/edscron/pkg/x/expx"
+)
+
+// 文档转换服务标识
+const (
+	ConverterAli   = "ali"   // 阿里云文档智能(默认)
+	ConverterPdf24 = "pdf24" // pdf24.org网页转换，无需账号
+	ConverterLocal = "local" // 本地：按文件哈希读取预录结果，或调用本地安装的转换程序，用于离线测试或内网部署
+)
+
+// IsBillableConverter 是否为按页计费的转换服务
+func IsBillableConverter(provider string) bool {
+	return provider == ConverterAli
+}
+
+// DocConverter 文档转换服务，将PDF转换为Excel、Word或图片并保存为本地文件，ctx取消时尽快返回
+type DocConverter interface {
+	Convert(ctx context.Context, inPath string, format OutputFormat, outPath *string) error
+}
+
+// OcrConfig 文档转换服务配置，兼容原阿里云OCR配置
+type OcrConfig struct {
+	Provider string `json:"provider,omitempty"` // 转换服务：ali(默认)、pdf24、local
+	AliOcr
+	Path    string `json:"path,omitempty"`    // local：预录结果目录，文件命名为"<内容标识>.<扩展名>"(PDF文件SHA256，裁剪等处理后附加处理参数，见File.Key)；ali/pdf24：配置时录制转换结果至该目录
+	Command string `json:"command,omitempty"` // local：无预录结果时执行的本地转换命令，{in}、{out}、{format}替换为输入路径、输出路径、输出格式
+
+	CacheDir  string         `json:"cacheDir,omitempty"`  // 转换结果缓存目录，按原始文件SHA256、裁剪等处理参数及输出格式缓存，为空时不缓存
+	CacheDays int            `json:"cacheDays,omitempty"` // 缓存保留天数(按最近使用时间)，默认30天
+	OnUsage   func(OcrUsage) `json:"-"`                   // 用量回调，每次转换或命中缓存时调用
+}
+
+// NewConverter 依服务标识创建文档转换服务，按配置附加录制、用量统计及缓存
+func (c OcrConfig) NewConverter() (DocConverter, error) {
+	var converter DocConverter
+	provider := expx.If(c.Provider == "", ConverterAli, c.Provider)
+	switch provider {
+	case ConverterAli:
+		converter = aliConverter{cfg: c.AliOcr}
+	case ConverterPdf24:
+		converter = pdf24Converter{}
+	case ConverterLocal:
+		if len(c.Path) == 0 && len(c.Command) == 0 {
+			return nil, errors.New("local转换服务需指定path或command")
+		}
+		converter = localConverter{Path: c.Path, Command: c.Command}
+	default:
+		return nil, fmt.Errorf("未知的文档转换服务: %s", c.Provider)
+	}
+
+	if len(c.Path) > 0 && provider != ConverterLocal {
+		converter = recordConverter{converter: converter, Path: c.Path}
+	}
+
+	if c.OnUsage != nil {
+		converter = meterConverter{converter: converter, provider: provider, onUsage: c.OnUsage}
+	}
+
+	// 本地转换无需缓存
+	if len(c.CacheDir) > 0 && provider != ConverterLocal {
+		converter = cacheConverter{
+			converter: converter,
+			provider:  provider,
+			Dir:       c.CacheDir,
+			Days:      expx.If(c.CacheDays > 0, c.CacheDays, defaultCacheDays),
+			onUsage:   c.OnUsage,
+		}
+	}
+
+	return converter, nil
+}
+
+// aliConverter 阿里云文档智能，转换结果为URL，下载至本地
+type aliConverter struct {
+	cfg AliOcr
+}
+
+func (a aliConverter) Convert(ctx context.Context, inPath string, format OutputFormat, outPath *string) error {
+	url := ""
+	if err := aliConvertPDF(ctx, a.cfg, inPath, &url, format); err != nil {
+		return err
+	}
+
+	return localize(ctx, url, outPath)
+}
+
+// pdf24Converter pdf24.org网页转换，能准确保留单元格背景色
+type pdf24Converter struct{}
+
+func (pdf24Converter) Convert(ctx context.Context, inPath string, format OutputFormat, outPath *string) error {
+	return pdf24Convert(ctx, inPath, format, outPath)
+}
+
+// localConverter 本地转换服务，优先读取预录结果
+type localConverter struct {
+	Path    string
+	Command string
+}
+
+func (l localConverter) Convert(ctx context.Context, inPath string, format OutputFormat, outPath *string) error {
+	hash, err := fileHash(inPath)
+	if err != nil {
+		return err
+	}
+
+	return l.ConvertKey(ctx, hash, inPath, format, outPath)
+}
+
+// ConvertKey 按内容标识(见File.Key)读取预录结果，裁剪、阈值处理的输出不确定，按其文件哈希无法回放
+func (l localConverter) ConvertKey(ctx context.Context, key string, inPath string, format OutputFormat, outPath *string) error {
+	if len(l.Path) > 0 {
+		if recorded := findConverted(l.Path, key, format); len(recorded) > 0 {
+			// 任务结束时会删除转换结果，故复制而非直接引用预录文件
+			return copyToTemp(recorded, outPath)
+		}
+	}
+
+	if len(l.Command) == 0 {
+		return fmt.Errorf("无预录转换结果: %s", inPath)
+	}
+
+	if err := ensureDir(tempDir); err != nil {
+		return fmt.Errorf("创建临时目录失败: %w", err)
+	}
+
+	out := filepath.Join(tempDir, xid.New().String()+exts[format])
+	replacer := strings.NewReplacer("{in}", inPath, "{out}", out, "{format}", string(format))
+	args := strings.Fields(l.Command)
+	for i := range args {
+		args[i] = replacer.Replace(args[i])
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	if output, err := exec.CommandContext(ctx, args[0], args[1:]...).CombinedOutput(); err != nil {
+		return fmt.Errorf("执行本地转换失败: %w, %s", err, output)
+	}
+
+	if _, err := os.Stat(out); err != nil {
+		return fmt.Errorf("本地转换无输出文件: %w", err)
+	}
+
+	*outPath = out
+	return nil
+}
+
+// recordConverter 录制转换结果，供local转换服务离线回放
+type recordConverter struct {
+	converter DocConverter
+	Path      string
+}
+
+func (r recordConverter) Convert(ctx context.Context, inPath string, format OutputFormat, outPath *string) error {
+	hash, err := fileHash(inPath)
+	if err != nil {
+		return err
+	}
+
+	return r.ConvertKey(ctx, hash, inPath, format, outPath)
+}
+
+// ConvertKey 以内容标识(见File.Key)命名录制结果，与local转换服务的回放一致
+func (r recordConverter) ConvertKey(ctx context.Context, key string, inPath string, format OutputFormat, outPath *string) error {
+	if err := convertKey(ctx, r.converter, key, inPath, format, outPath); err != nil {
+		return err
+	}
+
+	if err := ensureDir(r.Path); err != nil {
+		return fmt.Errorf("创建录制目录失败: %w", err)
+	}
+
+	return copyFile(*outPath, filepath.Join(r.Path, key+filepath.Ext(*outPath)))
+}
+
+// findConverted 按文件名(不含扩展名)查找转换结果，图片格式多页时为zip
+func findConverted(dir, key string, format OutputFormat) string {
+	for _, ext := range []string{exts[format], ".zip"} {
+		name := filepath.Join(dir, key+ext)
+		if _, err := os.Stat(name); err == nil {
+			return name
+		}
+
+		if format != formatImage {
+			break
+		}
+	}
+
+	return ""
+}
+
+// fileHash 计算文件SHA256
+func fileHash(name string) (string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", fmt.Errorf("打开文件失败: %w", err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("读取文件失败: %w", err)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// copyToTemp 复制文件至临时目录
+func copyToTemp(src string, outPath *string) error {
+	if err := ensureDir(tempDir); err != nil {
+		return fmt.Errorf("创建临时目录失败: %w", err)
+	}
+
+	dst := filepath.Join(tempDir, xid.New().String()+filepath.Ext(src))
+	if err := copyFile(src, dst); err != nil {
+		return err
+	}
+
+	*outPath = dst
+	return nil
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("打开文件失败: %w", err)
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return fmt.Errorf("创建文件失败: %w", err)
+	}
+	defer out.Close()
+
+	if _, err := io.Copy(out, in); err != nil {
+		return fmt.Errorf("复制文件失败: %w", err)
+	}
+
+	return nil
+}
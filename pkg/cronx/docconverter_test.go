@@ -0,0 +1,119 @@
+package cronx
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewConverter(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     OcrConfig
+		wantErr bool
+	}{
+		{name: "默认阿里云", cfg: OcrConfig{}},
+		{name: "pdf24", cfg: OcrConfig{Provider: ConverterPdf24}},
+		{name: "本地预录", cfg: OcrConfig{Provider: ConverterLocal, Path: "testdata"}},
+		{name: "本地缺少配置", cfg: OcrConfig{Provider: ConverterLocal}, wantErr: true},
+		{name: "未知服务", cfg: OcrConfig{Provider: "abbyy"}, wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := test.cfg.NewConverter()
+			assert.Equal(t, test.wantErr, err != nil)
+		})
+	}
+}
+
+func TestLocalConverter(t *testing.T) {
+	dir := t.TempDir()
+	in := filepath.Join(dir, "dlgd.pdf")
+	assert.NoError(t, os.WriteFile(in, []byte("%PDF-1.4 dlgd"), fileMode))
+	hash, err := fileHash(in)
+	assert.NoError(t, err)
+
+	defer os.Remove(tempDir)
+
+	t.Run("预录结果", func(t *testing.T) {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, hash+".xlsx"), []byte("recorded"), fileMode))
+		converter, err := OcrConfig{Provider: ConverterLocal, Path: dir}.NewConverter()
+		assert.NoError(t, err)
+
+		out := ""
+		assert.NoError(t, converter.Convert(context.Background(), in, formatExcel, &out))
+		defer os.Remove(out)
+
+		buf, _ := os.ReadFile(out)
+		assert.Equal(t, "recorded", string(buf))
+	})
+
+	t.Run("无预录结果", func(t *testing.T) {
+		converter, err := OcrConfig{Provider: ConverterLocal, Path: dir}.NewConverter()
+		assert.NoError(t, err)
+
+		out := ""
+		assert.Error(t, converter.Convert(context.Background(), in, formatWord, &out))
+	})
+
+	t.Run("本地命令", func(t *testing.T) {
+		converter, err := OcrConfig{Provider: ConverterLocal, Command: "cp {in} {out}"}.NewConverter()
+		assert.NoError(t, err)
+
+		out := ""
+		assert.NoError(t, converter.Convert(context.Background(), in, formatWord, &out))
+		defer os.Remove(out)
+
+		assert.Equal(t, ".docx", filepath.Ext(out))
+	})
+}
+
+func TestRecordConverterReplay(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "dlgd.png")
+	writeTestPng(t, src)
+
+	assert.NoError(t, ensureDir(tempDir))
+	defer os.Remove(tempDir)
+
+	// 原始文件经pdfcpu转换、裁剪后转换，输出文件每次不同
+	convert := func(converter DocConverter) string {
+		in := filepath.Join(dir, "source.png")
+		assert.NoError(t, copyFile(src, in))
+
+		p := NewPipeline("dlgd")
+		defer p.Close()
+
+		pdf := NewFile(p, "pdf")
+		subs := NewFile(p, "subs")
+		excel := NewFile(p, "excel")
+		pages := NewValue[[]string](p, "pages")
+		pdf.Set(in)
+		pages.Set([]string{"1"})
+		p.Add(
+			image2PdfStep(pdf),
+			cropPdfStep(pdf, subs, pages),
+			convertStep("convert", converter, subs, excel, formatExcel),
+		)
+
+		if !assert.NoError(t, p.Run(context.Background())) {
+			return ""
+		}
+		buf, _ := os.ReadFile(excel.Path())
+		return string(buf)
+	}
+
+	calls := 0
+	records := filepath.Join(dir, "records")
+	assert.Equal(t, string(formatExcel), convert(recordConverter{converter: countConverter{calls: &calls}, Path: records}))
+	assert.Equal(t, 1, calls)
+
+	// 离线回放录制结果
+	converter, err := OcrConfig{Provider: ConverterLocal, Path: records}.NewConverter()
+	assert.NoError(t, err)
+	assert.Equal(t, string(formatExcel), convert(converter))
+}
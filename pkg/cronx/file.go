@@ -1,6 +1,7 @@
 package cronx
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -59,7 +60,7 @@ func image2Pdf(in string, out *string) error {
 }
 
 // 文件本地化
-func localize(url string, name *string) error {
+func localize(ctx context.Context, url string, name *string) error {
 	if !urlRegex.MatchString(url) {
 
 		return fmt.Errorf("无效的URL格式: %s", expx.If(url == "", "空URL", url))
@@ -75,7 +76,7 @@ func localize(url string, name *string) error {
 	}
 
 	localPath := filepath.Join(tempDir, fmt.Sprintf("%s.%s", xid.New().String(), ext))
-	if err := download(url, localPath); err != nil {
+	if err := download(ctx, url, localPath); err != nil {
 		return fmt.Errorf("文件下载失败: %w", err)
 	}
 
@@ -84,8 +85,8 @@ func localize(url string, name *string) error {
 }
 
 // 下载文件
-func download(url, name string) error {
-	req, err := http.NewRequest("GET", url, nil)
+func download(ctx context.Context, url, name string) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return fmt.Errorf("创建HTTP请求失败: %w", err)
 	}
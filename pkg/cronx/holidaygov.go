@@ -48,7 +48,7 @@ func DefaultHolidayGovTask() string {
 			Urls: []chromedpx.DPUrl{
 				{
 					Url:    url,
-					Clicks: []string{title},
+					Clicks: chromedpx.Clicks(title),
 				},
 			},
 			Outer: chromedpx.DPOuter{
@@ -0,0 +1,92 @@
+package cronx
+
+import (
+	"strings"
+	"time"
+)
+
+// 参考：RFC 5545 Internet Calendaring and Scheduling Core Object Specification
+
+const (
+	icsProdId    = "-//seeccloud//EDSCron//CN"
+	icsLineLimit = 75 // 单行最大字节数，超出需折行
+	icsDate      = "20060102"
+	icsDatetime  = "20060102T150405"
+)
+
+// IcsEvent 日历事件
+type IcsEvent struct {
+	Uid         string    // 唯一标识，重复导入时日历软件据此覆盖旧事件
+	Summary     string    // 标题，如：尖段
+	Description string    // 描述，如：电价1.2345
+	Start       time.Time // 开始时间
+	End         time.Time // 结束时间（不含）
+	AllDay      bool      // 全天事件，仅取Start/End日期部分
+}
+
+// BuildIcs 生成iCalendar文本，calName为日历名称，tzid为时区标识（如Asia/Shanghai）
+// 事件时间为tzid时区的当地时间，输出为UTC时间，无需VTIMEZONE组件(RFC 5545 §3.2.19)
+func BuildIcs(calName, tzid string, events []IcsEvent) string {
+	var b strings.Builder
+	loc, err := time.LoadLocation(tzid)
+	if err != nil {
+		loc = time.Local
+	}
+	stamp := time.Now().UTC().Format(icsDatetime) + "Z"
+
+	writeIcsLine(&b, "BEGIN:VCALENDAR")
+	writeIcsLine(&b, "VERSION:2.0")
+	writeIcsLine(&b, "PRODID:"+icsProdId)
+	writeIcsLine(&b, "CALSCALE:GREGORIAN")
+	writeIcsLine(&b, "METHOD:PUBLISH")
+	writeIcsLine(&b, "X-WR-CALNAME:"+escapeIcsText(calName))
+	writeIcsLine(&b, "X-WR-TIMEZONE:"+tzid)
+
+	for _, e := range events {
+		writeIcsLine(&b, "BEGIN:VEVENT")
+		writeIcsLine(&b, "UID:"+e.Uid)
+		writeIcsLine(&b, "DTSTAMP:"+stamp)
+		if e.AllDay {
+			writeIcsLine(&b, "DTSTART;VALUE=DATE:"+e.Start.Format(icsDate))
+			writeIcsLine(&b, "DTEND;VALUE=DATE:"+e.End.Format(icsDate))
+			writeIcsLine(&b, "TRANSP:TRANSPARENT")
+		} else {
+			writeIcsLine(&b, "DTSTART:"+icsUtc(e.Start, loc))
+			writeIcsLine(&b, "DTEND:"+icsUtc(e.End, loc))
+		}
+		writeIcsLine(&b, "SUMMARY:"+escapeIcsText(e.Summary))
+		if len(e.Description) > 0 {
+			writeIcsLine(&b, "DESCRIPTION:"+escapeIcsText(e.Description))
+		}
+		writeIcsLine(&b, "END:VEVENT")
+	}
+
+	writeIcsLine(&b, "END:VCALENDAR")
+	return b.String()
+}
+
+// icsUtc 按loc时区的当地时间转为UTC时间，如Asia/Shanghai 11:00 → 030000Z
+func icsUtc(t time.Time, loc *time.Location) string {
+	local := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, loc)
+	return local.UTC().Format(icsDatetime) + "Z"
+}
+
+// escapeIcsText 转义TEXT类型值中的特殊字符
+func escapeIcsText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+// writeIcsLine 按75字节折行写入，且不拆分UTF-8多字节字符，行尾为CRLF
+func writeIcsLine(b *strings.Builder, line string) {
+	size := 0
+	for _, r := range line {
+		n := len(string(r))
+		if size+n > icsLineLimit {
+			b.WriteString("\r\n ")
+			size = 1
+		}
+		b.WriteRune(r)
+		size += n
+	}
+	b.WriteString("\r\n")
+}
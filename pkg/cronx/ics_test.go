@@ -0,0 +1,53 @@
+package cronx
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBuildIcs(t *testing.T) {
+	start := time.Date(2025, 8, 1, 11, 0, 0, 0, time.Local)
+	events := []IcsEvent{
+		{
+			Uid:         "20250801-sharp-1100@edscron",
+			Summary:     "尖段",
+			Description: "福建>工商业,两部制>1-10（20）千伏; 电价1.2345",
+			Start:       start,
+			End:         start.Add(time.Hour),
+		},
+		{
+			Uid:     "20251001-holiday@edscron",
+			Summary: "假日 国庆节",
+			Start:   time.Date(2025, 10, 1, 0, 0, 0, 0, time.Local),
+			End:     time.Date(2025, 10, 2, 0, 0, 0, 0, time.Local),
+			AllDay:  true,
+		},
+	}
+
+	ics := BuildIcs(strings.Repeat("分时电价", 10), "Asia/Shanghai", events)
+	wants := []string{
+		"BEGIN:VCALENDAR\r\n",
+		"DTSTART:20250801T030000Z\r\n",
+		"DTEND:20250801T040000Z\r\n",
+		`DESCRIPTION:福建>工商业\,两部制>1-10（20）千伏\; 电价1.2345`,
+		"DTSTART;VALUE=DATE:20251001\r\n",
+		"DTEND;VALUE=DATE:20251002\r\n",
+		"END:VCALENDAR\r\n",
+	}
+	for _, want := range wants {
+		if !strings.Contains(ics, want) {
+			t.Errorf("BuildIcs() missing %q", want)
+		}
+	}
+
+	if strings.Contains(ics, "TZID=") {
+		t.Errorf("BuildIcs() TZID without VTIMEZONE")
+	}
+
+	for _, line := range strings.Split(ics, "\r\n") {
+		if len(line) > icsLineLimit {
+			t.Errorf("BuildIcs() line too long(%d): %q", len(line), line)
+		}
+	}
+}
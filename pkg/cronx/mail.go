@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"html/template"
+	"strings"
 
 	"github.com/zeromicro/go-zero/core/logx"
 	"gopkg.in/gomail.v2"
@@ -17,6 +18,8 @@ const (
 	SubjectTwdlNotice      MailSubject = "[通知]台湾电价表更新"
 	SubjectTwCarbonNotice  MailSubject = "[通知]台湾碳排因子更新"
 	SubjectDlgdHourConfirm MailSubject = "[通知]时段划分确认"
+	SubjectPriceEventAlert MailSubject = "[预警]次日气温触发尖峰电价"
+	SubjectReprocess       MailSubject = "[通知]归档文件重新解析"
 )
 
 // MailConfig 邮件服务器配置
@@ -26,6 +29,8 @@ type MailConfig struct {
 	Username string `json:"username"` // 发件人账号
 	Password string `json:"password"` // 发件人密码/授权码
 	Addr     string `json:"addr"`     // 收件人邮箱地址
+	// 工程ID对应的收件人邮箱(多个以逗号分隔)，用于按工程发送的通知(如电价预警)，未配置时发送至Addr
+	Accounts map[string]string `json:"accounts,optional"`
 }
 
 // MailSubject 邮件主题类型
@@ -42,6 +47,8 @@ type DlgdHourConfirmTemplate struct {
 	Month  string
 	DocNo  string
 	Detail template.HTML
+	Issues []string             // 时段覆盖校验问题
+	Diag   *DlgdHourDiagnostics // 时段解析诊断
 }
 
 func (t DlgdHourConfirmTemplate) Subject() MailSubject {
@@ -52,7 +59,40 @@ func (t DlgdHourConfirmTemplate) Body() (string, error) {
 	if t.DocNo == "" {
 		t.DocNo = "无"
 	}
-	const tpl = `<p>区域：<b>{{.Area}}</b></p><p>月份：<b>{{.Month}}</b></p><p>政策文号：<b>{{.DocNo}}</b></p><p>时段划分：</p><div>{{.Detail | safeHTML}}</div>`
+	const tpl = `<p>区域：<b>{{.Area}}</b></p><p>月份：<b>{{.Month}}</b></p><p>政策文号：<b>{{.DocNo}}</b></p><p>时段划分：</p><div>{{.Detail | safeHTML}}</div>{{if .Issues}}<p>校验问题：</p><ul>{{range .Issues}}<li>{{.}}</li>{{end}}</ul>{{end}}{{with .Diag}}<p>解析置信度：<b>{{.Confidence}}</b></p>{{if .Unmatched}}<p>未匹配原文：</p><ul>{{range .Unmatched}}<li>{{.}}</li>{{end}}</ul>{{end}}{{end}}`
+	return renderTemplate(tpl, t)
+}
+
+// DlgdValidateTemplate 代理购电入库校验失败模板
+type DlgdValidateTemplate struct {
+	Area   string
+	Month  string
+	Issues []string  // 校验问题
+	Rows   []DlgdRow // 解析的电价，未入库
+}
+
+func (t DlgdValidateTemplate) Subject() MailSubject {
+	return SubjectDlgdWarning
+}
+
+func (t DlgdValidateTemplate) Body() (string, error) {
+	const tpl = `<p>区域：<b>{{.Area}}</b></p><p>月份：<b>{{.Month}}</b></p><p>电价校验未通过，未入库：</p><ul>{{range .Issues}}<li>{{.}}</li>{{end}}</ul><p>解析结果：</p><table border="1" cellspacing="0" cellpadding="4"><tr><th>用电分类</th><th>电压等级</th><th>阶梯</th><th>尖段</th><th>峰段</th><th>平段</th><th>谷段</th><th>深谷</th><th>需量电价</th><th>容量电价</th></tr>{{range .Rows}}<tr><td>{{.Category}}</td><td>{{.Voltage}}</td><td>{{.Stage}}</td><td>{{.Sharp}}</td><td>{{.Peak}}</td><td>{{.Flat}}</td><td>{{.Valley}}</td><td>{{.Deep}}</td><td>{{.Demand}}</td><td>{{.Capacity}}</td></tr>{{end}}</table>`
+	return renderTemplate(tpl, t)
+}
+
+// PriceEventTemplate 次日气温触发尖峰/高峰预警模板
+type PriceEventTemplate struct {
+	Account string
+	Date    string
+	Details template.HTML
+}
+
+func (t PriceEventTemplate) Subject() MailSubject {
+	return SubjectPriceEventAlert
+}
+
+func (t PriceEventTemplate) Body() (string, error) {
+	const tpl = `<p>工程ID：<b>{{.Account}}</b></p><p>预报<b>{{.Date}}</b>最高气温达到电价触发条件(实测前仅供参考)：</p><div>{{.Details | safeHTML}}</div>`
 	return renderTemplate(tpl, t)
 }
 
@@ -87,6 +127,23 @@ func (t HolidayNoticeTemplate) Body() (string, error) {
 	return renderTemplate(tpl, t)
 }
 
+// ReprocessTemplate 归档文件重新解析结果模板
+type ReprocessTemplate struct {
+	Id       int64
+	Category string
+	Area     string
+	Message  string // 失败原因，为空表示成功
+}
+
+func (t ReprocessTemplate) Subject() MailSubject {
+	return SubjectReprocess
+}
+
+func (t ReprocessTemplate) Body() (string, error) {
+	const tpl = `<p>归档记录：<b>{{.Id}}</b>({{.Category}} {{.Area}})</p>{{if .Message}}<p>重新解析失败：</p><p><blockquote>{{.Message}}</blockquote></p>{{else}}<p>重新解析成功，电价已更新</p>{{end}}`
+	return renderTemplate(tpl, t)
+}
+
 // EmptyDataTemplate 空数据模板
 type EmptyDataTemplate struct {
 	TaskDetail interface{}
@@ -171,6 +228,19 @@ func emptyValueErr[T any](m *MailConfig, task any, v *[]T) (*[]T, error) {
 
 // Send 发送邮件通知
 func (m *MailConfig) Send(template MailTemplate, files ...string) error {
+	return m.sendTo(m.Addr, template, files...)
+}
+
+// SendAccount 按工程发送邮件通知，收件人见Accounts
+func (m *MailConfig) SendAccount(account string, template MailTemplate, files ...string) error {
+	addr, ok := m.Accounts[account]
+	if !ok || len(addr) == 0 {
+		addr = m.Addr
+	}
+	return m.sendTo(addr, template, files...)
+}
+
+func (m *MailConfig) sendTo(addr string, template MailTemplate, files ...string) error {
 	body, err := template.Body()
 	if err != nil {
 		return fmt.Errorf("生成邮件内容失败: %w", err)
@@ -178,7 +248,7 @@ func (m *MailConfig) Send(template MailTemplate, files ...string) error {
 
 	msg := gomail.NewMessage()
 	msg.SetHeader("From", msg.FormatAddress(m.Username, "EDS服务"))
-	msg.SetHeader("To", m.Addr)
+	msg.SetHeader("To", strings.Split(addr, ",")...)
 	msg.SetHeader("Subject", string(template.Subject()))
 	msg.SetBody("text/html", body)
 	for _, file := range files {
@@ -0,0 +1,289 @@
+package cronx
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"seeccloud.com/edscron/pkg/x/expx"
+)
+
+type ReadingKind string
+
+type GapPolicy string
+
+const (
+	ReadingInterval   ReadingKind = "interval"   // 区间电量，时间为区间起点
+	ReadingCumulative ReadingKind = "cumulative" // 累计表码，时间为抄表时刻
+
+	GapLinear   GapPolicy = "linear"   // 线性插值（默认）
+	GapPrevious GapPolicy = "previous" // 沿用前值
+	GapZero     GapPolicy = "zero"     // 补零，累计表码的缺口电量全部计入下次抄表所在时段
+
+	meterSlot      = 30 * time.Minute // 电量网格粒度
+	meterTolerance = time.Minute      // 抄表时刻与网格边界的容许偏差
+	meterRollover  = 0.1              // 翻转判定：前值不低于满量程的90%，且后值不高于满量程的10%
+)
+
+// MeterReading 带时间戳的电表读数
+type MeterReading struct {
+	Time     time.Time // 区间起点或抄表时刻
+	Value    float64   // 区间电量或累计表码
+	Replaced bool      // 换表后首个读数，累计表码自此重新起算
+}
+
+// MeterGrid 30分钟电量网格
+type MeterGrid struct {
+	Values         []float64 // 30分钟电量，与BillReq.ep30ms一致
+	Estimated      []bool    // 是否为估算值
+	EstimatedSize  int       // 估算点数
+	EstimatedUsage float64   // 估算电量
+	RolloverSize   int       // 表码翻转次数
+	ReplaceSize    int       // 换表次数
+	BackwardSize   int       // 表码回退次数(如人工修正)，区间电量未知，按缺数补齐
+	UncoveredSize  int       // 首个读数之前、末个读数之后的无数据点数，电量计0不外推
+}
+
+// NewMeterGrid 将读数转换为从start起、共size个点的30分钟电量网格
+//
+// 参数:
+//   - kind: 读数类型，缺省为区间电量
+//   - policy: 缺数补齐策略，缺省为线性插值
+//   - meterMax: 累计表码满量程，缺省时按翻转前表码整数位数推算，如99876→100000、9999.5→10000
+func NewMeterGrid(start time.Time, size int, kind ReadingKind, policy GapPolicy, meterMax float64, readings []MeterReading) (*MeterGrid, error) {
+	if len(readings) == 0 {
+		return nil, fmt.Errorf("电表读数为空")
+	}
+
+	if len(policy) == 0 {
+		policy = GapLinear
+	}
+
+	if policy != GapLinear && policy != GapPrevious && policy != GapZero {
+		return nil, fmt.Errorf("不支持的缺数补齐策略: %s", policy)
+	}
+
+	sorted := make([]MeterReading, len(readings))
+	copy(sorted, readings)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Time.Before(sorted[j].Time)
+	})
+
+	grid := &MeterGrid{
+		Values:    make([]float64, size),
+		Estimated: make([]bool, size),
+	}
+
+	var known []bool
+	switch kind {
+	case ReadingInterval, "":
+		known = grid.fromInterval(start, sorted)
+	case ReadingCumulative:
+		if len(sorted) < 2 {
+			return nil, fmt.Errorf("累计表码至少需要2个读数")
+		}
+		known = grid.fromCumulative(start, sorted, policy, meterMax)
+	default:
+		return nil, fmt.Errorf("不支持的读数类型: %s", kind)
+	}
+
+	grid.fillGaps(known, policy)
+
+	for i, v := range grid.Values {
+		if grid.Estimated[i] {
+			grid.EstimatedSize++
+			grid.EstimatedUsage += v
+		}
+	}
+
+	return grid, nil
+}
+
+// fromInterval 区间电量按起点归入网格，粒度小于30分钟时累加，大于30分钟时均分
+func (g *MeterGrid) fromInterval(start time.Time, readings []MeterReading) []bool {
+	size := len(g.Values)
+	known := make([]bool, size)
+	counts := make([]int, size)
+
+	step := readingStep(readings)
+	perSlot := max(int(meterSlot/step), 1)
+	spread := max(int(step/meterSlot), 1)
+
+	for _, r := range readings {
+		idx := int(math.Floor(float64(r.Time.Sub(start)) / float64(meterSlot)))
+		for k := idx; k < idx+spread; k++ {
+			if k < 0 || k >= size {
+				continue
+			}
+			g.Values[k] += r.Value / float64(spread)
+			counts[k]++
+		}
+	}
+
+	for i, n := range counts {
+		if n == 0 {
+			continue
+		}
+
+		known[i] = true
+		// 部分缺数按比例放大
+		if n < perSlot {
+			g.Values[i] = g.Values[i] * float64(perSlot) / float64(n)
+			g.Estimated[i] = true
+		}
+	}
+
+	return known
+}
+
+// fromCumulative 累计表码差值按时间重叠比例分摊到网格，处理翻转、换表和表码回退
+func (g *MeterGrid) fromCumulative(start time.Time, readings []MeterReading, policy GapPolicy, meterMax float64) []bool {
+	size := len(g.Values)
+	known := make([]bool, size)
+	covered := make([]time.Duration, size)
+	measured := make([]time.Duration, size)
+	end := start.Add(meterSlot * time.Duration(size))
+
+	for i := 1; i < len(readings); i++ {
+		a, b := readings[i-1], readings[i]
+		if !b.Time.After(a.Time) {
+			continue
+		}
+
+		// 换表区间电量未知，留待补齐
+		if b.Replaced {
+			g.ReplaceSize++
+			continue
+		}
+
+		delta := b.Value - a.Value
+		if delta < 0 {
+			full := meterMax
+			if full <= 0 && a.Value > 0 {
+				full = math.Pow(10, math.Floor(math.Log10(a.Value))+1)
+			}
+
+			// 接近满量程后归零才是翻转，其他回退(如12345.6→12345.5)电量未知，留待补齐
+			if full <= 0 || a.Value < full*(1-meterRollover) || b.Value > full*meterRollover {
+				g.BackwardSize++
+				continue
+			}
+			delta += full
+			g.RolloverSize++
+		}
+
+		if !b.Time.After(start) || !a.Time.Before(end) {
+			continue
+		}
+
+		segment := b.Time.Sub(a.Time)
+		isGap := segment > meterSlot+meterTolerance
+		first := max(int(math.Floor(float64(a.Time.Sub(start))/float64(meterSlot))), 0)
+		last := min(int(math.Floor(float64(b.Time.Sub(start)-1)/float64(meterSlot))), size-1)
+		for k := first; k <= last; k++ {
+			slotStart := start.Add(meterSlot * time.Duration(k))
+			overlap := minTime(b.Time, slotStart.Add(meterSlot)).Sub(maxTime(a.Time, slotStart))
+			if overlap <= 0 {
+				continue
+			}
+
+			covered[k] += overlap
+			if !isGap {
+				measured[k] += overlap
+			}
+
+			// 缺口内总量已知，补零策略将电量全部计入下次抄表所在时段，其余策略按时间均摊
+			if isGap && policy == GapZero {
+				if b.Time.Sub(slotStart) <= meterSlot {
+					g.Values[k] += delta
+				}
+				continue
+			}
+			g.Values[k] += delta * float64(overlap) / float64(segment)
+		}
+	}
+
+	for k := range size {
+		if covered[k] == 0 {
+			continue
+		}
+
+		known[k] = true
+		if measured[k] < meterSlot-meterTolerance {
+			g.Estimated[k] = true
+		}
+
+		// 首末或换表处部分覆盖的时段按比例放大
+		if covered[k] < meterSlot-meterTolerance && policy != GapZero {
+			g.Values[k] = g.Values[k] * float64(meterSlot) / float64(covered[k])
+		}
+	}
+
+	return known
+}
+
+// fillGaps 按策略补齐读数之间无数据的时段，首个读数之前及末个读数之后不外推(如月中装表)，计0并计入UncoveredSize
+func (g *MeterGrid) fillGaps(known []bool, policy GapPolicy) {
+	size := len(g.Values)
+	for i := 0; i < size; {
+		if known[i] {
+			i++
+			continue
+		}
+
+		j := i
+		for j < size && !known[j] {
+			j++
+		}
+
+		left, right := i-1, j
+		if left < 0 || right >= size {
+			g.UncoveredSize += j - i
+			i = j
+			continue
+		}
+
+		for k := i; k < j; k++ {
+			g.Estimated[k] = true
+			switch {
+			case policy == GapZero:
+				g.Values[k] = 0
+			case policy == GapLinear:
+				ratio := float64(k-left) / float64(right-left)
+				g.Values[k] = g.Values[left] + (g.Values[right]-g.Values[left])*ratio
+			default:
+				g.Values[k] = g.Values[left]
+			}
+		}
+
+		i = j
+	}
+}
+
+// readingStep 取相邻读数的最小间隔作为数据粒度，缺数只会使间隔变大
+func readingStep(readings []MeterReading) time.Duration {
+	step := time.Duration(0)
+	for i := 1; i < len(readings); i++ {
+		d := readings[i].Time.Sub(readings[i-1].Time)
+		if d >= meterTolerance && (step == 0 || d < step) {
+			step = d
+		}
+	}
+
+	return expx.If(step == 0, meterSlot, step)
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
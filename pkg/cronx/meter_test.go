@@ -0,0 +1,148 @@
+package cronx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMeterGrid(t *testing.T) {
+	start := time.Date(2025, 8, 1, 0, 0, 0, 0, time.Local)
+	at := func(minutes int) time.Time {
+		return start.Add(time.Minute * time.Duration(minutes))
+	}
+
+	tests := []struct {
+		name          string
+		kind          ReadingKind
+		policy        GapPolicy
+		meterMax      float64
+		readings      []MeterReading
+		want          []float64
+		wantEstimated int
+		wantRollover  int
+		wantReplace   int
+		wantBackward  int
+		wantUncovered int
+	}{
+		{
+			name: "区间电量，15分钟粒度，缺一点放大，缺整点线性插值",
+			kind: ReadingInterval,
+			readings: []MeterReading{
+				{Time: at(0), Value: 5}, {Time: at(15), Value: 5},
+				{Time: at(30), Value: 6},
+				{Time: at(90), Value: 10}, {Time: at(105), Value: 10},
+			},
+			want:          []float64{10, 12, 16, 20},
+			wantEstimated: 2,
+		},
+		{
+			name:   "区间电量，缺整点沿用前值",
+			kind:   ReadingInterval,
+			policy: GapPrevious,
+			readings: []MeterReading{
+				{Time: at(0), Value: 10}, {Time: at(30), Value: 12}, {Time: at(90), Value: 20},
+			},
+			want:          []float64{10, 12, 12, 20},
+			wantEstimated: 1,
+		},
+		{
+			name: "累计表码，翻转",
+			kind: ReadingCumulative,
+			readings: []MeterReading{
+				{Time: at(0), Value: 99990}, {Time: at(30), Value: 99998},
+				{Time: at(60), Value: 6}, {Time: at(90), Value: 16}, {Time: at(120), Value: 26},
+			},
+			want:         []float64{8, 8, 10, 10},
+			wantRollover: 1,
+		},
+		{
+			name: "累计表码，缺一次抄表均摊",
+			kind: ReadingCumulative,
+			readings: []MeterReading{
+				{Time: at(0), Value: 100}, {Time: at(30), Value: 110},
+				{Time: at(90), Value: 130}, {Time: at(120), Value: 140},
+			},
+			want:          []float64{10, 10, 10, 10},
+			wantEstimated: 2,
+		},
+		{
+			name:   "累计表码，缺一次抄表补零",
+			kind:   ReadingCumulative,
+			policy: GapZero,
+			readings: []MeterReading{
+				{Time: at(0), Value: 100}, {Time: at(30), Value: 110},
+				{Time: at(90), Value: 130}, {Time: at(120), Value: 140},
+			},
+			want:          []float64{10, 0, 20, 10},
+			wantEstimated: 2,
+		},
+		{
+			name: "累计表码，换表",
+			kind: ReadingCumulative,
+			readings: []MeterReading{
+				{Time: at(0), Value: 500}, {Time: at(30), Value: 510},
+				{Time: at(60), Value: 0, Replaced: true}, {Time: at(90), Value: 14}, {Time: at(120), Value: 28},
+			},
+			want:          []float64{10, 12, 14, 14},
+			wantEstimated: 1,
+			wantReplace:   1,
+		},
+		{
+			name: "累计表码，小幅回退不视为翻转",
+			kind: ReadingCumulative,
+			readings: []MeterReading{
+				{Time: at(0), Value: 12335.6}, {Time: at(30), Value: 12345.6},
+				{Time: at(60), Value: 12345.5}, {Time: at(90), Value: 12355.5}, {Time: at(120), Value: 12365.5},
+			},
+			want:          []float64{10, 10, 10, 10},
+			wantEstimated: 1,
+			wantBackward:  1,
+		},
+		{
+			name: "累计表码，小数表码翻转按整数位数推算满量程",
+			kind: ReadingCumulative,
+			readings: []MeterReading{
+				{Time: at(0), Value: 9979.5}, {Time: at(30), Value: 9989.5}, {Time: at(60), Value: 9999.5},
+				{Time: at(90), Value: 9.5}, {Time: at(120), Value: 19.5},
+			},
+			want:         []float64{10, 10, 10, 10},
+			wantRollover: 1,
+		},
+		{
+			name: "累计表码，首末读数之外不外推",
+			kind: ReadingCumulative,
+			readings: []MeterReading{
+				{Time: at(30), Value: 100}, {Time: at(60), Value: 110}, {Time: at(90), Value: 120},
+			},
+			want:          []float64{0, 10, 10, 0},
+			wantUncovered: 2,
+		},
+		{
+			name: "区间电量，首末读数之外不外推",
+			kind: ReadingInterval,
+			readings: []MeterReading{
+				{Time: at(30), Value: 10}, {Time: at(60), Value: 12},
+			},
+			want:          []float64{0, 10, 12, 0},
+			wantUncovered: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			grid, err := NewMeterGrid(start, 4, test.kind, test.policy, test.meterMax, test.readings)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.InDeltaSlice(t, test.want, grid.Values, 1e-6)
+			assert.Equal(t, test.wantEstimated, grid.EstimatedSize)
+			assert.Equal(t, test.wantRollover, grid.RolloverSize)
+			assert.Equal(t, test.wantReplace, grid.ReplaceSize)
+			assert.Equal(t, test.wantBackward, grid.BackwardSize)
+			assert.Equal(t, test.wantUncovered, grid.UncoveredSize)
+		})
+	}
+}
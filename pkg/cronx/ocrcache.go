@@ -0,0 +1,134 @@
+package cronx
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	pdfapi "github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const defaultCacheDays = 30
+
+// OcrUsage 文档转换用量
+type OcrUsage struct {
+	Provider string // 转换服务
+	Pages    int64  // 输入PDF页数
+	Cached   bool   // 是否命中缓存(未实际调用转换服务)
+}
+
+// meterConverter 统计实际调用转换服务的页数
+type meterConverter struct {
+	converter DocConverter
+	provider  string
+	onUsage   func(OcrUsage)
+}
+
+func (m meterConverter) Convert(ctx context.Context, inPath string, format OutputFormat, outPath *string) error {
+	hash, err := fileHash(inPath)
+	if err != nil {
+		return err
+	}
+
+	return m.ConvertKey(ctx, hash, inPath, format, outPath)
+}
+
+// ConvertKey 向内层(如录制)传递内容标识
+func (m meterConverter) ConvertKey(ctx context.Context, key string, inPath string, format OutputFormat, outPath *string) error {
+	if err := convertKey(ctx, m.converter, key, inPath, format, outPath); err != nil {
+		return err
+	}
+
+	m.onUsage(OcrUsage{Provider: m.provider, Pages: pageCount(inPath)})
+	return nil
+}
+
+// keyConverter 按内容标识(见File.Key)缓存转换结果的文档转换服务
+type keyConverter interface {
+	ConvertKey(ctx context.Context, key string, inPath string, format OutputFormat, outPath *string) error
+}
+
+// convertKey 转换服务支持内容标识时传递key，否则按输入文件转换
+func convertKey(ctx context.Context, converter DocConverter, key string, inPath string, format OutputFormat, outPath *string) error {
+	if kc, ok := converter.(keyConverter); ok {
+		return kc.ConvertKey(ctx, key, inPath, format, outPath)
+	}
+	return converter.Convert(ctx, inPath, format, outPath)
+}
+
+// cacheConverter 按输入内容标识及输出格式缓存转换结果，避免重复抓取的同一文件重复计费
+type cacheConverter struct {
+	converter DocConverter
+	provider  string
+	Dir       string
+	Days      int
+	onUsage   func(OcrUsage)
+}
+
+func (c cacheConverter) Convert(ctx context.Context, inPath string, format OutputFormat, outPath *string) error {
+	hash, err := fileHash(inPath)
+	if err != nil {
+		return err
+	}
+
+	return c.ConvertKey(ctx, hash, inPath, format, outPath)
+}
+
+// ConvertKey 以内容标识key(原始文件SHA256加裁剪、阈值等处理参数)缓存，不受处理输出不确定的影响
+func (c cacheConverter) ConvertKey(ctx context.Context, key string, inPath string, format OutputFormat, outPath *string) error {
+	name := fmt.Sprintf("%s-%s", key, format)
+	if cached := findConverted(c.Dir, name, format); len(cached) > 0 {
+		// 刷新使用时间，保留期按最近使用计算
+		now := time.Now()
+		os.Chtimes(cached, now, now)
+		if c.onUsage != nil {
+			c.onUsage(OcrUsage{Provider: c.provider, Pages: pageCount(inPath), Cached: true})
+		}
+		return copyToTemp(cached, outPath)
+	}
+
+	if err := convertKey(ctx, c.converter, key, inPath, format, outPath); err != nil {
+		return err
+	}
+
+	if err := ensureDir(c.Dir); err != nil {
+		return fmt.Errorf("创建缓存目录失败: %w", err)
+	}
+
+	// 缓存失败不影响转换结果
+	if err := copyFile(*outPath, filepath.Join(c.Dir, name+filepath.Ext(*outPath))); err != nil {
+		logx.Errorf("缓存转换结果失败: %v", err)
+	}
+
+	c.purge()
+	return nil
+}
+
+// purge 清理超过保留天数未使用的缓存
+func (c cacheConverter) purge() {
+	entries, err := os.ReadDir(c.Dir)
+	if err != nil {
+		return
+	}
+
+	expire := time.Now().AddDate(0, 0, -c.Days)
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil || info.IsDir() || info.ModTime().After(expire) {
+			continue
+		}
+		os.Remove(filepath.Join(c.Dir, entry.Name()))
+	}
+}
+
+// pageCount PDF页数，无法读取时按1页计
+func pageCount(name string) int64 {
+	cnt, err := pdfapi.PageCountFile(name)
+	if err != nil || cnt == 0 {
+		return 1
+	}
+	return int64(cnt)
+}
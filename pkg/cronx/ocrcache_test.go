@@ -0,0 +1,125 @@
+package cronx
+
+import (
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+)
+
+// countConverter 统计调用次数的转换服务
+type countConverter struct {
+	calls *int
+}
+
+func (c countConverter) Convert(ctx context.Context, inPath string, format OutputFormat, outPath *string) error {
+	*c.calls++
+	*outPath = filepath.Join(tempDir, xid.New().String()+exts[format])
+	return os.WriteFile(*outPath, []byte(format), fileMode)
+}
+
+func TestCacheConverter(t *testing.T) {
+	dir := t.TempDir()
+	in := filepath.Join(dir, "dlgd.pdf")
+	assert.NoError(t, os.WriteFile(in, []byte("%PDF-1.4 dlgd"), fileMode))
+
+	assert.NoError(t, ensureDir(tempDir))
+	defer os.Remove(tempDir)
+
+	calls := 0
+	usages := make([]OcrUsage, 0)
+	onUsage := func(u OcrUsage) { usages = append(usages, u) }
+	converter := cacheConverter{
+		converter: meterConverter{converter: countConverter{calls: &calls}, provider: ConverterAli, onUsage: onUsage},
+		provider:  ConverterAli,
+		Dir:       filepath.Join(dir, "cache"),
+		Days:      defaultCacheDays,
+		onUsage:   onUsage,
+	}
+
+	for i := 0; i < 2; i++ {
+		out := ""
+		assert.NoError(t, converter.Convert(context.Background(), in, formatExcel, &out))
+		os.Remove(out)
+	}
+
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, []OcrUsage{
+		{Provider: ConverterAli, Pages: 1},
+		{Provider: ConverterAli, Pages: 1, Cached: true},
+	}, usages)
+
+	// 不同输出格式分别缓存
+	out := ""
+	assert.NoError(t, converter.Convert(context.Background(), in, formatWord, &out))
+	os.Remove(out)
+	assert.Equal(t, 2, calls)
+
+	// 过期缓存被清理
+	expired := filepath.Join(converter.Dir, "expired.xlsx")
+	assert.NoError(t, os.WriteFile(expired, []byte("expired"), fileMode))
+	old := time.Now().AddDate(0, 0, -defaultCacheDays-1)
+	assert.NoError(t, os.Chtimes(expired, old, old))
+	converter.purge()
+	assert.NoFileExists(t, expired)
+}
+
+// writeTestPng 生成测试图片，经image2PdfStep转换为PDF后可裁剪
+func writeTestPng(t *testing.T, name string) {
+	img := image.NewGray(image.Rect(0, 0, 40, 20))
+	for x := 0; x < 40; x++ {
+		img.SetGray(x, x/2, color.Gray{Y: 240})
+	}
+	f, err := os.Create(name)
+	assert.NoError(t, err)
+	assert.NoError(t, png.Encode(f, img))
+	f.Close()
+}
+
+func TestCacheConverterDerived(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "dlgd.png")
+	writeTestPng(t, src)
+
+	assert.NoError(t, ensureDir(tempDir))
+	defer os.Remove(tempDir)
+
+	calls := 0
+	converter := cacheConverter{
+		converter: countConverter{calls: &calls},
+		provider:  ConverterAli,
+		Dir:       filepath.Join(dir, "cache"),
+		Days:      defaultCacheDays,
+	}
+
+	// 同一原始文件两次经pdfcpu转换、裁剪，输出文件不同，仍只调用一次转换服务
+	for i := 0; i < 2; i++ {
+		in := filepath.Join(dir, "source.png")
+		assert.NoError(t, copyFile(src, in))
+
+		p := NewPipeline("dlgd")
+		pdf := NewFile(p, "pdf")
+		subs := NewFile(p, "subs")
+		excel := NewFile(p, "excel")
+		pages := NewValue[[]string](p, "pages")
+		pdf.Set(in)
+		pages.Set([]string{"1"})
+		p.Add(
+			image2PdfStep(pdf),
+			cropPdfStep(pdf, subs, pages),
+			convertStep("convert", converter, subs, excel, formatExcel),
+		)
+
+		assert.NoError(t, p.Run(context.Background()))
+		p.Close()
+	}
+
+	assert.Equal(t, 1, calls)
+}
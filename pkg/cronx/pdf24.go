@@ -32,7 +32,7 @@ var (
 )
 
 // pdf24Convert 将PDF文件转换为指定格式并下载
-func pdf24Convert(inPath string, format OutputFormat, outPath *string) error {
+func pdf24Convert(ctx context.Context, inPath string, format OutputFormat, outPath *string) error {
 	inExt := filepath.Ext(inPath)
 	if strings.ToLower(inExt) != ".pdf" {
 		return errors.New("输入文件格式错误")
@@ -54,10 +54,10 @@ func pdf24Convert(inPath string, format OutputFormat, outPath *string) error {
 		// chromedp.Flag("headless", false), // 可见模式，方便调试
 	)
 
-	allocCtx, cancel := chromedp.NewExecAllocator(context.Background(), opts...)
+	allocCtx, cancel := chromedp.NewExecAllocator(ctx, opts...)
 	defer cancel()
 
-	ctx, cancel := chromedp.NewContext(allocCtx)
+	ctx, cancel = chromedp.NewContext(allocCtx)
 	defer cancel()
 
 	ctx, cancel = context.WithTimeout(ctx, 5*time.Minute)
@@ -89,8 +89,8 @@ func pdf24Convert(inPath string, format OutputFormat, outPath *string) error {
 			select {
 			case <-downloadComplete:
 				return nil
-			case <-time.After(5 * time.Minute):
-				return fmt.Errorf("下载超时")
+			case <-ctx.Done():
+				return fmt.Errorf("下载超时: %w", ctx.Err())
 			}
 		}),
 	)
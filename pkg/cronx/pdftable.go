@@ -0,0 +1,360 @@
+package cronx
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ledongthuc/pdf"
+	"github.com/rs/xid"
+	"github.com/xuri/excelize/v2"
+	"seeccloud.com/edscron/pkg/x/slicex"
+)
+
+// PDF文本层表格提取：按字形坐标重建行列，生成与OCR结果结构一致的Excel
+const (
+	glyphLineTol  = 0.5 // 同行判定：基线差不超过字号的比例
+	glyphCellGap  = 1.0 // 分列判定：字形间距超过字号的比例
+	glyphLineSpan = 1.8 // 续行判定：行距不超过字号的比例
+)
+
+var errNoTextLayer = errors.New("PDF无文本层")
+
+// pdfCell 同行相邻字形组成的单元格
+type pdfCell struct {
+	X0, X1 float64 // 左右边界
+	Lead   float64 // 首个字形的宽度
+	Text   string
+}
+
+// at 单元格定位点(首个字形中心)，居中的跨列文本按首字形归入起始列
+func (c pdfCell) at() float64 {
+	return c.X0 + c.Lead/2
+}
+
+// pdfLine 基线相同的一行文本
+type pdfLine struct {
+	Y     float64 // 基线(自下而上递增)
+	Size  float64 // 字号
+	Cells []pdfCell
+}
+
+func (l pdfLine) text() string {
+	var sb strings.Builder
+	for _, c := range l.Cells {
+		sb.WriteString(c.Text)
+	}
+	return sb.String()
+}
+
+// pdfLabel 独占一行的纵向合并单元格文本，如垂直居中的"工商业用电"
+type pdfLabel struct {
+	Col         int
+	Top, Bottom float64 // 首行及末行基线
+	Text        string
+}
+
+// y 文本中心位置
+func (l pdfLabel) y() float64 {
+	return (l.Top + l.Bottom) / 2
+}
+
+// extractPdfTable 从PDF文本层提取表格并保存为Excel，无文本层(扫描件、图片)时返回errNoTextLayer
+func extractPdfTable(name string, outPath *string) error {
+	f, r, err := pdf.Open(name)
+	if err != nil {
+		return fmt.Errorf("打开PDF失败: %w", err)
+	}
+	defer f.Close()
+
+	grid := make([][]string, 0)
+	glyphs := 0
+	for i := 1; i <= r.NumPage(); i++ {
+		texts, err := pageTexts(r.Page(i))
+		if err != nil {
+			return fmt.Errorf("读取PDF页面%d失败: %w", i, err)
+		}
+
+		glyphs += len(texts)
+		grid = append(grid, pdfTable(pdfLines(texts))...)
+	}
+
+	if glyphs == 0 {
+		return errNoTextLayer
+	}
+
+	return saveGrid(grid, outPath)
+}
+
+// pageTexts 读取页面字形，忽略空白字符
+func pageTexts(page pdf.Page) (texts []pdf.Text, err error) {
+	// 第三方库解析异常内容流时会panic
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	if page.V.IsNull() {
+		return nil, nil
+	}
+
+	for _, t := range page.Content().Text {
+		if len(strings.TrimSpace(t.S)) > 0 {
+			t.FontSize = math.Abs(t.FontSize)
+			texts = append(texts, t)
+		}
+	}
+	return texts, nil
+}
+
+// pdfLines 按基线将字形分行，再按字形间距分列
+func pdfLines(texts []pdf.Text) []pdfLine {
+	sorted := append([]pdf.Text{}, texts...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Y > sorted[j].Y })
+
+	groups := make([][]pdf.Text, 0)
+	for _, t := range sorted {
+		if n := len(groups); n > 0 {
+			last := groups[n-1][0]
+			if math.Abs(last.Y-t.Y) <= math.Max(last.FontSize, t.FontSize)*glyphLineTol {
+				groups[n-1] = append(groups[n-1], t)
+				continue
+			}
+		}
+		groups = append(groups, []pdf.Text{t})
+	}
+
+	lines := make([]pdfLine, 0, len(groups))
+	for _, group := range groups {
+		sort.SliceStable(group, func(i, j int) bool { return group[i].X < group[j].X })
+
+		line := pdfLine{Y: group[0].Y, Size: group[0].FontSize}
+		for i, t := range group {
+			line.Size = math.Max(line.Size, t.FontSize)
+			if i == 0 || t.X-line.Cells[len(line.Cells)-1].X1 > t.FontSize*glyphCellGap {
+				line.Cells = append(line.Cells, pdfCell{X0: t.X, X1: t.X + t.W, Lead: t.W, Text: t.S})
+				continue
+			}
+
+			cell := &line.Cells[len(line.Cells)-1]
+			cell.X1 = math.Max(cell.X1, t.X+t.W)
+			cell.Text += t.S
+		}
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+// pdfTable 重建表格：合并多行表头，将纵向合并单元格的文本填入所跨各行，表格前后的文本整行置于首列
+func pdfTable(lines []pdfLine) [][]string {
+	plain := func(lines []pdfLine) [][]string {
+		rows := make([][]string, 0, len(lines))
+		for _, line := range lines {
+			rows = append(rows, []string{line.text()})
+		}
+		return rows
+	}
+
+	// 定位"电压等级"表头行
+	isVolTitle := func(c pdfCell) bool { return c.Text == voltageName || c.Text == voltageSZName }
+	anchor := slicex.FirstIndexFunc(lines, func(l pdfLine) bool { return slicex.Any(l.Cells, isVolTitle) })
+	if anchor < 0 {
+		return plain(lines)
+	}
+
+	// 表头：电压等级行及其上方行距相近、位于表格范围内的行
+	top := pdfHeaderTop(lines, anchor)
+	// 数值行之前的各行均为表头
+	numeric := anchor + 1 + slicex.FirstIndexFunc(lines[anchor+1:], func(l pdfLine) bool { return numbers(l) >= 2 })
+
+	cols := pdfColumns(lines[top:], numeric-top)
+	colOf := func(c pdfCell) int {
+		return sort.SearchFloat64s(cols, c.at())
+	}
+	volTitle, _ := slicex.FirstFunc(lines[anchor].Cells, isVolTitle)
+	volCol := colOf(volTitle)
+
+	volReg := regexp.MustCompile(voltagePattern)
+	volSZReg := regexp.MustCompile(voltageSZPattern)
+	isData := func(line pdfLine) bool {
+		return numbers(line) >= 2 || slicex.Any(line.Cells, func(c pdfCell) bool {
+			return colOf(c) == volCol && (volReg.MatchString(c.Text) || volSZReg.MatchString(c.Text))
+		})
+	}
+
+	first := slicex.FirstIndexFunc(lines[anchor+1:], isData)
+	if first < 0 {
+		return plain(lines)
+	}
+	first += anchor + 1
+	last := anchor + 1 + slicex.LastIndexFunc(lines[anchor+1:], isData)
+
+	header := make([]string, len(cols)+1)
+	for _, line := range lines[top:first] {
+		for _, c := range line.Cells {
+			header[colOf(c)] += c.Text
+		}
+	}
+
+	rows := plain(lines[:top])
+	rows = append(rows, header)
+
+	// 表体：数据行按列填充，独占一行的文本视为纵向合并单元格或上一数据行的换行
+	data := make([][]string, 0)
+	dataY := make([]float64, 0)
+	labels := make([]pdfLabel, 0)
+	for _, line := range lines[first : last+1] {
+		if isData(line) {
+			row := make([]string, len(cols)+1)
+			for _, c := range line.Cells {
+				row[colOf(c)] += c.Text
+			}
+			data = append(data, row)
+			dataY = append(dataY, line.Y)
+			continue
+		}
+
+		for _, c := range line.Cells {
+			col := colOf(c)
+			if col >= volCol {
+				data[len(data)-1][col] += c.Text
+				continue
+			}
+
+			// 相邻的同列文本为换行，合并后取首尾行的中间位置
+			if n := len(labels); n > 0 && labels[n-1].Col == col && labels[n-1].Bottom-line.Y <= line.Size*glyphLineSpan {
+				labels[n-1].Bottom = line.Y
+				labels[n-1].Text += c.Text
+				continue
+			}
+			labels = append(labels, pdfLabel{Col: col, Top: line.Y, Bottom: line.Y, Text: c.Text})
+		}
+	}
+
+	for col := 0; col < volCol; col++ {
+		spanLabels(data, dataY, col, slicex.FilterFunc(labels, func(l pdfLabel) bool { return l.Col == col }))
+	}
+
+	rows = append(rows, data...)
+	return append(rows, plain(lines[last+1:])...)
+}
+
+// pdfHeaderTop 表头首行：自电压等级行向上，行距相近、字号不大于表头且位于表格范围内的行均为表头
+func pdfHeaderTop(lines []pdfLine, anchor int) int {
+	// 表格范围取多单元格行的左右边界，表格后的说明文字通常为单个单元格
+	left, right := math.MaxFloat64, -math.MaxFloat64
+	for _, line := range lines[anchor:] {
+		if len(line.Cells) >= 2 {
+			left = math.Min(left, line.Cells[0].X0)
+			right = math.Max(right, line.Cells[len(line.Cells)-1].X1)
+		}
+	}
+
+	top := anchor
+	for top > 0 {
+		above, below := lines[top-1], lines[top]
+		if above.Y-below.Y > below.Size*glyphLineSpan || above.Size > below.Size*1.2 || strings.Contains(above.text(), "单位") ||
+			above.Cells[0].X0 < left-above.Size || above.Cells[len(above.Cells)-1].X1 > right+above.Size {
+			break
+		}
+		top--
+	}
+	return top
+}
+
+// pdfColumns 计算列分界：以单元格最多的行为基准，补充表头中未覆盖的列，header为表头行数
+func pdfColumns(lines []pdfLine, header int) []float64 {
+	base, _ := slicex.MaxFunc(lines, func(l pdfLine) int { return len(l.Cells) })
+
+	spans := append([]pdfCell{}, base.Cells...)
+	for _, line := range lines[:header] {
+		for _, c := range line.Cells {
+			if !slicex.Any(spans, func(s pdfCell) bool { return c.X0 < s.X1 && s.X0 < c.X1 }) {
+				spans = append(spans, c)
+			}
+		}
+	}
+	sort.Slice(spans, func(i, j int) bool { return spans[i].X0 < spans[j].X0 })
+
+	// 分界取相邻两列间隙的中点
+	bounds := make([]float64, 0, len(spans)-1)
+	for i := 1; i < len(spans); i++ {
+		bounds = append(bounds, (spans[i-1].X1+spans[i].X0)/2)
+	}
+	return bounds
+}
+
+// numbers 行内数值单元格数量
+func numbers(line pdfLine) int {
+	return slicex.LenFunc(line.Cells, func(c pdfCell) bool {
+		_, err := strconv.ParseFloat(c.Text, 64)
+		return err == nil
+	})
+}
+
+// spanLabels 将纵向合并单元格文本填入所跨各数据行
+//
+// 合并单元格文本垂直居中，自首个未填充行起、关于文本位置对称的各行即为其所跨行；
+// 仍未填充的行沿用上一行的值
+func spanLabels(data [][]string, dataY []float64, col int, labels []pdfLabel) {
+	start := 0
+	for _, label := range labels {
+		y := label.y()
+		for start < len(data) && dataY[start] > y && len(data[start][col]) > 0 {
+			start++
+		}
+		if start >= len(data) {
+			break
+		}
+
+		bottom := y - math.Max(dataY[start]-y, 0)
+		for start < len(data) && len(data[start][col]) == 0 && dataY[start] >= bottom-1 {
+			data[start][col] = label.Text
+			start++
+		}
+	}
+
+	for i := 1; i < len(data); i++ {
+		if len(data[i][col]) == 0 {
+			data[i][col] = data[i-1][col]
+		}
+	}
+}
+
+// saveGrid 保存为临时Excel文件，工作表名称同OCR结果
+func saveGrid(grid [][]string, outPath *string) error {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	if err := f.SetSheetName(f.GetSheetName(0), defSheet); err != nil {
+		return err
+	}
+
+	for i, row := range grid {
+		cell, _ := excelize.CoordinatesToCellName(1, i+1)
+		if err := f.SetSheetRow(defSheet, cell, &row); err != nil {
+			return fmt.Errorf("写入Excel失败: %w", err)
+		}
+	}
+
+	if err := ensureDir(tempDir); err != nil {
+		return fmt.Errorf("创建临时目录失败: %w", err)
+	}
+
+	name := filepath.Join(tempDir, xid.New().String()+exts[formatExcel])
+	if err := f.SaveAs(name); err != nil {
+		os.Remove(name)
+		return fmt.Errorf("保存Excel失败: %w", err)
+	}
+
+	*outPath = name
+	return nil
+}
@@ -0,0 +1,90 @@
+package cronx
+
+import (
+	"os"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/ledongthuc/pdf"
+	"github.com/stretchr/testify/assert"
+)
+
+// glyphs 按字号排布字形，汉字宽度为字号，其余字符为半个字号
+func glyphs(x, y, size float64, s string) []pdf.Text {
+	texts := make([]pdf.Text, 0, utf8.RuneCountInString(s))
+	for _, r := range s {
+		w := size
+		if r < utf8.RuneSelf {
+			w = size / 2
+		}
+		texts = append(texts, pdf.Text{FontSize: size, X: x, Y: y, W: w, S: string(r)})
+		x += w
+	}
+	return texts
+}
+
+func TestPdfTable(t *testing.T) {
+	texts := make([]pdf.Text, 0)
+	add := func(x, y, size float64, s string) { texts = append(texts, glyphs(x, y, size, s)...) }
+
+	add(150, 800, 16, "某省电网代理购电工商业用户电价表")
+	add(450, 770, 10, "单位：元/千瓦时")
+	add(300, 740, 10, "电度用电价格")
+	add(50, 730, 10, "用电分类")
+	add(150, 730, 10, "电压等级")
+	for i, name := range []string{"尖峰", "高峰", "平段", "低谷"} {
+		add(250+float64(i)*70, 720, 10, name)
+	}
+
+	// "单一制"、"两部制"为纵向合并单元格，垂直居中于所跨两行
+	values := [][]string{
+		{"不满1千伏", "0.9123", "0.7602", "0.4561", "0.2281"},
+		{"1-10千伏", "0.8912", "0.7427", "0.4456", "0.2228"},
+		{"不满1千伏", "0.8721", "0.7268", "0.4361", "0.2180"},
+		{"1-10千伏", "0.8540", "0.7117", "0.4270", "0.2135"},
+	}
+	for i, row := range values {
+		y := 700 - float64(i)*15
+		add(150, y, 10, row[0])
+		for j, v := range row[1:] {
+			add(250+float64(j)*70, y, 10, v)
+		}
+	}
+	add(50, 692.5, 10, "单一制")
+	add(50, 662.5, 10, "两部制")
+	add(50, 620, 10, "高峰时段为10:00-12:00,谷段为0:00-8:00,其他时段为平段。")
+
+	grid := pdfTable(pdfLines(texts))
+	assert.Equal(t, [][]string{
+		{"某省电网代理购电工商业用户电价表"},
+		{"单位：元/千瓦时"},
+		{"用电分类", "电压等级", "尖峰", "电度用电价格高峰", "平段", "低谷"},
+		{"单一制", "不满1千伏", "0.9123", "0.7602", "0.4561", "0.2281"},
+		{"单一制", "1-10千伏", "0.8912", "0.7427", "0.4456", "0.2228"},
+		{"两部制", "不满1千伏", "0.8721", "0.7268", "0.4361", "0.2180"},
+		{"两部制", "1-10千伏", "0.8540", "0.7117", "0.4270", "0.2135"},
+		{"高峰时段为10:00-12:00,谷段为0:00-8:00,其他时段为平段。"},
+	}, grid)
+
+	// 生成的Excel可按OCR结果同样解析
+	excel := ""
+	assert.NoError(t, saveGrid(grid, &excel))
+	defer os.Remove(tempDir)
+	defer os.Remove(excel)
+
+	rows := make([]DlgdRow, 0)
+	hours := make([]DlgdHour, 0)
+	diag := DlgdHourDiagnostics{}
+	assert.NoError(t, unexcelize(excel, "某省", "电价表", &rows, &hours, &diag))
+	assert.Len(t, rows, 4)
+	assert.Equal(t, "两部制", rows[3].Category)
+	assert.Equal(t, "1-10千伏", rows[3].Voltage)
+	assert.Equal(t, 0.7117, rows[3].Peak)
+	assert.Len(t, hours, 3)
+	assert.Equal(t, 1.0, diag.Confidence)
+}
+
+func TestPdfTableWithoutHeader(t *testing.T) {
+	texts := glyphs(50, 700, 10, "关于代理购电价格的说明")
+	assert.Equal(t, [][]string{{"关于代理购电价格的说明"}}, pdfTable(pdfLines(texts)))
+}
@@ -0,0 +1,312 @@
+package cronx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var errStepTimeout = errors.New("步骤执行超时")
+
+// Port 步骤的输入或输出
+type Port interface {
+	Name() string
+	IsSet() bool
+}
+
+// Value 步骤间传递的中间结果，配置断点时随断点保存及恢复
+type Value[T any] struct {
+	name  string
+	value T
+	set   bool
+}
+
+// NewValue 创建流水线的中间结果
+func NewValue[T any](p *Pipeline, name string) *Value[T] {
+	v := &Value[T]{name: name}
+	p.values[name] = v
+	return v
+}
+
+func (v *Value[T]) Name() string { return v.name }
+func (v *Value[T]) IsSet() bool  { return v.set }
+func (v *Value[T]) Get() T       { return v.value }
+
+func (v *Value[T]) Set(value T) {
+	v.value = value
+	v.set = true
+}
+
+// MarshalJSON 未生成时为null，恢复断点时保持未生成
+func (v *Value[T]) MarshalJSON() ([]byte, error) {
+	if !v.set {
+		return []byte("null"), nil
+	}
+	return json.Marshal(v.value)
+}
+
+func (v *Value[T]) UnmarshalJSON(buf []byte) error {
+	if string(buf) == "null" {
+		return nil
+	}
+
+	if err := json.Unmarshal(buf, &v.value); err != nil {
+		return err
+	}
+	v.set = true
+	return nil
+}
+
+// File 步骤间传递的过程文件，流水线关闭时删除其生成过的所有文件
+type File struct {
+	name string
+	path string
+	key  string // 内容标识，见Key
+	p    *Pipeline
+}
+
+// NewFile 创建流水线的过程文件
+func NewFile(p *Pipeline, name string) *File {
+	f := &File{name: name, p: p}
+	p.files = append(p.files, f)
+	return f
+}
+
+func (f *File) Name() string { return f.name }
+func (f *File) IsSet() bool  { return len(f.path) > 0 }
+func (f *File) Path() string { return f.path }
+
+// Set 设置文件路径，原文件(如裁剪、格式转换前的文件)同样于流水线关闭时删除
+func (f *File) Set(path string) {
+	if len(f.path) > 0 && f.path != path {
+		f.p.temps = append(f.p.temps, f.path)
+	}
+	f.path = path
+	f.key = ""
+}
+
+// Key 内容标识：经Derive记录的处理时为原始文件SHA256加处理参数，否则为当前文件SHA256
+func (f *File) Key() (string, error) {
+	if len(f.key) > 0 {
+		return f.key, nil
+	}
+	return fileHash(f.path)
+}
+
+// Derive 记录当前文件由内容标识为key的文件经确定处理op(如裁剪页码、阈值)生成
+// pdfcpu等处理的输出含时间戳、随机ID，同一原始文件每次处理结果不同，按文件哈希缓存无法命中
+func (f *File) Derive(key, op string) {
+	f.key = key + "-" + op
+}
+
+// Step 流水线步骤
+type Step struct {
+	Name    string                          // 步骤名称，用于日志、进度事件及重新解析时筛选步骤
+	In      []Port                          // 输入，执行前校验均已生成
+	Out     []Port                          // 输出，执行后校验均已生成(可选输出不列出)
+	When    func() bool                     // 执行条件，为空时总是执行
+	Timeout time.Duration                   // 单次执行超时，0为不限；超时后不再重试
+	Retry   int                             // 失败重试次数
+	Backoff time.Duration                   // 重试间隔
+	Run     func(ctx context.Context) error // 执行函数
+}
+
+// StepEvent 步骤进度事件
+type StepEvent struct {
+	Pipeline string        // 流水线名称，如dlgd、twdl
+	Step     string        // 步骤名称
+	Index    int           // 步骤序号(自0起)
+	Total    int           // 步骤总数
+	Attempt  int           // 执行次数(自1起)
+	Elapsed  time.Duration // 执行耗时，Before时为0
+	Err      error         // 执行结果，Before时为nil
+}
+
+// StepHooks 步骤钩子，用于统计指标及进度通知
+type StepHooks struct {
+	Before func(StepEvent) // 每次执行前调用
+	After  func(StepEvent) // 每次执行后调用(无论成功与否)
+}
+
+// Pipeline 任务流水线：顺序执行命名步骤，支持单步超时、重试、钩子及断点续跑，关闭时清理过程文件
+//
+//	p := NewPipeline("dlgd")
+//	defer p.Close()
+//	pdf := NewFile(p, "pdf")
+//	p.Add(localizeStep(url, pdf), ...)
+//	err := p.Run(ctx)
+type Pipeline struct {
+	StepHooks
+	Name   string
+	Keep   bool // 保留过程文件，用于调试
+	Steps  []Step
+	values map[string]any
+	files  []*File
+	temps  []string
+	cp     *checkpoint
+}
+
+// NewPipeline 创建任务流水线，name用于日志及进度事件
+func NewPipeline(name string) *Pipeline {
+	return &Pipeline{
+		Name:   name,
+		values: make(map[string]any),
+		cp:     CheckpointConfig{}.newCheckpoint(""),
+	}
+}
+
+// Add 添加步骤
+func (p *Pipeline) Add(steps ...Step) *Pipeline {
+	p.Steps = append(p.Steps, steps...)
+	return p
+}
+
+// Checkpoint 启用断点续跑，key标识同一任务(如区域+月份)，from为最早续跑步骤
+func (p *Pipeline) Checkpoint(c CheckpointConfig, key string, from int) *Pipeline {
+	p.cp = c.newCheckpoint(key).resumable(from)
+	return p
+}
+
+// Run 顺序执行各步骤，每步成功后保存断点，全部成功后删除断点
+func (p *Pipeline) Run(ctx context.Context) error {
+	for name, v := range p.values {
+		p.cp.bind(name, v)
+	}
+	for _, f := range p.files {
+		p.cp.bindFile(f.name, &f.path)
+		p.cp.bind(f.name+"_key", &f.key)
+	}
+
+	// 续跑起始步骤之前的步骤(如判定是否发布新电价)每次执行，其输出与断点不同时放弃断点
+	from := min(p.cp.from, len(p.Steps))
+	for i := 0; i < from; i++ {
+		if err := p.step(ctx, i); err != nil {
+			return err
+		}
+		for _, out := range p.Steps[i].Out {
+			if _, ok := p.values[out.Name()]; ok {
+				p.cp.watch = append(p.cp.watch, out.Name())
+			}
+		}
+	}
+
+	for i := max(from, p.cp.resume()); i < len(p.Steps); i++ {
+		if err := p.step(ctx, i); err != nil {
+			return err
+		}
+		p.cp.save(i + 1)
+	}
+
+	p.cp.clear()
+	return nil
+}
+
+// step 按执行条件执行第i个步骤
+func (p *Pipeline) step(ctx context.Context, i int) error {
+	step := p.Steps[i]
+	if step.When != nil && !step.When() {
+		return nil
+	}
+
+	if err := p.exec(ctx, i, step); err != nil {
+		return fmt.Errorf("执行任务步骤%d(%s)失败: %w", i, step.Name, err)
+	}
+	return nil
+}
+
+// exec 执行单个步骤，失败时按重试策略重试
+func (p *Pipeline) exec(ctx context.Context, index int, step Step) error {
+	for _, in := range step.In {
+		if !in.IsSet() {
+			return fmt.Errorf("缺少输入: %s", in.Name())
+		}
+	}
+
+	var err error
+	for attempt := 1; attempt <= step.Retry+1; attempt++ {
+		event := StepEvent{Pipeline: p.Name, Step: step.Name, Index: index, Total: len(p.Steps), Attempt: attempt}
+		if p.Before != nil {
+			p.Before(event)
+		}
+
+		start := time.Now()
+		err = p.call(ctx, step)
+		event.Elapsed, event.Err = time.Since(start), err
+		if p.After != nil {
+			p.After(event)
+		}
+
+		if err == nil || errors.Is(err, errStepTimeout) || attempt > step.Retry {
+			break
+		}
+
+		logx.Infof("%s任务步骤%s第%d次执行失败，%v后重试: %v", p.Name, step.Name, attempt, step.Backoff, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(step.Backoff):
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for _, out := range step.Out {
+		if !out.IsSet() {
+			return fmt.Errorf("未生成输出: %s", out.Name())
+		}
+	}
+	return nil
+}
+
+// call 单次执行步骤，超时后取消ctx并等待步骤返回，避免重试或Close时步骤仍在后台读写过程文件
+// 步骤须响应ctx取消(网页抓取、文档转换、下载均已传递ctx)
+func (p *Pipeline) call(ctx context.Context, step Step) error {
+	if step.Timeout <= 0 {
+		return step.Run(ctx)
+	}
+
+	tctx, cancel := context.WithTimeout(ctx, step.Timeout)
+	defer cancel()
+
+	err := step.Run(tctx)
+	if err != nil && ctx.Err() == nil && errors.Is(tctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("%w(%v): %v", errStepTimeout, step.Timeout, err)
+	}
+	return err
+}
+
+// Close 删除过程文件，调试模式(Keep)下保留
+func (p *Pipeline) Close() {
+	if p.Keep {
+		return
+	}
+
+	for _, name := range p.temps {
+		os.Remove(name)
+	}
+	for _, f := range p.files {
+		if f.IsSet() {
+			os.Remove(f.path)
+		}
+	}
+}
+
+// Without 排除指定名称的步骤，如重新解析时跳过抓取及下载
+func (p *Pipeline) Without(names ...string) *Pipeline {
+	steps := make([]Step, 0, len(p.Steps))
+	for _, step := range p.Steps {
+		if !slices.Contains(names, step.Name) {
+			steps = append(steps, step)
+		}
+	}
+	p.Steps = steps
+	return p
+}
@@ -0,0 +1,86 @@
+package cronx
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"seeccloud.com/edscron/pkg/x/expx"
+)
+
+func TestPipeline(t *testing.T) {
+	dir := t.TempDir()
+	p := NewPipeline("dlgd")
+	events := make([]string, 0)
+	p.After = func(e StepEvent) {
+		events = append(events, e.Step+expx.If(e.Err == nil, ":ok", ":fail"))
+	}
+
+	url := NewValue[string](p, "url")
+	pdf := NewFile(p, "pdf")
+	excel := NewFile(p, "excel")
+	attempts := 0
+	p.Add(
+		// 失败后重试
+		Step{Name: "crawl", Retry: 2, Out: []Port{url}, Run: func(ctx context.Context) error {
+			attempts++
+			if attempts < 2 {
+				return errors.New("网络错误")
+			}
+			url.Set("https://example.com/dlgd.pdf")
+			return nil
+		}},
+		Step{Name: "localize", In: []Port{url}, Out: []Port{pdf}, Run: func(ctx context.Context) error {
+			name := filepath.Join(dir, "dlgd.pdf")
+			pdf.Set(name)
+			return os.WriteFile(name, []byte("pdf"), fileMode)
+		}},
+		// 裁剪后原文件同样于关闭时删除
+		Step{Name: "crop", In: []Port{pdf}, Out: []Port{pdf}, Run: func(ctx context.Context) error {
+			name := filepath.Join(dir, "crop.pdf")
+			pdf.Set(name)
+			return os.WriteFile(name, []byte("crop"), fileMode)
+		}},
+		// 条件不满足时跳过
+		Step{Name: "calendar", When: func() bool { return false }, Run: func(ctx context.Context) error {
+			return errors.New("不应执行")
+		}},
+	)
+
+	assert.NoError(t, p.Run(context.Background()))
+	assert.Equal(t, []string{"crawl:fail", "crawl:ok", "localize:ok", "crop:ok"}, events)
+	assert.Equal(t, filepath.Join(dir, "crop.pdf"), pdf.Path())
+
+	// 缺少输入
+	err := NewPipeline("dlgd").Add(Step{Name: "table", In: []Port{excel}, Run: func(ctx context.Context) error { return nil }}).Run(context.Background())
+	assert.ErrorContains(t, err, "缺少输入: excel")
+
+	// 未生成输出
+	err = NewPipeline("dlgd").Add(Step{Name: "table", Out: []Port{excel}, Run: func(ctx context.Context) error { return nil }}).Run(context.Background())
+	assert.ErrorContains(t, err, "未生成输出: excel")
+
+	// 超时后等待步骤退出，不再重试
+	calls, exited := 0, false
+	err = NewPipeline("dlgd").Add(Step{Name: "ocr", Timeout: 10 * time.Millisecond, Retry: 2, Run: func(ctx context.Context) error {
+		calls++
+		<-ctx.Done()
+		time.Sleep(10 * time.Millisecond)
+		exited = true
+		return ctx.Err()
+	}}).Run(context.Background())
+	assert.ErrorIs(t, err, errStepTimeout)
+	assert.Equal(t, 1, calls)
+	assert.True(t, exited)
+
+	// 关闭时删除全部过程文件
+	p.Close()
+	assert.NoFileExists(t, filepath.Join(dir, "dlgd.pdf"))
+	assert.NoFileExists(t, filepath.Join(dir, "crop.pdf"))
+
+	// 排除步骤
+	assert.Len(t, p.Without("crawl", "localize").Steps, 2)
+}
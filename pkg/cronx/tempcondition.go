@@ -0,0 +1,118 @@
+package cronx
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type TempAgg string
+
+const (
+	TempAggMax TempAgg = "max" // 各城市日最高气温取最大值(默认)
+	TempAggAvg TempAgg = "avg" // 各城市日最高气温取平均值
+	TempAggAny TempAgg = "any" // 任一城市单独满足连续天数
+
+	tempCitySep = "|" // 城市分隔符
+)
+
+var (
+	// temp:35、temp:35,3、temp:35,3,avg@广州|深圳|佛山
+	tempCondReg = regexp.MustCompile(`temp:(\d+)(?:,(\d+))?(?:,(max|avg|any)@(\p{Han}+(?:\|\p{Han}+)*))?`)
+	// 其他月份中广州日最高气温达到35℃
+	tempCityReg = regexp.MustCompile(`中(\p{Han}{2,3}?)市?日?最高气温`)
+)
+
+// TempCondition 气温触发条件：城市日最高气温达到Temp且连续Days天(第Days天触发)
+type TempCondition struct {
+	Temp   float64  // 触发温度(℃)
+	Days   int      // 连续天数，缺省1
+	Agg    TempAgg  // 多城市聚合方式，缺省max
+	Cities []string // 参考城市，缺省为省会
+}
+
+// ParseTempCondition 从日期条件中解析气温触发条件
+func ParseTempCondition(s string) (TempCondition, bool) {
+	subs := tempCondReg.FindStringSubmatch(s)
+	if len(subs) != 5 {
+		return TempCondition{}, false
+	}
+
+	c := TempCondition{Days: 1, Agg: TempAggMax}
+	c.Temp, _ = strconv.ParseFloat(subs[1], 64)
+	if days, err := strconv.Atoi(subs[2]); err == nil && days > 0 {
+		c.Days = days
+	}
+
+	if len(subs[4]) > 0 {
+		c.Agg = TempAgg(subs[3])
+		c.Cities = strings.Split(subs[4], tempCitySep)
+	}
+
+	return c, true
+}
+
+// String 格式化为日期条件，如"temp:35,3,avg@广州|深圳"
+func (c TempCondition) String() string {
+	s := fmt.Sprintf("temp:%g", c.Temp)
+	if c.Days > 1 {
+		s += fmt.Sprintf(",%d", c.Days)
+	}
+
+	if len(c.Cities) > 0 {
+		agg := c.Agg
+		if len(agg) == 0 {
+			agg = TempAggMax
+		}
+		s += fmt.Sprintf(",%s@%s", agg, strings.Join(c.Cities, tempCitySep))
+	}
+
+	return s
+}
+
+// Aggregate 聚合各城市同日最高气温，any按max处理(单城市逐一判断由调用方完成)
+func (c TempCondition) Aggregate(highs []float64) float64 {
+	if len(highs) == 0 {
+		return 0
+	}
+
+	value := highs[0]
+	for _, h := range highs[1:] {
+		if c.Agg == TempAggAvg {
+			value += h
+		} else {
+			value = max(value, h)
+		}
+	}
+
+	if c.Agg == TempAggAvg {
+		value /= float64(len(highs))
+	}
+
+	return value
+}
+
+// newTempCondition 从时段温度条件生成日期条件，支持结构化条件或政策原文，如“其他月份中广州日最高气温达到35℃，连续3天”
+func newTempCondition(text string) (TempCondition, bool) {
+	if c, ok := ParseTempCondition(text); ok {
+		return c, true
+	}
+
+	subs := tempSubReg.FindStringSubmatch(text)
+	if len(subs) < 2 {
+		return TempCondition{}, false
+	}
+
+	c := TempCondition{Days: 1, Agg: TempAggMax}
+	c.Temp, _ = strconv.ParseFloat(subs[1], 64)
+	if day, ok := matchDayNum(text); ok {
+		c.Days = day
+	}
+
+	if city := tempCityReg.FindStringSubmatch(text); len(city) == 2 {
+		c.Cities = []string{city[1]}
+	}
+
+	return c, true
+}
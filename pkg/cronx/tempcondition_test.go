@@ -0,0 +1,55 @@
+package cronx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTempCondition(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want TempCondition
+		ok   bool
+	}{
+		{name: "单日", text: "temp:35", want: TempCondition{Temp: 35, Days: 1, Agg: TempAggMax}, ok: true},
+		{name: "连续天数", text: "holiday:3;temp:35,3", want: TempCondition{Temp: 35, Days: 3, Agg: TempAggMax}, ok: true},
+		{name: "城市及聚合", text: "temp:35,3,avg@广州|深圳|佛山weekend", want: TempCondition{Temp: 35, Days: 3, Agg: TempAggAvg, Cities: []string{"广州", "深圳", "佛山"}}, ok: true},
+		{name: "无条件", text: "holiday:春节,劳动节", ok: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := ParseTempCondition(test.text)
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestNewTempCondition(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{text: "其他月份中广州日最高气温达到35℃", want: "temp:35,max@广州"},
+		{text: "其他月份连续三日最高气温≥35℃", want: "temp:35,3"},
+		{text: "temp:37,2,any@成都|绵阳", want: "temp:37,2,any@成都|绵阳"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.text, func(t *testing.T) {
+			got, ok := newTempCondition(test.text)
+			assert.True(t, ok)
+			assert.Equal(t, test.want, got.String())
+		})
+	}
+}
+
+func TestTempAggregate(t *testing.T) {
+	highs := []float64{34, 36, 35}
+	assert.Equal(t, 36.0, TempCondition{Agg: TempAggMax}.Aggregate(highs))
+	assert.Equal(t, 35.0, TempCondition{Agg: TempAggAvg}.Aggregate(highs))
+	assert.Equal(t, 0.0, TempCondition{}.Aggregate(nil))
+}
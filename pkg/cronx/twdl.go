@@ -5,20 +5,24 @@ import (
 	"encoding/json"
 	"fmt"
 	"html/template"
-	"os"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"seeccloud.com/edscron/pkg/chromedpx"
+	"seeccloud.com/edscron/pkg/x/expx"
 	"seeccloud.com/edscron/pkg/x/slicex"
 )
 
 // TwdlConfig 台湾电价获取配置
 type TwdlConfig struct {
-	FileSize string       `json:"file_size"` // 电价表文件大小，因页面上无任何时间标签，基于文件大小判定是否发布新电价
-	Ocr      AliOcr       `json:"ocr"`       // 阿里云OCR配置
-	Dp       chromedpx.DP `json:"dp"`        // 网页爬虫配置
+	FileSize   string           `json:"file_size"`  // 电价表文件大小，因页面上无任何时间标签，基于文件大小判定是否发布新电价
+	Ocr        OcrConfig        `json:"ocr"`        // 文档转换服务配置
+	Dp         chromedpx.DP     `json:"dp"`         // 网页爬虫配置
+	Archive    ArtifactStore    `json:"archive"`    // 原始文件及识别结果归档
+	Checkpoint CheckpointConfig `json:"checkpoint"` // 断点续跑配置
+	Hooks      StepHooks        `json:"-"`          // 步骤钩子，用于统计指标及进度通知
 }
 
 func DefaultTwdlTask() string {
@@ -39,69 +43,126 @@ func DefaultTwdlTask() string {
 }
 
 func (c *TwdlConfig) Run(m *MailConfig) (*[]TwdlRow, *[]Holiday, error) {
+	return c.run(m, nil)
+}
 
-	var url, calUrl, calPdfPath, calExcelPath, pdfPath, subsPdfPath, excelPath string
-	startDate := ""
-	fileSize := c.FileSize
-	page := make([]string, 1)
-	values := make([]TwdlRow, 0)
-	offPeakDays := make([]string, 0)
+// Reprocess 以当前解析程序重新解析归档的电价表及电价日历，无需重新抓取
+func (c *TwdlConfig) Reprocess(m *MailConfig, a Artifact) (*[]TwdlRow, *[]Holiday, error) {
+	return c.run(m, &a)
+}
 
+// run 执行任务，archived不为空时跳过抓取及归档，解析归档的原始文件
+func (c *TwdlConfig) run(m *MailConfig, archived *Artifact) (*[]TwdlRow, *[]Holiday, error) {
 	// 调试模式
 	// c.Dp.IsVisible = true
 
+	p := NewPipeline("twdl")
+	p.StepHooks = c.Hooks
+	p.Keep = c.Dp.IsVisible
+	defer p.Close()
+
+	page := NewValue[string](p, "page")
+	fileSize := NewValue[string](p, "fileSize")
+	url := NewValue[string](p, "url")
+	calUrl := NewValue[string](p, "calUrl")
+	startDate := NewValue[string](p, "startDate")
+	pages := NewValue[[]string](p, "pages")
+	values := NewValue[[]TwdlRow](p, "values")
+	offPeakDays := NewValue[[]string](p, "offPeakDays")
+	artifact := NewValue[Artifact](p, "artifact")
+	pdf := NewFile(p, "pdf")
+	subsPdf := NewFile(p, "subsPdf")
+	excel := NewFile(p, "excel")
+	calPdf := NewFile(p, "calPdf")
+	calExcel := NewFile(p, "calExcel")
+
+	fileSize.Set(c.FileSize)
+	startDate.Set("")
+	pages.Set(make([]string, 1))
+	offPeakDays.Set(make([]string, 0))
+	artifact.Set(Artifact{FetchedAt: time.Now()})
+
+	archive := expx.If(archived == nil, c.Archive, ArtifactStore{})
 	defer func() {
-		if !c.Dp.IsVisible {
-			os.Remove(pdfPath)
-			os.Remove(subsPdfPath)
-			os.Remove(excelPath)
-			os.Remove(calPdfPath)
-			os.Remove(calExcelPath)
-		}
+		// 原始文件下载后，url为电价表地址
+		a := artifact.Get()
+		a.Url = url.Get()
+		archive.done(a)
 	}()
 
-	ctx := context.Background()
-	calActions := []Action{
-		localizeAc(&calUrl, &calPdfPath),
-		pdfConvertAc(&calPdfPath, &calExcelPath, formatExcel),
-		excelizeCalAc(&calExcelPath, &offPeakDays),
+	converter, err := c.Ocr.NewConverter()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	actions := []Action{
-		crawlAc(ctx, c.Dp, &url),
-		extractContentAc(&fileSize, &url, &calUrl),
-		adjustCalendarAc(&calUrl, &calActions),
-		localizeAc(&url, &pdfPath),
-		extractFirstPageAc(&pdfPath, &startDate, &page),
-		cropPdfAc(&pdfPath, &subsPdfPath, &page),
-		ocrPdfAc(c.Ocr, &subsPdfPath, &url),
-		localizeAc(&url, &excelPath),
-		excelizeTwdlAc(&excelPath, &startDate, &values),
+	// 电价日历需保留单元格背景色，除离线运行外均使用pdf24
+	calOcr := c.Ocr
+	if calOcr.Provider != ConverterLocal {
+		calOcr.Provider = ConverterPdf24
+	}
+	calConverter, err := calOcr.NewConverter()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	for i, ac := range actions {
-		if err := ac(); err != nil {
-			return nil, nil, fmt.Errorf("执行任务步骤%d失败: %w", i, err)
+	calConvert := convertStep("calendar_convert", calConverter, calPdf, calExcel, formatExcel)
+	calConvert.When = calPdf.IsSet
+	calLocalize := localizeStep("calendar_localize", calUrl, calPdf)
+	calLocalize.When = calUrl.IsSet
+
+	p.Add(
+		crawlStep(c.Dp, page),
+		extractContentStep(page, fileSize, url, calUrl),
+		// 电价日历(可选)
+		calLocalize,
+		archiveStep("archive_calendar", archive, artifact, ArtifactCalendar, calPdf),
+		calConvert,
+		archiveStep("archive_calendar_table", archive, artifact, ArtifactCalendarTable, calExcel),
+		excelizeCalStep(calExcel, offPeakDays),
+		// 电价表
+		localizeStep("localize", url, pdf),
+		archiveStep("archive_source", archive, artifact, ArtifactSource, pdf),
+		extractFirstPageStep(pdf, startDate, pages),
+		cropPdfStep(pdf, subsPdf, pages),
+		convertStep("convert", converter, subsPdf, excel, formatExcel),
+		archiveStep("archive_table", archive, artifact, ArtifactTable, excel),
+		excelizeTwdlStep(excel, startDate, values),
+	)
+
+	if archived != nil {
+		// 重新解析：跳过抓取及下载，电价日历可选
+		path := ""
+		if err := c.Archive.Open(*archived, ArtifactSource, &path); err != nil {
+			return nil, nil, err
 		}
+		pdf.Set(path)
 
-		if c.Dp.IsVisible {
-			fmt.Printf("执行任务步骤%d成功\n", i)
+		if err := c.Archive.Open(*archived, ArtifactCalendar, &path); err == nil {
+			calPdf.Set(path)
 		}
+		p.Without("crawl", "extract_content", "calendar_localize", "localize")
+	} else {
+		// 断点：重试时自首个失败步骤继续，无需重新下载及OCR识别；是否发布新电价每次重新判定，电价表地址或文件大小变化时放弃断点
+		p.Checkpoint(c.Checkpoint, "twdl", 2)
+	}
+
+	if err := p.Run(context.Background()); err != nil {
+		return nil, nil, err
 	}
 
-	if len(values) > 0 {
+	if rows := values.Get(); len(rows) > 0 {
 		// 邮件含附近，用go会被中断
 		m.Send(TwdlTemplate{
-			Calendar:    strings.Join(offPeakDays, ", "),
-			StartDate:   startDate,
-			RecordCount: len(values),
+			Calendar:    strings.Join(offPeakDays.Get(), ", "),
+			StartDate:   startDate.Get(),
+			RecordCount: len(rows),
 			TargetCount: len(TwdlCategories) * 2,
-			Details:     template.HTML(formatTwdls(values, true)),
-		}, calPdfPath, calExcelPath, pdfPath, excelPath)
+			Details:     template.HTML(formatTwdls(rows, true)),
+		}, calPdf.Path(), calExcel.Path(), pdf.Path(), excel.Path())
 
-		c.FileSize = fileSize
+		c.FileSize = fileSize.Get()
 
-		days := slicex.MapFunc(offPeakDays, func(s string) Holiday {
+		days := slicex.MapFunc(offPeakDays.Get(), func(s string) Holiday {
 			return Holiday{
 				Area:     string(TaiwanArea),
 				Alias:    TaiwanAreaName,
@@ -109,98 +170,107 @@ func (c *TwdlConfig) Run(m *MailConfig) (*[]TwdlRow, *[]Holiday, error) {
 				Category: string(HolidayPeakOff),
 			}
 		})
-		return &values, &days, nil
+		return &rows, &days, nil
 	}
 
 	return nil, nil, fmt.Errorf("空数据错误: %v", c)
 }
 
-func extractContentAc(fileSize, url, calendarUrl *string) Action {
-	return func() error {
-		content := regexp.MustCompile(`[\r\n]+`).ReplaceAllString(*url, "")
-		reg1 := regexp.MustCompile(`(?s)<a\s+[^>]*href=["']([^"']*簡要電價表[^"']*)["'][^>]*>.*?(\d+(?:\.\d+)?[KMkm][Bb]).*?</a>`)
-		reg2 := regexp.MustCompile(`(?s)<a\s+[^>]*href=["']([^"']*日曆表[^"']*)["'][^>]*>.*?</a>`)
-
-		matches := reg1.FindStringSubmatch(content)
-		if len(matches) != 3 {
-			return fmt.Errorf("找不到含'簡要電價表'和文件大小的链接(<a>)")
-		}
-
-		// 未发布新电价
-		if strings.EqualFold(matches[2], *fileSize) {
-			return fmt.Errorf("未发布新电价")
-		}
+// extractContentStep 从网页中提取电价表及电价日历链接，按文件大小判定是否发布新电价
+func extractContentStep(page, fileSize, url, calendarUrl *Value[string]) Step {
+	return Step{
+		Name: "extract_content",
+		In:   []Port{page},
+		Out:  []Port{url, fileSize},
+		Run: func(ctx context.Context) error {
+			content := regexp.MustCompile(`[\r\n]+`).ReplaceAllString(page.Get(), "")
+			reg1 := regexp.MustCompile(`(?s)<a\s+[^>]*href=["']([^"']*簡要電價表[^"']*)["'][^>]*>.*?(\d+(?:\.\d+)?[KMkm][Bb]).*?</a>`)
+			reg2 := regexp.MustCompile(`(?s)<a\s+[^>]*href=["']([^"']*日曆表[^"']*)["'][^>]*>.*?</a>`)
+
+			matches := reg1.FindStringSubmatch(content)
+			if len(matches) != 3 {
+				return fmt.Errorf("找不到含'簡要電價表'和文件大小的链接(<a>)")
+			}
 
-		// 发布新电价
-		*fileSize = matches[2]
-		*url = matches[1]
+			// 未发布新电价
+			if strings.EqualFold(matches[2], fileSize.Get()) {
+				return fmt.Errorf("未发布新电价")
+			}
 
-		// 电价日历
-		matches = reg2.FindStringSubmatch(content)
-		if len(matches) == 2 {
-			*calendarUrl = matches[1]
-		}
+			// 发布新电价
+			fileSize.Set(matches[2])
+			url.Set(matches[1])
 
-		return nil
+			// 电价日历
+			matches = reg2.FindStringSubmatch(content)
+			if len(matches) == 2 {
+				calendarUrl.Set(matches[1])
+			}
 
+			return nil
+		},
 	}
 }
 
-// extractFirstPageAc 提取目录页信息任务
-func extractFirstPageAc(path, startDate *string, page *[]string) Action {
-	// 提取任务允许失败
-	return func() error {
-		var content string
-		if err := readPdf(*path, 1, &content); err != nil {
-			return nil
-		}
-
-		if match := twStartDateReg.FindStringSubmatch(content); len(match) == 4 {
-			*startDate = fmt.Sprintf("%s年%s月%s日", match[1], match[2], match[3])
-		}
+// extractFirstPageStep 提取目录页信息：生效日期及电价表页码范围，提取失败时沿用默认值
+func extractFirstPageStep(pdf *File, startDate *Value[string], pages *Value[[]string]) Step {
+	return Step{
+		Name: "extract_first_page",
+		In:   []Port{pdf},
+		Run: func(ctx context.Context) error {
+			var content string
+			if err := readPdf(pdf.Path(), 1, &content); err != nil {
+				return nil
+			}
 
-		if subs := twPageNumReg.FindStringSubmatch(content); len(subs) == 3 {
-			// "壹、調整後各類用電電價表...1"，基于目录页偏移+1
-			start, _ := strconv.Atoi(subs[1])
-			// "貳、凍漲行業適用電價表...10"，不含“10”
-			end, _ := strconv.Atoi(subs[2])
-			(*page)[0] = fmt.Sprintf("%d-%d", start+1, end)
-		}
+			if match := twStartDateReg.FindStringSubmatch(content); len(match) == 4 {
+				startDate.Set(fmt.Sprintf("%s年%s月%s日", match[1], match[2], match[3]))
+			}
 
-		return nil
-	}
-}
+			if subs := twPageNumReg.FindStringSubmatch(content); len(subs) == 3 {
+				// "壹、調整後各類用電電價表...1"，基于目录页偏移+1
+				start, _ := strconv.Atoi(subs[1])
+				// "貳、凍漲行業適用電價表...10"，不含“10”
+				end, _ := strconv.Atoi(subs[2])
+				pages.Set([]string{fmt.Sprintf("%d-%d", start+1, end)})
+			}
 
-func excelizeTwdlAc(excelPath, startDate *string, twdls *[]TwdlRow) Action {
-	return func() error {
-		return excelizeTwdl(*excelPath, *startDate, twdls)
+			return nil
+		},
 	}
 }
 
-func adjustCalendarAc(calUrl *string, action *[]Action) Action {
-	return func() error {
-		if len(*calUrl) == 0 {
-			return nil
-		}
-
-		for _, ac := range *action {
-			if err := ac(); err != nil {
+// excelizeTwdlStep 解析电价表Excel
+func excelizeTwdlStep(excel *File, startDate *Value[string], values *Value[[]TwdlRow]) Step {
+	return Step{
+		Name: "excelize",
+		In:   []Port{excel, startDate},
+		Out:  []Port{values},
+		Run: func(ctx context.Context) error {
+			rows := make([]TwdlRow, 0)
+			if err := excelizeTwdl(excel.Path(), startDate.Get(), &rows); err != nil {
 				return err
 			}
-		}
 
-		return nil
+			values.Set(rows)
+			return nil
+		},
 	}
 }
 
-func excelizeCalAc(excelPath *string, dates *[]string) Action {
-	return func() error {
-		dts, err := ExcelizeCalendar(*excelPath)
-		if err != nil {
-			return err
-		}
+// excelizeCalStep 解析电价日历Excel中的离峰日
+func excelizeCalStep(excel *File, dates *Value[[]string]) Step {
+	return Step{
+		Name: "calendar_excelize",
+		When: excel.IsSet,
+		Run: func(ctx context.Context) error {
+			dts, err := ExcelizeCalendar(excel.Path())
+			if err != nil {
+				return err
+			}
 
-		*dates = append(*dates, dts...)
-		return nil
+			dates.Set(append(dates.Get(), dts...))
+			return nil
+		},
 	}
 }
@@ -43,6 +43,7 @@ type Predict struct {
 	Publish string        `json:"publish"` // 发布时间
 	Dates   []DateWeather `json:"dates"`   // 每日天气预报
 	Temps   []Temperature `json:"temps"`   // 温度趋势数据
+	Passed  []PassedHour  `json:"passed"`  // 过去24小时逐时实况
 }
 
 // DateWeather 单日天气数据
@@ -77,10 +78,17 @@ type Temperature struct {
 	Min  float64 `json:"min_temp"` // 最低气温
 }
 
+// PassedHour 逐时实况观测
+type PassedHour struct {
+	Time        string  `json:"time"`        // 观测时间(YYYY-MM-DD HH:mm)
+	Temperature float64 `json:"temperature"` // 气温，9999表示缺测
+}
+
 // WeatherConfig 天气查询配置
 type WeatherConfig struct {
 	Province string `json:"province"` // 省级名称
 	City     string `json:"city"`     // 市级名称
+	WeatherSource
 }
 
 // Weather 简化版天气数据(用于输出)
@@ -102,8 +110,13 @@ type Weather struct {
 //   - any: 查询结果
 //   - error: 错误信息
 func (w WeatherConfig) Run(m *MailConfig) (any, error) {
+	provider, err := w.NewProvider()
+	if err != nil {
+		return nil, err
+	}
+
 	var p Predict
-	if err := getPredict(w.Province, w.City, &p); err != nil {
+	if err := provider.Predict(w.Province, w.City, &p); err != nil {
 		return nil, fmt.Errorf("获取天气预报失败: %v", err)
 	}
 
@@ -178,21 +191,10 @@ func getMaxTempOf(province, city, date string, temp *float64) error {
 
 // getProvince 获取省级行政区划代码
 func getProvince(text string, code *string) error {
-	resp, err := http.Get(provinceUrl)
+	provinces, err := listProvinces()
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
-
-	buf, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return err
-	}
-
-	var provinces []Province
-	if err = json.Unmarshal(buf, &provinces); err != nil {
-		return err
-	}
 
 	// 查找匹配的省份
 	if p, ok := slicex.FirstFunc(provinces, func(s Province) bool {
@@ -207,22 +209,10 @@ func getProvince(text string, code *string) error {
 
 // getCity 获取市级行政区划代码
 func getCity(text string, prvCode string, code *string) error {
-	url := fmt.Sprintf(cityUrl, prvCode)
-	resp, err := http.Get(url)
+	cities, err := listCities(prvCode)
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
-
-	buf, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return err
-	}
-
-	var cities []City
-	if err = json.Unmarshal(buf, &cities); err != nil {
-		return err
-	}
 
 	// 查找匹配的城市
 	if c, ok := slicex.FirstFunc(cities, func(s City) bool {
@@ -235,6 +225,42 @@ func getCity(text string, prvCode string, code *string) error {
 	return fmt.Errorf("未找到匹配的城市: %s", text)
 }
 
+// listProvinces 获取全部省级行政区划
+func listProvinces() ([]Province, error) {
+	var provinces []Province
+	if err := getJson(provinceUrl, &provinces); err != nil {
+		return nil, err
+	}
+
+	return provinces, nil
+}
+
+// listCities 获取省级行政区划下全部城市
+func listCities(prvCode string) ([]City, error) {
+	var cities []City
+	if err := getJson(fmt.Sprintf(cityUrl, prvCode), &cities); err != nil {
+		return nil, err
+	}
+
+	return cities, nil
+}
+
+// getJson 请求REST接口并解析JSON
+func getJson(url string, v any) error {
+	resp, err := http.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	buf, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(buf, v)
+}
+
 // getWeather 获取城市天气预报
 func getWeather(text string, pre *Predict) error {
 	url := fmt.Sprintf(weatherUrl, text)
@@ -265,5 +291,10 @@ func getWeather(text string, pre *Predict) error {
 		json.Unmarshal(buf, &pre.Temps)
 	}
 
+	// 解析过去24小时实况
+	if buf, err := res.Get("data").Get("passedchart").MarshalJSON(); err == nil {
+		json.Unmarshal(buf, &pre.Passed)
+	}
+
 	return nil
 }
@@ -0,0 +1,80 @@
+package cronx
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"seeccloud.com/edscron/pkg/vars"
+	"seeccloud.com/edscron/pkg/x/expx"
+)
+
+// 单日有效观测不足该小时数时，不视为完整日实测
+const observedMinHours = 12
+
+// WeatherObservedConfig 实测天气查询配置，依过去24小时逐时实况统计日最高/最低气温
+type WeatherObservedConfig struct {
+	Province string `json:"province"`       // 省级名称
+	City     string `json:"city"`           // 市级名称
+	Date     string `json:"date,omitempty"` // 统计日期(YYYY-MM-DD)，缺省为当日
+	WeatherSource
+}
+
+// ObservedWeather 单日实测气温
+type ObservedWeather struct {
+	Date    string  `json:"date"`     // 日期
+	City    string  `json:"city"`     // 城市名称
+	MaxTemp float64 `json:"max_temp"` // 实测最高气温
+	MinTemp float64 `json:"min_temp"` // 实测最低气温
+	Hours   int64   `json:"hours"`    // 有效观测小时数
+	Source  string  `json:"source"`   // 数据来源
+}
+
+// Run 执行实测天气查询任务，nmc逐时实况仅覆盖过去24小时，宜于当日深夜执行
+func (w WeatherObservedConfig) Run(m *MailConfig) (*[]ObservedWeather, error) {
+	if len(w.Date) == 0 {
+		w.Date = time.Now().Format(vars.DateFormat)
+	}
+
+	provider, err := w.NewProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	var p Predict
+	if err := provider.Predict(w.Province, w.City, &p); err != nil {
+		return nil, fmt.Errorf("获取实况数据失败: %v", err)
+	}
+
+	results := []ObservedWeather{}
+	if ob, ok := observeDay(p.Passed, w.Date); ok {
+		ob.City = w.City
+		ob.Source = expx.If(len(w.Provider) == 0, ProviderNmc, w.Provider)
+		results = append(results, ob)
+	}
+
+	return emptyValueErr(m, w, &results)
+}
+
+// observeDay 统计指定日期的逐时实况，有效观测不足observedMinHours时返回false
+func observeDay(hours []PassedHour, date string) (ObservedWeather, bool) {
+	ob := ObservedWeather{Date: date}
+	for _, h := range hours {
+		// 过滤其他日期及缺测数据(9999)
+		if !strings.HasPrefix(h.Time, date) || h.Temperature >= 100 || h.Temperature <= -100 {
+			continue
+		}
+
+		if ob.Hours == 0 || h.Temperature > ob.MaxTemp {
+			ob.MaxTemp = h.Temperature
+		}
+
+		if ob.Hours == 0 || h.Temperature < ob.MinTemp {
+			ob.MinTemp = h.Temperature
+		}
+
+		ob.Hours++
+	}
+
+	return ob, ob.Hours >= observedMinHours
+}
@@ -0,0 +1,56 @@
+package cronx
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObserveDay(t *testing.T) {
+	// 2025-07-31 20:00 ~ 2025-08-01 19:00 逐时实况
+	hours := []PassedHour{}
+	for i := range 24 {
+		h := (20 + i) % 24
+		date := map[bool]string{true: "2025-07-31", false: "2025-08-01"}[i < 4]
+		hours = append(hours, PassedHour{
+			Time:        fmt.Sprintf("%s %02d:00", date, h),
+			Temperature: 28 + float64(h%12)/2,
+		})
+	}
+	hours[10].Temperature = 9999
+
+	tests := []struct {
+		name string
+		date string
+		want ObservedWeather
+		ok   bool
+	}{
+		{
+			name: "完整日，忽略缺测",
+			date: "2025-08-01",
+			want: ObservedWeather{Date: "2025-08-01", MaxTemp: 33.5, MinTemp: 28, Hours: 19},
+			ok:   true,
+		},
+		{
+			name: "观测不足",
+			date: "2025-07-31",
+			want: ObservedWeather{Date: "2025-07-31", MaxTemp: 33.5, MinTemp: 32, Hours: 4},
+			ok:   false,
+		},
+		{
+			name: "无观测",
+			date: "2025-08-02",
+			want: ObservedWeather{Date: "2025-08-02"},
+			ok:   false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := observeDay(hours, test.date)
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
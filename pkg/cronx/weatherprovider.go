@@ -0,0 +1,82 @@
+package cronx
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// 天气数据源标识
+const (
+	ProviderNmc  = "nmc"  // 中央气象台REST接口(默认)
+	ProviderFile = "file" // 本地录制的JSON文件，用于离线测试或内网部署
+)
+
+// WeatherProvider 天气数据源，获取城市预报及过去24小时实况
+type WeatherProvider interface {
+	Predict(province, city string, p *Predict) error
+}
+
+// WeatherSource 天气数据源配置，嵌入各天气任务配置
+type WeatherSource struct {
+	Provider string `json:"provider,omitempty"` // 数据源：nmc(默认)、file
+	Path     string `json:"path,omitempty"`     // file数据源的JSON文件或目录，目录下文件命名为“省份-城市.json”
+	Station  string `json:"station,omitempty"`  // nmc气象站代码，缺省时按省市名称在线匹配
+}
+
+// NewProvider 依数据源标识创建天气数据源
+func (s WeatherSource) NewProvider() (WeatherProvider, error) {
+	switch s.Provider {
+	case "", ProviderNmc:
+		return nmcProvider{Station: s.Station}, nil
+	case ProviderFile:
+		if len(s.Path) == 0 {
+			return nil, fmt.Errorf("file数据源需指定path")
+		}
+		return fileProvider{Path: s.Path}, nil
+	default:
+		return nil, fmt.Errorf("未知的天气数据源: %s", s.Provider)
+	}
+}
+
+// nmcProvider 中央气象台数据源
+type nmcProvider struct {
+	Station string
+}
+
+func (n nmcProvider) Predict(province, city string, p *Predict) error {
+	if len(n.Station) > 0 {
+		if err := getWeather(n.Station, p); err != nil {
+			return fmt.Errorf("获取天气数据失败: %v", err)
+		}
+		return nil
+	}
+
+	return getPredict(province, city, p)
+}
+
+// fileProvider 本地JSON数据源，文件内容为Predict结构
+type fileProvider struct {
+	Path string
+}
+
+func (f fileProvider) Predict(province, city string, p *Predict) error {
+	path := f.Path
+	if info, err := os.Stat(path); err != nil {
+		return err
+	} else if info.IsDir() {
+		path = filepath.Join(path, fmt.Sprintf("%s-%s.json", province, city))
+	}
+
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("读取天气文件失败: %v", err)
+	}
+
+	if err = json.Unmarshal(buf, p); err != nil {
+		return fmt.Errorf("解析天气文件失败: %v", err)
+	}
+
+	return nil
+}
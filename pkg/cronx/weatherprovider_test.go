@@ -0,0 +1,45 @@
+package cronx
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileWeatherProvider(t *testing.T) {
+	dir := t.TempDir()
+	passed := ""
+	for h := range 24 {
+		passed += fmt.Sprintf(`%s{"time": "2025-08-01 %02d:00", "temperature": %d}`, map[bool]string{true: "", false: ","}[h == 0], h, 26+h%12)
+	}
+	content := fmt.Sprintf(`{
+		"city": "广东广州",
+		"dates": [
+			{"date": "2025-08-02", "day": {"weather": {"info": "多云", "temperature": "35"}}, "night": {"weather": {"info": "晴", "temperature": "27"}}},
+			{"date": "2025-08-03", "day": {"weather": {"info": "雷阵雨", "temperature": "9999"}}, "night": {"weather": {"info": "-", "temperature": "26"}}}
+		],
+		"passed": [%s]
+	}`, passed)
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "广东-广州.json"), []byte(content), 0644))
+
+	weas, err := WeatherConfig{Province: "广东", City: "广州", WeatherSource: WeatherSource{Provider: ProviderFile, Path: dir}}.Run(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &[]Weather{
+		{Date: "2025-08-02", City: "广州", DayWeather: "多云", DayTemp: 35, NightWeather: "晴", NightTemp: 27},
+	}, weas)
+
+	obs, err := WeatherObservedConfig{Province: "广东", City: "广州", Date: "2025-08-01", WeatherSource: WeatherSource{Provider: ProviderFile, Path: dir}}.Run(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &[]ObservedWeather{
+		{Date: "2025-08-01", City: "广州", MaxTemp: 37, MinTemp: 26, Hours: 24, Source: ProviderFile},
+	}, obs)
+
+	_, err = WeatherConfig{Province: "四川", City: "成都", WeatherSource: WeatherSource{Provider: ProviderFile, Path: dir}}.Run(nil)
+	assert.Error(t, err)
+
+	_, err = WeatherSource{Provider: "unknown"}.NewProvider()
+	assert.Error(t, err)
+}
@@ -0,0 +1,57 @@
+package cronx
+
+import (
+	"fmt"
+	"strings"
+
+	"seeccloud.com/edscron/pkg/x/slicex"
+)
+
+// WeatherStation 省市名称与nmc气象站代码映射
+type WeatherStation struct {
+	Province string `json:"province"` // 省级名称，如“广东省”
+	City     string `json:"city"`     // 市级名称，如“广州”
+	Code     string `json:"code"`     // 气象站代码，如59287
+}
+
+// WeatherStationConfig 气象站代码刷新配置，代码极少变化，宜低频执行
+type WeatherStationConfig struct{}
+
+// Run 一次性获取全部省份及城市的气象站代码
+func (w WeatherStationConfig) Run(m *MailConfig) (*[]WeatherStation, error) {
+	provinces, err := listProvinces()
+	if err != nil {
+		return nil, fmt.Errorf("获取省级代码失败: %v", err)
+	}
+
+	stations := []WeatherStation{}
+	for _, p := range provinces {
+		cities, err := listCities(p.Code)
+		if err != nil {
+			return nil, fmt.Errorf("获取%s城市代码失败: %v", p.Name, err)
+		}
+
+		for _, c := range cities {
+			stations = append(stations, WeatherStation{
+				Province: p.Name,
+				City:     c.City,
+				Code:     c.Code,
+			})
+		}
+	}
+
+	return emptyValueErr(m, w, &stations)
+}
+
+// MatchStation 依省市名称匹配气象站，优先全称一致(含人工指定)，其次同getProvince/getCity的包含匹配
+func MatchStation(stations []WeatherStation, province, city string) (WeatherStation, bool) {
+	if s, ok := slicex.FirstFunc(stations, func(s WeatherStation) bool {
+		return s.Province == province && s.City == city
+	}); ok {
+		return s, true
+	}
+
+	return slicex.FirstFunc(stations, func(s WeatherStation) bool {
+		return strings.Contains(s.Province, province) && strings.Contains(s.City, city)
+	})
+}
@@ -0,0 +1,38 @@
+package cronx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchStation(t *testing.T) {
+	stations := []WeatherStation{
+		{Province: "广东省", City: "广州", Code: "59287"},
+		{Province: "广东省", City: "中山", Code: "59485"},
+		{Province: "四川省", City: "成都", Code: "56294"},
+		// 人工指定：名称不一致时覆盖
+		{Province: "四川", City: "成都市", Code: "56187"},
+	}
+
+	tests := []struct {
+		name     string
+		province string
+		city     string
+		want     string
+		ok       bool
+	}{
+		{name: "包含匹配", province: "广东", city: "广州", want: "59287", ok: true},
+		{name: "全称优先", province: "四川", city: "成都市", want: "56187", ok: true},
+		{name: "省份不符", province: "四川", city: "中山", ok: false},
+		{name: "无匹配", province: "福建", city: "厦门", ok: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := MatchStation(stations, test.province, test.city)
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.want, got.Code)
+		})
+	}
+}